@@ -35,14 +35,17 @@ import (
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	machineinformers "github.com/gardener/machine-controller-manager/pkg/client/informers/externalversions"
 	mcmcontroller "github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
 	corecontroller "github.com/gardener/machine-controller-manager/pkg/util/clientbuilder/core"
 	machinecontroller "github.com/gardener/machine-controller-manager/pkg/util/clientbuilder/machine"
+	"github.com/gardener/machine-controller-manager/pkg/webhook"
 	coreinformers "k8s.io/client-go/informers"
 	kubescheme "k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/gardener/machine-controller-manager/cmd/machine-controller-manager/app/options"
 	"github.com/gardener/machine-controller-manager/pkg/handlers"
 	"github.com/gardener/machine-controller-manager/pkg/util/configz"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	prometheus "github.com/prometheus/client_golang/prometheus/promhttp"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -124,27 +127,48 @@ func Run(s *options.MCMServer) error {
 	klog.V(3).Info("Starting http server and mux")
 	go startHTTP(s)
 
+	if s.WebhookServer.Port > 0 {
+		// The webhook server serves on all replicas, independent of leader election.
+		klog.V(3).Infof("Starting admission webhook server on port %d", s.WebhookServer.Port)
+		go startWebhookServer(s, kubeClientControl)
+	}
+
+	if s.DebugServer.Enabled() {
+		klog.V(3).Infof("Starting debug server on port %d", s.DebugServer.Port)
+		go startDebugServer(s)
+	}
+
 	recorder := createRecorder(kubeClientControl)
 
+	// If faults are to be injected, only the clients of the controllers inject them, so that the faults do not end the
+	// leader election.
+	controllerControlKubeconfig, controllerTargetKubeconfig := controlkubeconfig, targetkubeconfig
+	if s.Chaos.Enabled() {
+		klog.Warningf("Injecting faults into the requests of the controllers to the API servers: %+v", s.Chaos)
+		injector := chaos.NewInjector(s.Chaos)
+		controllerControlKubeconfig = injector.WrapConfig(controlkubeconfig)
+		controllerTargetKubeconfig = injector.WrapConfig(targetkubeconfig)
+	}
+
 	run := func(_ context.Context) {
 		var stop <-chan struct{}
 		// Control plane client used to interact with machine APIs
 		controlMachineClientBuilder := machinecontroller.SimpleClientBuilder{
-			ClientConfig: controlkubeconfig,
+			ClientConfig: controllerControlKubeconfig,
 		}
 		// Control plane client used to interact with core kubernetes objects
 		controlCoreClientBuilder := corecontroller.SimpleControllerClientBuilder{
-			ClientConfig: controlkubeconfig,
+			ClientConfig: controllerControlKubeconfig,
 		}
 		// Target plane client used to interact with core kubernetes objects
 		targetCoreClientBuilder := corecontroller.SimpleControllerClientBuilder{
-			ClientConfig: targetkubeconfig,
+			ClientConfig: controllerTargetKubeconfig,
 		}
 
 		err := StartControllers(
 			s,
-			controlkubeconfig,
-			targetkubeconfig,
+			controllerControlKubeconfig,
+			controllerTargetKubeconfig,
 			controlMachineClientBuilder,
 			controlCoreClientBuilder,
 			targetCoreClientBuilder,
@@ -268,6 +292,7 @@ func StartControllers(s *options.MCMServer,
 		recorder,
 		s.SafetyOptions,
 		s.AutoscalerScaleDownAnnotationDuringRollout,
+		s.MaxConcurrentRollouts,
 	)
 	if err != nil {
 		return err
@@ -370,3 +395,12 @@ func startHTTP(s *options.MCMServer) {
 	}
 	klog.Fatal(server.ListenAndServe())
 }
+
+func startDebugServer(s *options.MCMServer) {
+	klog.Fatal(debug.ListenAndServeTLS(s.Address, s.DebugServer))
+}
+
+func startWebhookServer(s *options.MCMServer, controlCoreClient kubernetes.Interface) {
+	handler := webhook.NewHandler(controlCoreClient, s.WebhookServer)
+	klog.Fatal(handler.ListenAndServeTLS(s.Address))
+}
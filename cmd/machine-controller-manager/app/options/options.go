@@ -22,6 +22,7 @@ Modifications Copyright SAP SE or an SAP affiliate company and Gardener contribu
 package options
 
 import (
+	"fmt"
 	"time"
 
 	machineconfig "github.com/gardener/machine-controller-manager/pkg/options"
@@ -62,6 +63,11 @@ func NewMCMServer() *MCMServer {
 			LeaderElection:          leaderelectionconfig.DefaultLeaderElectionConfiguration(),
 			ControllerStartInterval: metav1.Duration{Duration: 0 * time.Second},
 			AutoscalerScaleDownAnnotationDuringRollout: true,
+			WebhookServer: machineconfig.WebhookServerOptions{
+				DefaultMachineCreationTimeout: metav1.Duration{Duration: 20 * time.Minute},
+				DefaultMachineHealthTimeout:   metav1.Duration{Duration: 10 * time.Minute},
+				DefaultMachineDrainTimeout:    metav1.Duration{Duration: 2 * time.Hour},
+			},
 			SafetyOptions: machineconfig.SafetyOptions{
 				SafetyUp:                        2,
 				SafetyDown:                      1,
@@ -82,6 +88,7 @@ func (s *MCMServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.MinResyncPeriod.Duration, "min-resync-period", s.MinResyncPeriod.Duration, "The resync period in reflectors will be random between MinResyncPeriod and 2*MinResyncPeriod")
 	fs.BoolVar(&s.EnableProfiling, "profiling", true, "Enable profiling via web interface host:port/debug/pprof/")
 	fs.BoolVar(&s.EnableContentionProfiling, "contention-profiling", false, "Enable lock contention profiling, if profiling is enabled")
+	s.DebugServer.AddFlags(fs)
 	fs.StringVar(&s.TargetKubeconfig, "target-kubeconfig", s.TargetKubeconfig, "Filepath to the target cluster's kubeconfig where node objects are expected to join")
 	fs.StringVar(&s.ControlKubeconfig, "control-kubeconfig", s.ControlKubeconfig, "Filepath to the control cluster's kubeconfig where machine objects would be created. Optionally you could also use 'inClusterConfig' when pod is running inside control kubeconfig. (Default value is same as target-kubeconfig)")
 	fs.StringVar(&s.Namespace, "namespace", s.Namespace, "Name of the namespace in control cluster where controller would look for CRDs and Kubernetes objects")
@@ -96,6 +103,15 @@ func (s *MCMServer) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.SafetyOptions.MachineSafetyOvershootingPeriod.Duration, "machine-safety-overshooting-period", s.SafetyOptions.MachineSafetyOvershootingPeriod.Duration, "Time period (in duration) used to poll for overshooting of machine objects backing a machineSet by safety controller.")
 
 	fs.BoolVar(&s.AutoscalerScaleDownAnnotationDuringRollout, "autoscaler-scaldown-annotation-during-rollout", true, "Add cluster autoscaler scale-down disabled annotation during roll-out.")
+	fs.Int32Var(&s.MaxConcurrentRollouts, "max-concurrent-rollouts", s.MaxConcurrentRollouts, "The maximum number of machine deployments which roll out a new machine set at the same time. Further rollouts wait for a free slot in the order of their rollout-priority annotation. Zero means no limit.")
+
+	fs.Int32Var(&s.WebhookServer.Port, "webhook-port", s.WebhookServer.Port, "The port the admission webhook server for machine.sapcloud.io resources serves on. The webhook server is disabled if it is zero.")
+	fs.StringVar(&s.WebhookServer.CertDir, "webhook-cert-dir", s.WebhookServer.CertDir, "The directory containing the serving certificate tls.crt and its key tls.key of the admission webhook server.")
+	fs.DurationVar(&s.WebhookServer.DefaultMachineCreationTimeout.Duration, "webhook-default-machine-creation-timeout", s.WebhookServer.DefaultMachineCreationTimeout.Duration, "The creation timeout the admission webhook server sets on machines which do not specify one. Zero leaves it unset.")
+	fs.DurationVar(&s.WebhookServer.DefaultMachineHealthTimeout.Duration, "webhook-default-machine-health-timeout", s.WebhookServer.DefaultMachineHealthTimeout.Duration, "The health timeout the admission webhook server sets on machines which do not specify one. Zero leaves it unset.")
+	fs.DurationVar(&s.WebhookServer.DefaultMachineDrainTimeout.Duration, "webhook-default-machine-drain-timeout", s.WebhookServer.DefaultMachineDrainTimeout.Duration, "The drain timeout the admission webhook server sets on machines which do not specify one. Zero leaves it unset.")
+
+	s.Chaos.AddFlags(fs)
 
 	logs.AddFlags(fs) // Here `logs` is `k8s.io/component-base/logs`.
 
@@ -107,6 +123,21 @@ func (s *MCMServer) AddFlags(fs *pflag.FlagSet) {
 // Validate is used to validate the options and config before launching the controller manager
 func (s *MCMServer) Validate() error {
 	var errs []error
+	if s.MaxConcurrentRollouts < 0 {
+		errs = append(errs, fmt.Errorf("max-concurrent-rollouts must not be negative: %d", s.MaxConcurrentRollouts))
+	}
+	if s.WebhookServer.Port < 0 {
+		errs = append(errs, fmt.Errorf("webhook-port must not be negative: %d", s.WebhookServer.Port))
+	}
+	if s.WebhookServer.Port > 0 && s.WebhookServer.CertDir == "" {
+		errs = append(errs, fmt.Errorf("webhook-cert-dir is required if the webhook server is enabled"))
+	}
+	if err := s.DebugServer.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := s.Chaos.Validate(); err != nil {
+		errs = append(errs, err)
+	}
 	// TODO add validation
 	return utilerrors.NewAggregate(errs)
 }
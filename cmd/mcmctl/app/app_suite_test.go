@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinefake "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/fake"
+	"github.com/gardener/machine-controller-manager/pkg/controller"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+const testNamespace = "test"
+
+func TestMcmctl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mcmctl Suite")
+}
+
+// newMachineDeployment returns the machine deployment md with the template of class-b and revision 2, which has
+// rolled out.
+func newMachineDeployment() *v1alpha1.MachineDeployment {
+	return &v1alpha1.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "machine.sapcloud.io/v1alpha1", Kind: "MachineDeployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "md",
+			Namespace:   testNamespace,
+			UID:         "md-uid",
+			Annotations: map[string]string{controller.RevisionAnnotation: "2"},
+		},
+		Spec: v1alpha1.MachineDeploymentSpec{
+			Replicas: 1,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "md"}},
+			Strategy: v1alpha1.MachineDeploymentStrategy{
+				Type: v1alpha1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &v1alpha1.RollingUpdateMachineDeployment{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			Template: newMachineTemplate("class-b"),
+		},
+		Status: v1alpha1.MachineDeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+	}
+}
+
+func newMachineTemplate(class string) v1alpha1.MachineTemplateSpec {
+	return v1alpha1.MachineTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"name": "md"}},
+		Spec:       v1alpha1.MachineSpec{Class: v1alpha1.ClassSpec{Kind: "MachineClass", Name: class}},
+	}
+}
+
+// newMachineSet returns a machine set of the given revision and class, which is controlled by the machine
+// deployment md if controlled is set.
+func newMachineSet(name, revision, class string, replicas int32, controlled bool) *v1alpha1.MachineSet {
+	machineSet := &v1alpha1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   testNamespace,
+			Labels:      map[string]string{"name": "md"},
+			Annotations: map[string]string{controller.RevisionAnnotation: revision},
+		},
+		Spec: v1alpha1.MachineSetSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "md"}},
+			Template: newMachineTemplate(class),
+		},
+		Status: v1alpha1.MachineSetStatus{Replicas: replicas, AvailableReplicas: replicas},
+	}
+	if controlled {
+		machineSet.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "machine.sapcloud.io/v1alpha1",
+			Kind:       "MachineDeployment",
+			Name:       "md",
+			UID:        "md-uid",
+			Controller: ptr.To(true),
+		}}
+	}
+	return machineSet
+}
+
+// newOptions returns options using fake clients with the machine deployment md, its machine sets md-1 of
+// revision 1 and md-2 of revision 2, the machine set other not controlled by it, the machine machine-1 with
+// the ready node node-1 and the machine machine-2 without a node. The target client is only set if withTarget is.
+func newOptions(withTarget bool) *Options {
+	machineObjects := []runtime.Object{
+		newMachineDeployment(),
+		newMachineSet("md-1", "1", "class-a", 0, true),
+		newMachineSet("md-2", "2", "class-b", 1, true),
+		newMachineSet("other", "1", "class-a", 0, false),
+		&v1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machine-1",
+				Namespace: testNamespace,
+				Labels:    map[string]string{"name": "md", v1alpha1.NodeLabelKey: "node-1"},
+			},
+			Spec:   v1alpha1.MachineSpec{ProviderID: "fake://machine-1"},
+			Status: v1alpha1.MachineStatus{CurrentStatus: v1alpha1.CurrentStatus{Phase: v1alpha1.MachineRunning}},
+		},
+		&v1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-2", Namespace: testNamespace, Labels: map[string]string{"name": "md"}},
+			Status:     v1alpha1.MachineStatus{CurrentStatus: v1alpha1.CurrentStatus{Phase: v1alpha1.MachinePending}},
+		},
+	}
+	o := &Options{Namespace: testNamespace, machineClientset: machinefake.NewSimpleClientset(machineObjects...)}
+	if withTarget {
+		o.targetClientset = fake.NewSimpleClientset(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		})
+	}
+	return o
+}
+
+// run executes mcmctl with the arguments and returns its output.
+func run(o *Options, args ...string) (string, error) {
+	var out bytes.Buffer
+	cmd := newCommand(o)
+	cmd.SetArgs(args)
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	err := cmd.ExecuteContext(context.TODO())
+	return out.String(), err
+}
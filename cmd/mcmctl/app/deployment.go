@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newDeploymentCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment",
+		Short: "Operate machine deployments",
+	}
+	cmd.AddCommand(newDeploymentFreezeCommand(o), newDeploymentUnfreezeCommand(o))
+	return cmd
+}
+
+func newDeploymentFreezeCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freeze MACHINEDEPLOYMENT",
+		Short: "Freeze a machine deployment and its machine sets",
+		Long: "Set spec.frozen of a machine deployment and its machine sets like the safety controller does if they have too many " +
+			"machines, which stops their scaling. The safety controller unfreezes them once their number of machines is within the " +
+			"overshooting limits again, or if the machine deployment is unfrozen.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			machineDeployment, err := client.MachineV1alpha1().MachineDeployments(namespace).Get(ctx, args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			selector, err := metav1.LabelSelectorAsSelector(machineDeployment.Spec.Selector)
+			if err != nil {
+				return err
+			}
+			machineSets, err := client.MachineV1alpha1().MachineSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+			if err != nil {
+				return err
+			}
+			patch, err := mergePatch(map[string]interface{}{
+				"spec": map[string]interface{}{"frozen": true},
+			})
+			if err != nil {
+				return err
+			}
+			// The machine sets are frozen first, as the safety controller unfreezes machine deployments without frozen machine sets.
+			for i := range machineSets.Items {
+				machineSet := &machineSets.Items[i]
+				if !metav1.IsControlledBy(machineSet, machineDeployment) {
+					continue
+				}
+				if _, err := client.MachineV1alpha1().MachineSets(namespace).Patch(ctx, machineSet.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+					return err
+				}
+			}
+			if _, err := client.MachineV1alpha1().MachineDeployments(namespace).Patch(ctx, args[0], types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "machine deployment %q frozen\n", args[0])
+			return err
+		},
+	}
+}
+
+func newDeploymentUnfreezeCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unfreeze MACHINEDEPLOYMENT",
+		Short: "Unfreeze a machine deployment and its machine sets",
+		Long:  "Annotate a machine deployment with " + machineutils.UnfreezeAnnotation + ", on which the safety controller unfreezes it and its machine sets.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			patch, err := mergePatch(map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{machineutils.UnfreezeAnnotation: "True"},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := client.MachineV1alpha1().MachineDeployments(namespace).Patch(cmd.Context(), args[0], types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "unfreeze of machine deployment %q requested\n", args[0])
+			return err
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("deployment", func() {
+	type expect struct {
+		err    string
+		output string
+		verify func(o *Options)
+	}
+	type data struct {
+		args   []string
+		expect expect
+	}
+
+	// frozen returns spec.frozen of the machine deployment md and of the machine sets
+	frozen := func(o *Options, machineSets ...string) []bool {
+		md, err := o.machineClientset.MachineV1alpha1().MachineDeployments(testNamespace).Get(context.TODO(), "md", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		result := []bool{md.Spec.Frozen}
+		for _, name := range machineSets {
+			ms, err := o.machineClientset.MachineV1alpha1().MachineSets(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			result = append(result, ms.Spec.Frozen)
+		}
+		return result
+	}
+
+	DescribeTable("##table",
+		func(data *data) {
+			o := newOptions(false)
+			output, err := run(o, data.args...)
+			if data.expect.err != "" {
+				Expect(err).To(MatchError(ContainSubstring(data.expect.err)))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(ContainSubstring(data.expect.output))
+			if data.expect.verify != nil {
+				data.expect.verify(o)
+			}
+		},
+		Entry("should freeze a machine deployment and its machine sets", &data{
+			args: []string{"deployment", "freeze", "md"},
+			expect: expect{
+				output: `machine deployment "md" frozen`,
+				verify: func(o *Options) {
+					Expect(frozen(o, "md-1", "md-2")).To(HaveEach(BeTrue()))
+					Expect(frozen(o, "other")[1]).To(BeFalse())
+				},
+			},
+		}),
+		Entry("should fail to freeze a machine deployment which does not exist", &data{
+			args:   []string{"deployment", "freeze", "missing"},
+			expect: expect{err: `"missing" not found`},
+		}),
+		Entry("should annotate a machine deployment with the unfreeze annotation", &data{
+			args: []string{"deployment", "unfreeze", "md"},
+			expect: expect{
+				output: `unfreeze of machine deployment "md" requested`,
+				verify: func(o *Options) {
+					md, err := o.machineClientset.MachineV1alpha1().MachineDeployments(testNamespace).Get(context.TODO(), "md", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(md.Annotations).To(HaveKeyWithValue(machineutils.UnfreezeAnnotation, "True"))
+				},
+			},
+		}),
+		Entry("should require the machine deployment", &data{
+			args:   []string{"deployment", "freeze"},
+			expect: expect{err: "accepts 1 arg(s), received 0"},
+		}),
+	)
+})
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// machineInfo is the joined view of a machine, its node and its VM.
+type machineInfo struct {
+	Name              string                  `json:"name"`
+	Node              string                  `json:"node,omitempty"`
+	ProviderID        string                  `json:"providerID,omitempty"`
+	Phase             v1alpha1.MachinePhase   `json:"phase,omitempty"`
+	NodeReady         *corev1.ConditionStatus `json:"nodeReady,omitempty"`
+	LastOperation     v1alpha1.LastOperation  `json:"lastOperation"`
+	CreationTimestamp metav1.Time             `json:"creationTimestamp"`
+}
+
+func newMachineCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "machine",
+		Short: "Inspect and operate machines",
+	}
+	cmd.AddCommand(newMachineListCommand(o), newMachineTriggerDeletionCommand(o), newMachineSetPriorityCommand(o), newMachineForceDeleteCommand(o))
+	return cmd
+}
+
+func newMachineListCommand(o *Options) *cobra.Command {
+	var (
+		output   = outputTable
+		selector string
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the machines together with their nodes and VMs",
+		Long:  "List the machines together with their nodes and VMs. The readiness of the nodes is only shown if --target-kubeconfig is set.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+			machines, err := o.listMachines(cmd.Context(), selector)
+			if err != nil {
+				return err
+			}
+			if output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), machines)
+			}
+			return printMachines(cmd.OutOrStdout(), machines)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", output, "Output format, either table or json.")
+	cmd.Flags().StringVarP(&selector, "selector", "l", selector, "Label selector to filter the machines on.")
+	return cmd
+}
+
+func newMachineTriggerDeletionCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trigger-deletion MACHINE",
+		Short: "Let the machine-controller-manager delete a machine through its node",
+		Long: "Annotate the node of a machine with " + machineutils.TriggerDeletionByMCM + "=true, on which the machine-controller-manager " +
+			"deletes the machine and scales down its machine deployment. Requires --target-kubeconfig.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			targetClient, err := o.targetClient()
+			if err != nil {
+				return err
+			}
+			if targetClient == nil {
+				return fmt.Errorf("--target-kubeconfig is required to annotate the node")
+			}
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			machine, err := client.MachineV1alpha1().Machines(namespace).Get(ctx, args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			nodeName := machine.Labels[v1alpha1.NodeLabelKey]
+			if nodeName == "" {
+				return fmt.Errorf("machine %q has no node", machine.Name)
+			}
+			patch, err := mergePatch(map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{machineutils.TriggerDeletionByMCM: "true"},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := targetClient.CoreV1().Nodes().Patch(ctx, nodeName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "node %q of machine %q annotated for deletion\n", nodeName, machine.Name)
+			return err
+		},
+	}
+}
+
+func newMachineSetPriorityCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-priority MACHINE PRIORITY",
+		Short: "Set the deletion priority of a machine",
+		Long:  "Set the deletion priority of a machine. Machines with a lower priority are deleted first on a scale-down, 1 marks a machine for deletion first.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			priority, err := strconv.ParseInt(args[1], 10, 32)
+			if err != nil || priority < 1 {
+				return fmt.Errorf("invalid priority %q: must be a positive integer", args[1])
+			}
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			// The legacy annotation is updated as well, as the lower of the annotation and the spec field takes effect.
+			patch, err := mergePatch(map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{machineutils.MachinePriority: strconv.FormatInt(priority, 10)},
+				},
+				"spec": map[string]interface{}{"deletionPriority": priority},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := client.MachineV1alpha1().Machines(namespace).Patch(cmd.Context(), args[0], types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "machine %q deletion priority set to %d\n", args[0], priority)
+			return err
+		},
+	}
+}
+
+func newMachineForceDeleteCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force-delete MACHINE",
+		Short: "Delete a machine without evicting the pods of its node",
+		Long: "Label a machine with " + machineutils.ForceDeletion + "=True and delete it. The machine-controller-manager then deletes " +
+			"the pods of the node instead of evicting them, ignoring pod disruption budgets.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			patch, err := mergePatch(map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{machineutils.ForceDeletion: "True"},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := client.MachineV1alpha1().Machines(namespace).Patch(ctx, args[0], types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			if err := client.MachineV1alpha1().Machines(namespace).Delete(ctx, args[0], metav1.DeleteOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "machine %q force deleted\n", args[0])
+			return err
+		},
+	}
+}
+
+func (o *Options) listMachines(ctx context.Context, selector string) ([]machineInfo, error) {
+	client, namespace, err := o.machineClient()
+	if err != nil {
+		return nil, err
+	}
+	targetClient, err := o.targetClient()
+	if err != nil {
+		return nil, err
+	}
+	machines, err := client.MachineV1alpha1().Machines(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	nodeReady := map[string]corev1.ConditionStatus{}
+	if targetClient != nil {
+		nodes, err := targetClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes.Items {
+			nodeReady[node.Name] = corev1.ConditionUnknown
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == corev1.NodeReady {
+					nodeReady[node.Name] = condition.Status
+				}
+			}
+		}
+	}
+
+	infos := make([]machineInfo, 0, len(machines.Items))
+	for _, machine := range machines.Items {
+		info := machineInfo{
+			Name:              machine.Name,
+			Node:              machine.Labels[v1alpha1.NodeLabelKey],
+			ProviderID:        machine.Spec.ProviderID,
+			Phase:             machine.Status.CurrentStatus.Phase,
+			LastOperation:     machine.Status.LastOperation,
+			CreationTimestamp: machine.CreationTimestamp,
+		}
+		if ready, ok := nodeReady[info.Node]; ok {
+			info.NodeReady = &ready
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func printMachines(out io.Writer, machines []machineInfo) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tNODE\tPROVIDERID\tPHASE\tNODEREADY\tLASTOPERATION\tAGE")
+	for _, m := range machines {
+		ready := "<none>"
+		if m.NodeReady != nil {
+			ready = string(*m.NodeReady)
+		}
+		lastOperation := "<none>"
+		if m.LastOperation.Type != "" {
+			lastOperation = fmt.Sprintf("%s/%s", m.LastOperation.Type, m.LastOperation.State)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			m.Name, valueOrNone(m.Node), valueOrNone(m.ProviderID), valueOrNone(string(m.Phase)), ready, lastOperation,
+			duration.HumanDuration(time.Since(m.CreationTimestamp.Time)))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("machine", func() {
+	type setup struct {
+		args       []string
+		withTarget bool
+	}
+	type expect struct {
+		err    string
+		output []string
+		verify func(o *Options)
+	}
+	type data struct {
+		setup  setup
+		expect expect
+	}
+
+	DescribeTable("##table",
+		func(data *data) {
+			o := newOptions(data.setup.withTarget)
+			output, err := run(o, data.setup.args...)
+			if data.expect.err != "" {
+				Expect(err).To(MatchError(ContainSubstring(data.expect.err)))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			for _, expected := range data.expect.output {
+				Expect(output).To(ContainSubstring(expected))
+			}
+			if data.expect.verify != nil {
+				data.expect.verify(o)
+			}
+		},
+		Entry("should list the machines without the readiness of their nodes", &data{
+			setup: setup{args: []string{"machine", "list"}},
+			expect: expect{
+				output: []string{"NAME", "machine-1   node-1   fake://machine-1   Running   <none>", "machine-2   <none>"},
+			},
+		}),
+		Entry("should list the machines with the readiness of their nodes", &data{
+			setup: setup{args: []string{"machine", "list", "-o", "json"}, withTarget: true},
+			expect: expect{
+				output: []string{`"name": "machine-1"`, `"node": "node-1"`, `"nodeReady": "True"`, `"name": "machine-2"`},
+			},
+		}),
+		Entry("should fail on an unsupported output format", &data{
+			setup:  setup{args: []string{"machine", "list", "-o", "yaml"}},
+			expect: expect{err: `unsupported output format "yaml"`},
+		}),
+		Entry("should annotate the node of a machine for deletion", &data{
+			setup: setup{args: []string{"machine", "trigger-deletion", "machine-1"}, withTarget: true},
+			expect: expect{
+				output: []string{`node "node-1" of machine "machine-1" annotated for deletion`},
+				verify: func(o *Options) {
+					node, err := o.targetClientset.CoreV1().Nodes().Get(context.TODO(), "node-1", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(node.Annotations).To(HaveKeyWithValue(machineutils.TriggerDeletionByMCM, "true"))
+				},
+			},
+		}),
+		Entry("should require the target kubeconfig to trigger the deletion of a machine", &data{
+			setup:  setup{args: []string{"machine", "trigger-deletion", "machine-1"}},
+			expect: expect{err: "--target-kubeconfig is required"},
+		}),
+		Entry("should fail to trigger the deletion of a machine without a node", &data{
+			setup:  setup{args: []string{"machine", "trigger-deletion", "machine-2"}, withTarget: true},
+			expect: expect{err: `machine "machine-2" has no node`},
+		}),
+		Entry("should set the deletion priority of a machine", &data{
+			setup: setup{args: []string{"machine", "set-priority", "machine-1", "1"}},
+			expect: expect{
+				output: []string{`machine "machine-1" deletion priority set to 1`},
+				verify: func(o *Options) {
+					machine, err := o.machineClientset.MachineV1alpha1().Machines(testNamespace).Get(context.TODO(), "machine-1", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(machine.Spec.DeletionPriority).To(Equal(ptr.To[int32](1)))
+					Expect(machine.Annotations).To(HaveKeyWithValue(machineutils.MachinePriority, "1"))
+				},
+			},
+		}),
+		Entry("should fail on an invalid deletion priority", &data{
+			setup:  setup{args: []string{"machine", "set-priority", "machine-1", "0"}},
+			expect: expect{err: `invalid priority "0"`},
+		}),
+		Entry("should label a machine for force deletion and delete it", &data{
+			setup: setup{args: []string{"machine", "force-delete", "machine-1"}},
+			expect: expect{
+				output: []string{`machine "machine-1" force deleted`},
+				verify: func(o *Options) {
+					_, err := o.machineClientset.MachineV1alpha1().Machines(testNamespace).Get(context.TODO(), "machine-1", metav1.GetOptions{})
+					Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				},
+			},
+		}),
+	)
+})
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package app implements mcmctl, a command line tool to inspect and operate machines managed by the machine-controller-manager
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// Options are the options common to all mcmctl commands.
+type Options struct {
+	// Kubeconfig is the path to the kubeconfig of the cluster hosting the machine objects.
+	Kubeconfig string
+	// Namespace is the namespace of the machine objects.
+	Namespace string
+	// TargetKubeconfig is the path to the kubeconfig of the cluster hosting the nodes.
+	TargetKubeconfig string
+
+	// machineClientset and targetClientset replace the clients built from the kubeconfigs if set.
+	machineClientset versioned.Interface
+	targetClientset  kubernetes.Interface
+}
+
+// AddFlags adds flags for the options to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to the kubeconfig of the cluster hosting the machine objects. Defaults to the KUBECONFIG environment variable or ~/.kube/config.")
+	fs.StringVarP(&o.Namespace, "namespace", "n", o.Namespace, "Namespace of the machine objects. Defaults to the namespace of the current kubeconfig context.")
+	fs.StringVar(&o.TargetKubeconfig, "target-kubeconfig", o.TargetKubeconfig, "Path to the kubeconfig of the cluster hosting the nodes. Required to act on nodes, and nodes are only shown if it is set.")
+}
+
+// machineClient returns a client for the machine objects and the namespace to operate in.
+func (o *Options) machineClient() (versioned.Interface, string, error) {
+	if o.machineClientset != nil {
+		return o.machineClientset, o.Namespace, nil
+	}
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.Kubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{})
+
+	namespace := o.Namespace
+	if namespace == "" {
+		var err error
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return nil, "", err
+		}
+	}
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, namespace, nil
+}
+
+// targetClient returns a client for the nodes, or nil if no kubeconfig of the cluster hosting them is set.
+func (o *Options) targetClient() (kubernetes.Interface, error) {
+	if o.targetClientset != nil {
+		return o.targetClientset, nil
+	}
+	if o.TargetKubeconfig == "" {
+		return nil, nil
+	}
+	config, err := clientcmd.BuildConfigFromFlags("", o.TargetKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// NewCommand returns the mcmctl root command.
+func NewCommand() *cobra.Command {
+	return newCommand(&Options{})
+}
+
+func newCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "mcmctl",
+		Short:        "mcmctl inspects and operates machines managed by the machine-controller-manager",
+		SilenceUsage: true,
+	}
+	o.AddFlags(cmd.PersistentFlags())
+	cmd.AddCommand(newMachineCommand(o), newDeploymentCommand(o), newRolloutCommand(o))
+	return cmd
+}
+
+// mergePatch returns a JSON merge patch of the given object. Keys with a nil value are removed by the patch.
+func mergePatch(patch map[string]interface{}) ([]byte, error) {
+	return json.Marshal(patch)
+}
+
+func validateOutput(output string) error {
+	if output != outputTable && output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, use %q or %q", output, outputTable, outputJSON)
+	}
+	return nil
+}
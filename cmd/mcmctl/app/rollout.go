@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newRolloutCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Inspect and manage the rollouts of a machine deployment",
+	}
+	cmd.AddCommand(newRolloutHistoryCommand(o), newRolloutDiffCommand(o), newRolloutStatusCommand(o), newRolloutUndoCommand(o), newRolloutSimulateCommand(o))
+	return cmd
+}
+
+func newRolloutHistoryCommand(o *Options) *cobra.Command {
+	output := outputTable
+	cmd := &cobra.Command{
+		Use:   "history MACHINEDEPLOYMENT",
+		Short: "List the revisions of a machine deployment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+			history, err := o.machineDeploymentHistory(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), history)
+			}
+			return printHistory(cmd.OutOrStdout(), history)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", output, "Output format, either table or json.")
+	return cmd
+}
+
+func newRolloutDiffCommand(o *Options) *cobra.Command {
+	output := outputTable
+	cmd := &cobra.Command{
+		Use:   "diff MACHINEDEPLOYMENT FROM_REVISION [TO_REVISION]",
+		Short: "Show the changes of the machine template between two revisions of a machine deployment",
+		Long:  "Show the changes of the machine template between two revisions of a machine deployment. TO_REVISION defaults to the latest revision.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+			from, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid revision %q: %v", args[1], err)
+			}
+			var to int64
+			if len(args) == 3 {
+				if to, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+					return fmt.Errorf("invalid revision %q: %v", args[2], err)
+				}
+			}
+			history, err := o.machineDeploymentHistory(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			changes, err := controller.DiffMachineDeploymentRevisions(history, from, to)
+			if err != nil {
+				return err
+			}
+			if output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), changes)
+			}
+			return printChanges(cmd.OutOrStdout(), changes)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", output, "Output format, either table or json.")
+	return cmd
+}
+
+func newRolloutStatusCommand(o *Options) *cobra.Command {
+	var (
+		watch    bool
+		interval = 5 * time.Second
+	)
+	cmd := &cobra.Command{
+		Use:   "status MACHINEDEPLOYMENT",
+		Short: "Show the status of the rollout of a machine deployment",
+		Long:  "Show the status of the rollout of a machine deployment. With --watch, wait until the rollout finished and fail if it exceeded its progress deadline.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			var last string
+			for {
+				deployment, err := client.MachineV1alpha1().MachineDeployments(namespace).Get(ctx, args[0], metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				status, done, err := controller.MachineDeploymentRolloutStatus(deployment)
+				if err != nil {
+					return err
+				}
+				if status != last {
+					if _, err := fmt.Fprintln(cmd.OutOrStdout(), status); err != nil {
+						return err
+					}
+					last = status
+				}
+				if done || !watch {
+					return nil
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(interval):
+				}
+			}
+		},
+	}
+	cmd.Flags().BoolVarP(&watch, "watch", "w", watch, "Watch the status of the rollout until it is done.")
+	cmd.Flags().DurationVar(&interval, "interval", interval, "Interval in which the status is polled with --watch.")
+	return cmd
+}
+
+func newRolloutUndoCommand(o *Options) *cobra.Command {
+	var toRevision int64
+	cmd := &cobra.Command{
+		Use:   "undo MACHINEDEPLOYMENT",
+		Short: "Roll back a machine deployment to a previous revision",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if toRevision < 0 {
+				return fmt.Errorf("invalid revision %d: must not be negative", toRevision)
+			}
+			client, namespace, err := o.machineClient()
+			if err != nil {
+				return err
+			}
+			patch, err := mergePatch(map[string]interface{}{
+				"spec": map[string]interface{}{
+					"rollbackTo": map[string]interface{}{"revision": toRevision},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := client.MachineV1alpha1().MachineDeployments(namespace).Patch(cmd.Context(), args[0], types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "machine deployment %q rolled back\n", args[0])
+			return err
+		},
+	}
+	cmd.Flags().Int64Var(&toRevision, "to-revision", toRevision, "Revision to roll back to. Defaults to the previous revision.")
+	return cmd
+}
+
+func (o *Options) machineDeploymentHistory(ctx context.Context, name string) ([]controller.MachineDeploymentRevision, error) {
+	client, namespace, err := o.machineClient()
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := client.MachineV1alpha1().MachineDeployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	machineSets, err := client.MachineV1alpha1().MachineSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	isList := make([]*v1alpha1.MachineSet, 0, len(machineSets.Items))
+	for i := range machineSets.Items {
+		isList = append(isList, &machineSets.Items[i])
+	}
+	return controller.MachineDeploymentHistory(deployment, isList), nil
+}
+
+func printHistory(out io.Writer, history []controller.MachineDeploymentRevision) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tMACHINESET\tCREATED\tMACHINECLASS\tREPLICAS\tAVAILABLE\tCOMPLETE\tNODETEMPLATE\tCONFIGURATION")
+	for _, r := range history {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s/%s\t%d\t%d\t%t\t%s\t%s\n",
+			r.Revision, r.MachineSetName, r.CreationTimestamp.UTC().Format(time.RFC3339), r.Class.Kind, r.Class.Name,
+			r.Replicas, r.AvailableReplicas, r.Complete, valueOrNone(r.NodeTemplate), valueOrNone(r.MachineConfiguration))
+	}
+	return w.Flush()
+}
+
+func printChanges(out io.Writer, changes []controller.MachineTemplateChange) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(out, "No changes.")
+		return err
+	}
+	for _, change := range changes {
+		from, err := formatValue(change.From)
+		if err != nil {
+			return err
+		}
+		to, err := formatValue(change.To)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "%s: %s -> %s\n", change.Path, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func formatValue(v interface{}) (string, error) {
+	if v == nil {
+		return "<none>", nil
+	}
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+func newRolloutSimulateCommand(o *Options) *cobra.Command {
+	var (
+		files       []string
+		fromCluster bool
+		options     = controller.RolloutSimulationOptions{
+			Step:                controller.DefaultRolloutSimulationStep,
+			MachineCreationTime: controller.DefaultRolloutSimulationMachineCreationTime,
+			MaxSteps:            controller.DefaultRolloutSimulationMaxSteps,
+		}
+		output = outputTable
+	)
+	cmd := &cobra.Command{
+		Use:   "simulate MACHINEDEPLOYMENT",
+		Short: "Predict the rollout of a change of a machine deployment",
+		Long: `Predict the rollout of a change of a machine deployment without applying it. The machine deployments, machine sets,
+machines, nodes, pods and pod disruption budgets are read from the files given with -f and, with --from-cluster, from
+the clusters. Objects of the files replace the ones of the clusters, so the changed machine deployment is usually
+passed with -f. The deployment and machine set controllers then reconcile the snapshot on a simulated clock, and the
+timeline of the machines created and deleted, the peak surge and the drains blocking on pod disruption budgets are
+printed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+			if len(files) == 0 && !fromCluster {
+				return errors.New("either files with -f or --from-cluster are required")
+			}
+			snapshot, namespace, err := o.rolloutSnapshot(cmd.Context(), args[0], files, fromCluster)
+			if err != nil {
+				return err
+			}
+			simulation, err := controller.SimulateRollout(snapshot, namespace, args[0], options)
+			if err != nil {
+				return err
+			}
+			if output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), simulation)
+			}
+			return printRolloutSimulation(cmd.OutOrStdout(), simulation)
+		},
+	}
+	cmd.Flags().StringSliceVarP(&files, "filename", "f", files, "YAML or JSON files with the objects of the snapshot, which may contain several documents and lists.")
+	cmd.Flags().BoolVar(&fromCluster, "from-cluster", fromCluster, "Read the snapshot from the clusters. Nodes, pods and pod disruption budgets are only read if --target-kubeconfig is set.")
+	cmd.Flags().DurationVar(&options.Step, "step", options.Step, "Simulated time between two reconciliations.")
+	cmd.Flags().DurationVar(&options.MachineCreationTime, "machine-creation-time", options.MachineCreationTime, "Simulated time a machine takes from its creation until it is running.")
+	cmd.Flags().IntVar(&options.MaxSteps, "max-steps", options.MaxSteps, "Number of steps after which the simulation gives up.")
+	cmd.Flags().StringVarP(&output, "output", "o", output, "Output format, either table or json.")
+	return cmd
+}
+
+// rolloutSnapshot reads the snapshot of the simulation and returns it together with the namespace of the machine
+// objects.
+func (o *Options) rolloutSnapshot(ctx context.Context, name string, files []string, fromCluster bool) (*controller.RolloutSnapshot, string, error) {
+	fileSnapshot := &controller.RolloutSnapshot{}
+	for _, file := range files {
+		if err := readSnapshotFile(file, fileSnapshot); err != nil {
+			return nil, "", fmt.Errorf("failed to read %s: %v", file, err)
+		}
+	}
+
+	namespace := o.Namespace
+	snapshot := &controller.RolloutSnapshot{}
+	if fromCluster {
+		var err error
+		if snapshot, namespace, err = o.clusterSnapshot(ctx); err != nil {
+			return nil, "", err
+		}
+	} else if namespace == "" {
+		namespace = metav1.NamespaceDefault
+		for _, d := range fileSnapshot.MachineDeployments {
+			if d.Name == name && d.Namespace != "" {
+				namespace = d.Namespace
+			}
+		}
+	}
+	mergeSnapshot(snapshot, fileSnapshot, namespace)
+	return snapshot, namespace, nil
+}
+
+// clusterSnapshot reads the machine objects and, if the target cluster is configured, the nodes, pods and pod
+// disruption budgets.
+func (o *Options) clusterSnapshot(ctx context.Context) (*controller.RolloutSnapshot, string, error) {
+	client, namespace, err := o.machineClient()
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot := &controller.RolloutSnapshot{}
+	machineDeployments, err := client.MachineV1alpha1().MachineDeployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.MachineDeployments = machineDeployments.Items
+	machineSets, err := client.MachineV1alpha1().MachineSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.MachineSets = machineSets.Items
+	machines, err := client.MachineV1alpha1().Machines(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.Machines = machines.Items
+
+	targetClient, err := o.targetClient()
+	if err != nil || targetClient == nil {
+		return snapshot, namespace, err
+	}
+	nodes, err := targetClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.Nodes = nodes.Items
+	pods, err := targetClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.Pods = pods.Items
+	pdbs, err := targetClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	snapshot.PodDisruptionBudgets = pdbs.Items
+	return snapshot, namespace, nil
+}
+
+// readSnapshotFile adds the objects of the file to the snapshot. Objects of other kinds are ignored.
+func readSnapshotFile(file string, snapshot *controller.RolloutSnapshot) error {
+	f, err := os.Open(file) // #nosec G304 -- the files are passed by the user on purpose
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if len(raw.Raw) == 0 {
+			continue
+		}
+		if err := addSnapshotObject(raw.Raw, snapshot); err != nil {
+			return err
+		}
+	}
+}
+
+func addSnapshotObject(data []byte, snapshot *controller.RolloutSnapshot) error {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return err
+	}
+	var target interface{}
+	switch typeMeta.Kind {
+	case "MachineDeployment":
+		snapshot.MachineDeployments = append(snapshot.MachineDeployments, v1alpha1.MachineDeployment{})
+		target = &snapshot.MachineDeployments[len(snapshot.MachineDeployments)-1]
+	case "MachineSet":
+		snapshot.MachineSets = append(snapshot.MachineSets, v1alpha1.MachineSet{})
+		target = &snapshot.MachineSets[len(snapshot.MachineSets)-1]
+	case "Machine":
+		snapshot.Machines = append(snapshot.Machines, v1alpha1.Machine{})
+		target = &snapshot.Machines[len(snapshot.Machines)-1]
+	case "Node":
+		snapshot.Nodes = append(snapshot.Nodes, corev1.Node{})
+		target = &snapshot.Nodes[len(snapshot.Nodes)-1]
+	case "Pod":
+		snapshot.Pods = append(snapshot.Pods, corev1.Pod{})
+		target = &snapshot.Pods[len(snapshot.Pods)-1]
+	case "PodDisruptionBudget":
+		snapshot.PodDisruptionBudgets = append(snapshot.PodDisruptionBudgets, policyv1.PodDisruptionBudget{})
+		target = &snapshot.PodDisruptionBudgets[len(snapshot.PodDisruptionBudgets)-1]
+	default:
+		if !strings.HasSuffix(typeMeta.Kind, "List") {
+			return nil
+		}
+		var list struct {
+			Items []runtime.RawExtension `json:"items"`
+		}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		for _, item := range list.Items {
+			if err := addSnapshotObject(item.Raw, snapshot); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return json.Unmarshal(data, target)
+}
+
+// mergeSnapshot adds the objects read from files to the snapshot, replacing the objects of the same kind and name.
+// Namespaced objects of the files without a namespace are put into the namespace of the machine objects, or into the
+// default namespace for pods and pod disruption budgets.
+func mergeSnapshot(snapshot, files *controller.RolloutSnapshot, namespace string) {
+	for _, d := range files.MachineDeployments {
+		d.Namespace = valueOrDefault(d.Namespace, namespace)
+		snapshot.MachineDeployments = replaceOrAppend(snapshot.MachineDeployments, d, func(o v1alpha1.MachineDeployment) bool {
+			return o.Namespace == d.Namespace && o.Name == d.Name
+		})
+	}
+	for _, is := range files.MachineSets {
+		is.Namespace = valueOrDefault(is.Namespace, namespace)
+		snapshot.MachineSets = replaceOrAppend(snapshot.MachineSets, is, func(o v1alpha1.MachineSet) bool {
+			return o.Namespace == is.Namespace && o.Name == is.Name
+		})
+	}
+	for _, m := range files.Machines {
+		m.Namespace = valueOrDefault(m.Namespace, namespace)
+		snapshot.Machines = replaceOrAppend(snapshot.Machines, m, func(o v1alpha1.Machine) bool {
+			return o.Namespace == m.Namespace && o.Name == m.Name
+		})
+	}
+	for _, n := range files.Nodes {
+		snapshot.Nodes = replaceOrAppend(snapshot.Nodes, n, func(o corev1.Node) bool {
+			return o.Name == n.Name
+		})
+	}
+	for _, p := range files.Pods {
+		p.Namespace = valueOrDefault(p.Namespace, metav1.NamespaceDefault)
+		snapshot.Pods = replaceOrAppend(snapshot.Pods, p, func(o corev1.Pod) bool {
+			return o.Namespace == p.Namespace && o.Name == p.Name
+		})
+	}
+	for _, pdb := range files.PodDisruptionBudgets {
+		pdb.Namespace = valueOrDefault(pdb.Namespace, metav1.NamespaceDefault)
+		snapshot.PodDisruptionBudgets = replaceOrAppend(snapshot.PodDisruptionBudgets, pdb, func(o policyv1.PodDisruptionBudget) bool {
+			return o.Namespace == pdb.Namespace && o.Name == pdb.Name
+		})
+	}
+}
+
+func replaceOrAppend[T any](objects []T, object T, matches func(T) bool) []T {
+	for i := range objects {
+		if matches(objects[i]) {
+			objects[i] = object
+			return objects
+		}
+	}
+	return append(objects, object)
+}
+
+func valueOrDefault(s, defaultValue string) string {
+	if s == "" {
+		return defaultValue
+	}
+	return s
+}
+
+func printRolloutSimulation(out io.Writer, simulation *controller.RolloutSimulation) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ELAPSED\tCREATED\tDELETED\tMACHINES\tAVAILABLE\tSURGE\tBLOCKED DRAINS")
+	for _, step := range simulation.Steps {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+			step.Elapsed.Duration, valueOrNone(strings.Join(step.Created, ",")), valueOrNone(strings.Join(step.Deleted, ",")),
+			step.Machines, step.Available, step.Surge, len(step.BlockedDrains))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	var blocked []controller.BlockedDrain
+	for _, step := range simulation.Steps {
+		blocked = append(blocked, step.BlockedDrains...)
+	}
+	if len(blocked) > 0 {
+		fmt.Fprintln(out)
+		w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "MACHINE\tNODE\tPODDISRUPTIONBUDGET\tPODS\tREASON")
+		for _, b := range blocked {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", b.Machine, b.Node, valueOrNone(b.PodDisruptionBudget), valueOrNone(strings.Join(b.Pods, ",")), b.Reason)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	result := "complete"
+	if !simulation.Complete {
+		result = "not complete"
+	}
+	_, err := fmt.Fprintf(out, "\nRollout %s after %s, peak surge %d, peak unavailable %d, %d blocked drains.\n",
+		result, simulation.Duration.Duration, simulation.PeakSurge, simulation.PeakUnavailable, len(blocked))
+	return err
+}
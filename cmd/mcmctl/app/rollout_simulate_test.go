@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rollout simulate", func() {
+	type setup struct {
+		args []string
+		// files are written to a temporary directory and passed with -f
+		files map[string]string
+	}
+	type expect struct {
+		err    string
+		output []string
+	}
+	type data struct {
+		setup  setup
+		expect expect
+	}
+
+	// changedMachineDeployment returns the machine deployment md with the template of class-c as json
+	changedMachineDeployment := func() string {
+		md := newMachineDeployment()
+		md.Spec.Template = newMachineTemplate("class-c")
+		b, err := json.Marshal(md)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	DescribeTable("##table",
+		func(data *data) {
+			args := data.setup.args
+			dir := GinkgoT().TempDir()
+			for name, content := range data.setup.files {
+				file := filepath.Join(dir, name)
+				Expect(os.WriteFile(file, []byte(content), 0600)).To(Succeed())
+				args = append(args, "-f", file)
+			}
+			output, err := run(newOptions(false), args...)
+			if data.expect.err != "" {
+				Expect(err).To(MatchError(ContainSubstring(data.expect.err)))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			for _, expected := range data.expect.output {
+				Expect(output).To(ContainSubstring(expected))
+			}
+		},
+		Entry("should simulate the rollout of a changed machine deployment of the cluster", &data{
+			setup: setup{
+				args:  []string{"rollout", "simulate", "md", "--from-cluster"},
+				files: map[string]string{"md.json": changedMachineDeployment()},
+			},
+			expect: expect{output: []string{"ELAPSED", "peak surge 1,"}},
+		}),
+		Entry("should simulate the rollout of the objects of the files only", &data{
+			setup: setup{
+				args:  []string{"rollout", "simulate", "md", "-o", "json"},
+				files: map[string]string{"md.json": changedMachineDeployment()},
+			},
+			expect: expect{output: []string{`"machineDeployment": "md"`, `"complete": true`}},
+		}),
+		Entry("should read lists of objects from yaml files", &data{
+			setup: setup{
+				args:  []string{"rollout", "simulate", "md"},
+				files: map[string]string{"md.yaml": "apiVersion: v1\nkind: List\nitems:\n- " + changedMachineDeployment() + "\n"},
+			},
+			expect: expect{output: []string{"Rollout complete"}},
+		}),
+		Entry("should require files or the cluster", &data{
+			setup:  setup{args: []string{"rollout", "simulate", "md"}},
+			expect: expect{err: "either files with -f or --from-cluster are required"},
+		}),
+		Entry("should fail on a file which does not exist", &data{
+			setup:  setup{args: []string{"rollout", "simulate", "md", "-f", "missing.yaml"}},
+			expect: expect{err: "failed to read missing.yaml"},
+		}),
+		Entry("should fail if the machine deployment is not part of the snapshot", &data{
+			setup: setup{
+				args:  []string{"rollout", "simulate", "other"},
+				files: map[string]string{"md.json": changedMachineDeployment()},
+			},
+			expect: expect{err: "other"},
+		}),
+	)
+})
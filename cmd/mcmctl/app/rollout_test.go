@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("rollout", func() {
+	type expect struct {
+		err    string
+		output []string
+		verify func(o *Options)
+	}
+	type data struct {
+		args   []string
+		expect expect
+	}
+
+	DescribeTable("##table",
+		func(data *data) {
+			o := newOptions(false)
+			output, err := run(o, data.args...)
+			if data.expect.err != "" {
+				Expect(err).To(MatchError(ContainSubstring(data.expect.err)))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			for _, expected := range data.expect.output {
+				Expect(output).To(ContainSubstring(expected))
+			}
+			if data.expect.verify != nil {
+				data.expect.verify(o)
+			}
+		},
+		Entry("should list the revisions of the machine sets controlled by a machine deployment", &data{
+			args: []string{"rollout", "history", "md"},
+			expect: expect{
+				output: []string{"REVISION", "1          md-1", "2          md-2"},
+			},
+		}),
+		Entry("should list the revisions of a machine deployment as json", &data{
+			args: []string{"rollout", "history", "md", "-o", "json"},
+			expect: expect{
+				output: []string{`"revision": 1`, `"machineSetName": "md-1"`, `"revision": 2`, `"machineSetName": "md-2"`},
+			},
+		}),
+		Entry("should show the changes of the machine template to the latest revision", &data{
+			args: []string{"rollout", "diff", "md", "1"},
+			expect: expect{
+				output: []string{`spec.class.name: "class-a" -> "class-b"`},
+			},
+		}),
+		Entry("should show no changes between the same revisions", &data{
+			args: []string{"rollout", "diff", "md", "2", "2"},
+			expect: expect{
+				output: []string{"No changes."},
+			},
+		}),
+		Entry("should fail to diff a revision which does not exist", &data{
+			args:   []string{"rollout", "diff", "md", "3"},
+			expect: expect{err: "revision 3 not found"},
+		}),
+		Entry("should fail to diff an invalid revision", &data{
+			args:   []string{"rollout", "diff", "md", "latest"},
+			expect: expect{err: `invalid revision "latest"`},
+		}),
+		Entry("should show the status of a rolled out machine deployment", &data{
+			args: []string{"rollout", "status", "md", "--watch"},
+			expect: expect{
+				output: []string{`machine deployment "md" successfully rolled out`},
+			},
+		}),
+		Entry("should roll back a machine deployment to a revision", &data{
+			args: []string{"rollout", "undo", "md", "--to-revision", "1"},
+			expect: expect{
+				output: []string{`machine deployment "md" rolled back`},
+				verify: func(o *Options) {
+					md, err := o.machineClientset.MachineV1alpha1().MachineDeployments(testNamespace).Get(context.TODO(), "md", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(md.Spec.RollbackTo).ToNot(BeNil())
+					Expect(md.Spec.RollbackTo.Revision).To(Equal(int64(1)))
+				},
+			},
+		}),
+		Entry("should fail to roll back to a negative revision", &data{
+			args:   []string{"rollout", "undo", "md", "--to-revision", "-1"},
+			expect: expect{err: "invalid revision -1"},
+		}),
+	)
+})
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/gardener/machine-controller-manager/cmd/mcmctl/app"
+)
+
+func main() {
+	if err := app.NewCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -24,6 +24,7 @@ GO_ADD_LICENSE ?= $(TOOLS_BIN_DIR)/addlicense
 GOIMPORTS ?= $(TOOLS_BIN_DIR)/goimports
 GOLANGCI_LINT ?= $(TOOLS_BIN_DIR)/golangci-lint
 GOSEC ?= $(TOOLS_BIN_DIR)/gosec
+SETUP_ENVTEST ?= $(TOOLS_BIN_DIR)/setup-envtest
 
 ## Tool Versions
 CODE_GENERATOR_VERSION ?= v0.31.0
@@ -34,6 +35,7 @@ ADDLICENSE_VERSION ?= v1.1.1
 GOIMPORTS_VERSION ?= v0.13.0
 GOLANGCI_LINT_VERSION ?= v1.60.3
 GOSEC_VERSION ?= v2.21.4
+SETUP_ENVTEST_VERSION ?= release-0.19
 
 
 # default tool versions
@@ -82,3 +84,6 @@ $(GOLANGCI_LINT): $(TOOLS_BIN_DIR)
 
 $(GOSEC):
 	GOSEC_VERSION=$(GOSEC_VERSION) bash $(TOOLS_PKG_PATH)/install-gosec.sh
+
+$(SETUP_ENVTEST):
+	GOBIN=$(abspath $(TOOLS_BIN_DIR)) go install sigs.k8s.io/controller-runtime/tools/setup-envtest@$(SETUP_ENVTEST_VERSION)
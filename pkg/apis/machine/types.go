@@ -63,6 +63,12 @@ type MachineSpec struct {
 
 	// Configuration for the machine-controller.
 	*MachineConfiguration
+
+	// DeletionPriority is the priority of the machine on a scale-down of its machine set. Machines with a lower
+	// priority are deleted first. If the machinepriority.machine.sapcloud.io annotation, which is still accepted,
+	// holds a lower priority, that one is taken. Defaults to 3.
+	// +optional
+	DeletionPriority *int32
 }
 
 // NodeTemplateSpec describes the data a node should have when created from a template
@@ -214,6 +220,12 @@ const (
 
 	// MachineCrashLoopBackOff means creation or deletion of the machine is failing. It means that machine object is present but there is no corresponding VM.
 	MachineCrashLoopBackOff MachinePhase = "CrashLoopBackOff"
+
+	// MachineMaintenance means the node is cordoned and drained for maintenance and health timeouts are suspended
+	MachineMaintenance MachinePhase = "Maintenance"
+
+	// MachineStandby means the VM backing the machine is stopped as part of a warm pool and health timeouts are suspended
+	MachineStandby MachinePhase = "Standby"
 )
 
 // MachineState is a label for the state of a machines at the current time.
@@ -247,6 +259,15 @@ const (
 
 	// MachineOperationDelete indicates that the operation was a create
 	MachineOperationDelete MachineOperationType = "Delete"
+
+	// MachineOperationMaintenance indicates that the operation was a maintenance of the node
+	MachineOperationMaintenance MachineOperationType = "Maintenance"
+
+	// MachineOperationStop indicates that the operation was a stop of the VM backing a warm pool machine
+	MachineOperationStop MachineOperationType = "Stop"
+
+	// MachineOperationStart indicates that the operation was a start of the VM backing a warm pool machine
+	MachineOperationStart MachineOperationType = "Start"
 )
 
 // The below types are used by kube_client and api_server.
@@ -302,8 +323,81 @@ type MachineSetSpec struct {
 	Template MachineTemplateSpec
 
 	MinReadySeconds int32
+
+	// DeletePolicy defines which machines are deleted first on a scale-down. Machines are still deleted
+	// by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.
+	// +optional
+	DeletePolicy MachineSetDeletePolicy
+
+	// ClassFallback lists the machine classes new machines are created from when the provider has no capacity
+	// for the machine class of the template. If not set, machines are always created from the class of the template.
+	// +optional
+	ClassFallback *ClassFallback
+
+	// WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up,
+	// machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.
+	// +optional
+	WarmPool *MachineWarmPool
+
+	// Frozen stops the MachineSet from creating machines. It is set by the safety controller when the MachineSet
+	// has more machines than allowed and is reset once the number of machines decreased. The freeze label is
+	// still accepted.
+	// +optional
+	Frozen bool
+}
+
+// ClassFallback describes the machine classes to fall back to when the provider has no capacity for a machine class.
+type ClassFallback struct {
+	// Classes is the ordered list of machine classes to fall back to. They have to be of the same kind as the
+	// class of the template.
+	Classes []ClassSpec
+
+	// CapacityErrorThreshold is the number of machines failing to be created from a class for lack of capacity,
+	// after which new machines are created from the next class. Defaults to 1.
+	// +optional
+	CapacityErrorThreshold *int32
+
+	// PreferredClassRetryPeriod is the time after which new machines are created from the class of the template
+	// again after falling back to another class. Defaults to 30 minutes.
+	// +optional
+	PreferredClassRetryPeriod *metav1.Duration
+}
+
+// ClassFallbackStatus describes the machine class new machines of a MachineSet are created from.
+type ClassFallbackStatus struct {
+	// Class is the machine class new machines are created from.
+	Class ClassSpec
+
+	// LastTransitionTime is the last time new machines were switched to another class.
+	LastTransitionTime metav1.Time
+}
+
+// MachineWarmPool describes the machines kept created ahead of time with their VMs stopped.
+type MachineWarmPool struct {
+	// Size is the number of machines kept in the warm pool.
+	Size int32
 }
 
+// MachineSetDeletePolicy describes which machines are deleted first on a scale-down of a MachineSet.
+type MachineSetDeletePolicy string
+
+const (
+	// OldestMachineSetDeletePolicy deletes the oldest machines first. This is the default.
+	OldestMachineSetDeletePolicy MachineSetDeletePolicy = "Oldest"
+
+	// NewestMachineSetDeletePolicy deletes the newest machines first.
+	NewestMachineSetDeletePolicy MachineSetDeletePolicy = "Newest"
+
+	// RandomMachineSetDeletePolicy deletes randomly chosen machines.
+	RandomMachineSetDeletePolicy MachineSetDeletePolicy = "Random"
+
+	// ZoneBalancedMachineSetDeletePolicy deletes machines from the zone with the most machines first.
+	ZoneBalancedMachineSetDeletePolicy MachineSetDeletePolicy = "ZoneBalanced"
+
+	// LeastPodsMachineSetDeletePolicy deletes the machines whose nodes run the fewest non-DaemonSet pods first.
+	LeastPodsMachineSetDeletePolicy MachineSetDeletePolicy = "LeastPods"
+)
+
 // MachineSetConditionType is the condition on machineset object
 type MachineSetConditionType string
 
@@ -360,6 +454,12 @@ type MachineSetStatus struct {
 
 	// FailedMachines has summary of machines on which lastOperation Failed
 	FailedMachines *[]MachineSummary
+
+	// ClassFallback is the machine class new machines are created from, if the MachineSet has class fallback.
+	ClassFallback *ClassFallbackStatus
+
+	// StandbyReplicas is the number of machines of the warm pool.
+	StandbyReplicas int32
 }
 
 // MachineSummary store the summary of machine.
@@ -438,6 +538,190 @@ type MachineDeploymentSpec struct {
 	// not be estimated during the time a MachineDeployment is paused. This is not set
 	// by default.
 	ProgressDeadlineSeconds *int32
+
+	// MaintenanceWindow restricts disruptive operations, i.e. the scale-down of old machine sets
+	// during a rollout and the deletion of surplus machines, to the given windows.
+	// Replacement of failed machines is not restricted. If not set, disruptive operations
+	// are allowed at any time.
+	MaintenanceWindow *MachineDeploymentMaintenanceWindow
+
+	// AutoRollback enables the automatic rollback of a failing rollout to the last revision
+	// which has been rolled out completely. If not set, failing rollouts are not rolled back.
+	// +optional
+	AutoRollback *AutoRollbackPolicy
+
+	// DeletePolicy defines which machines of the machine sets are deleted first on a scale-down. Machines are
+	// still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.
+	// +optional
+	DeletePolicy MachineSetDeletePolicy
+
+	// ZoneSpread spreads the machines across several zones, each with its own machine class. The
+	// MachineDeployment manages one MachineDeployment per zone, which rolls out changes with the
+	// strategy of this MachineDeployment. If not set, all machines use the class of the template.
+	// +optional
+	ZoneSpread *MachineDeploymentZoneSpread
+
+	// ClassFallback lists the machine classes new machines are created from when the provider has no capacity
+	// for the machine class of the template. If not set, machines are always created from the class of the template.
+	// +optional
+	ClassFallback *ClassFallback
+
+	// WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up,
+	// machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.
+	// +optional
+	WarmPool *MachineWarmPool
+
+	// ScheduledScaling changes the replicas of the MachineDeployment on recurring schedules. The
+	// replicas may still be changed in between, e.g. by the cluster-autoscaler. If not set, the
+	// replicas are only changed by clients.
+	// +optional
+	ScheduledScaling *MachineDeploymentScheduledScaling
+
+	// Frozen is set by the safety controller when the machine sets of the MachineDeployment have more machines
+	// than allowed, which stops them from creating machines. It is reset once the number of machines decreased.
+	// The freeze label is still accepted.
+	// +optional
+	Frozen bool
+}
+
+// MachineDeploymentZoneSpread describes how the machines of a MachineDeployment are spread across zones.
+type MachineDeploymentZoneSpread struct {
+	// Zones lists the zones to spread the machines across.
+	Zones []MachineDeploymentZone
+
+	// Policy defines how the replicas are distributed across the zones. Defaults to Even.
+	// +optional
+	Policy ZoneSpreadPolicy
+
+	// MaxSkew is the maximum difference between the replicas of any two zones up to which the MaxSkew policy
+	// moves replicas out of failing zones. Unlike the maxSkew of topology spread constraints, it does not
+	// rebalance the replicas of the other zones. Required for the MaxSkew policy.
+	// +optional
+	MaxSkew *int32
+}
+
+// MachineDeploymentZone is a zone of a MachineDeployment with its machine class.
+type MachineDeploymentZone struct {
+	// Name is the name of the zone. It is part of the name of the MachineDeployment managing the zone.
+	Name string
+
+	// Class is the machine class used for the machines in the zone.
+	Class ClassSpec
+
+	// Weight is the share of the replicas of the zone for the Weighted policy. Defaults to 1.
+	// +optional
+	Weight *int32
+}
+
+// ZoneSpreadPolicy describes how the replicas of a MachineDeployment are distributed across zones.
+type ZoneSpreadPolicy string
+
+const (
+	// EvenZoneSpreadPolicy distributes the replicas evenly across the zones. This is the default.
+	EvenZoneSpreadPolicy ZoneSpreadPolicy = "Even"
+
+	// WeightedZoneSpreadPolicy distributes the replicas proportionally to the weights of the zones.
+	WeightedZoneSpreadPolicy ZoneSpreadPolicy = "Weighted"
+
+	// MaxSkewZoneSpreadPolicy distributes the replicas evenly across the zones, but moves replicas
+	// from zones exceeding their progress deadline to the other zones within the max skew.
+	MaxSkewZoneSpreadPolicy ZoneSpreadPolicy = "MaxSkew"
+)
+
+// MachineDeploymentZoneStatus is the status of a zone of a MachineDeployment.
+type MachineDeploymentZoneStatus struct {
+	// Name is the name of the zone.
+	Name string
+
+	// MachineDeployment is the name of the MachineDeployment managing the zone.
+	MachineDeployment string
+
+	// Replicas is the number of desired replicas of the zone.
+	Replicas int32
+
+	// UpdatedReplicas is the number of machines of the zone that have the desired template spec.
+	// +optional
+	UpdatedReplicas int32
+
+	// ReadyReplicas is the number of ready machines of the zone.
+	// +optional
+	ReadyReplicas int32
+
+	// AvailableReplicas is the number of available machines of the zone.
+	// +optional
+	AvailableReplicas int32
+}
+
+// AutoRollbackPolicy describes when a rollout of a MachineDeployment is rolled back automatically.
+type AutoRollbackPolicy struct {
+	// MaxFailedMachines is the number of failed machines of the new machine set which is tolerated.
+	// The rollout is rolled back once more machines of the new machine set have failed.
+	// If not set, the rollout is only rolled back once it exceeds its progress deadline,
+	// which requires progressDeadlineSeconds to be set.
+	// +optional
+	MaxFailedMachines *int32
+}
+
+// MachineDeploymentMaintenanceWindow describes when disruptive operations are allowed for a MachineDeployment.
+type MachineDeploymentMaintenanceWindow struct {
+	// Windows is the list of windows during which disruptive operations are allowed.
+	Windows []MaintenanceWindow
+
+	// TimeZone is the IANA name of the time zone in which the window schedules are evaluated (ex: Europe/Berlin).
+	// Defaults to UTC.
+	TimeZone *string
+}
+
+// MaintenanceWindow is a recurring window of time.
+type MaintenanceWindow struct {
+	// Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week)
+	// at which the window opens (ex: "0 22 * * 1-5").
+	Schedule string
+
+	// Duration is the length of time for which the window stays open.
+	Duration metav1.Duration
+}
+
+// MachineDeploymentScheduledScaling describes when and how the replicas of a MachineDeployment are changed.
+type MachineDeploymentScheduledScaling struct {
+	// Schedules is the list of schedules changing the replicas.
+	Schedules []ScalingSchedule
+
+	// TimeZone is the IANA name of the time zone in which the schedules are evaluated (ex: Europe/Berlin).
+	// Defaults to UTC.
+	TimeZone *string
+}
+
+// ScalingSchedule is a recurring change of the replicas of a MachineDeployment.
+type ScalingSchedule struct {
+	// Name is the name of the schedule. It is unique among the schedules of the MachineDeployment.
+	Name string
+
+	// Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week)
+	// at which the replicas are changed (ex: "0 7 * * 1-5").
+	Schedule string
+
+	// Replicas is the number of replicas the MachineDeployment is scaled to.
+	// If not set, the replicas are only kept within minReplicas and maxReplicas.
+	Replicas *int32
+
+	// MinReplicas is the minimum number of replicas the MachineDeployment is scaled to.
+	MinReplicas *int32
+
+	// MaxReplicas is the maximum number of replicas the MachineDeployment is scaled to.
+	MaxReplicas *int32
+}
+
+// ScalingScheduleStatus is the status of a schedule of a MachineDeployment with scheduled scaling.
+type ScalingScheduleStatus struct {
+	// Name is the name of the schedule.
+	Name string
+
+	// LastScheduleTime is the last time the replicas have been changed by the schedule.
+	LastScheduleTime *metav1.Time
+
+	// NextScheduleTime is the next time the replicas are changed by the schedule.
+	NextScheduleTime *metav1.Time
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -472,7 +756,7 @@ const (
 
 // MachineDeploymentStrategy describes how to replace existing machines with new ones.
 type MachineDeploymentStrategy struct {
-	// Type of MachineDeployment. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
+	// Type of MachineDeployment. Can be "Recreate", "RollingUpdate", "Canary" or "BlueGreen". Default is RollingUpdate.
 	Type MachineDeploymentStrategyType
 
 	// Rolling update config params. Present only if MachineDeploymentStrategyType =
@@ -481,6 +765,14 @@ type MachineDeploymentStrategy struct {
 	// TODO: Update this to follow our convention for oneOf, whatever we decide it
 	// to be.
 	RollingUpdate *RollingUpdateMachineDeployment
+
+	// Canary update config params. Present only if MachineDeploymentStrategyType =
+	// Canary.
+	Canary *CanaryMachineDeployment
+
+	// Blue/green update config params. Present only if MachineDeploymentStrategyType =
+	// BlueGreen.
+	BlueGreen *BlueGreenMachineDeployment
 }
 
 // MachineDeploymentStrategyType is the strategy to be used for rolling a MachineDeployment
@@ -492,6 +784,12 @@ const (
 
 	// RollingUpdateMachineDeploymentStrategyType means that old MCs will be replaced by new one using rolling update i.e gradually scale down the old MCs and scale up the new one.
 	RollingUpdateMachineDeploymentStrategyType MachineDeploymentStrategyType = "RollingUpdate"
+
+	// CanaryMachineDeploymentStrategyType means that old MCs will be replaced by new one in explicit steps, which can pause the rollout until the new machines proved healthy.
+	CanaryMachineDeploymentStrategyType MachineDeploymentStrategyType = "Canary"
+
+	// BlueGreenMachineDeploymentStrategyType means that a new MC is scaled up completely next to the old MCs, whose machines are cordoned and drained at once before the old MCs are scaled down.
+	BlueGreenMachineDeploymentStrategyType MachineDeploymentStrategyType = "BlueGreen"
 )
 
 // RollingUpdateMachineDeployment specifies the spec to control the desired behavior of rolling update.
@@ -522,6 +820,108 @@ type RollingUpdateMachineDeployment struct {
 	MaxSurge *intstr.IntOrString
 }
 
+// CanaryMachineDeployment specifies the spec to control the desired behavior of canary update.
+type CanaryMachineDeployment struct {
+	// Steps are executed in order to roll out a new machine template. Once all steps are completed,
+	// all machines are moved to the new machine template.
+	Steps []CanaryStep
+
+	// The maximum number of machines that can be unavailable while the machines of a step are replaced
+	// and while an aborted canary update moves the machines back to the old machine template.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// Defaults to 0.
+	MaxUnavailable *intstr.IntOrString
+
+	// The maximum number of machines that can be scheduled above the desired number of machines
+	// while the machines of a step are replaced and while an aborted canary update moves the machines
+	// back to the old machine template.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 1.
+	MaxSurge *intstr.IntOrString
+}
+
+// CanaryStep is a single step of a canary update. Exactly one of its fields has to be set.
+type CanaryStep struct {
+	// Replicas is the number of machines which should run the new machine template after this step.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// The old machine sets are scaled down within maxSurge and maxUnavailable.
+	Replicas *intstr.IntOrString
+
+	// Pause holds the rollout until all new machines are available for the given duration.
+	Pause *CanaryPause
+}
+
+// CanaryPause describes a pause of a canary update.
+type CanaryPause struct {
+	// Duration for which all new machines have to be available before the rollout continues.
+	// If not set, the rollout continues only once it is promoted.
+	Duration *metav1.Duration
+}
+
+// CanaryStatus is the most recently observed status of a canary update.
+type CanaryStatus struct {
+	// MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.
+	MachineTemplateHash string
+
+	// CurrentStep is the index of the step which is currently executed.
+	// It is equal to the number of steps once all steps are completed.
+	CurrentStep int32
+
+	// PauseStartTime is the time since which all new machines are available during a pause step.
+	PauseStartTime *metav1.Time
+
+	// Aborted is true if the rollout was aborted and the machines were moved back to the old machine template.
+	Aborted bool
+}
+
+// BlueGreenMachineDeployment is the spec to control the desired behavior of blue/green update.
+type BlueGreenMachineDeployment struct {
+	// Hooks are the names of checks which have to pass before the machines are switched to the new
+	// machine set. A check passes once the MachineDeployment is annotated with
+	// bluegreen-hook.deployment.machine.sapcloud.io/<name> set to the machine-template-hash of the new machine set.
+	Hooks []string
+
+	// ConfirmationWindow is the time for which the cordoned machines of the old machine sets are kept
+	// after the switch, so that the switch can be reverted instantly. If not set, they are kept until
+	// the switch is confirmed.
+	ConfirmationWindow *metav1.Duration
+}
+
+// BlueGreenPhase is the phase of a blue/green update.
+type BlueGreenPhase string
+
+const (
+	// BlueGreenProvisioning means that the new machine set is scaled up next to the old machine sets, until
+	// all its machines have been running for minReadySeconds and all hooks have passed.
+	BlueGreenProvisioning BlueGreenPhase = "Provisioning"
+
+	// BlueGreenSwitched means that the machines of the old machine sets are cordoned and drained, and that
+	// the old machine sets are kept until the confirmation window has passed or the switch is confirmed.
+	BlueGreenSwitched BlueGreenPhase = "Switched"
+
+	// BlueGreenCompleted means that the switch is confirmed and the old machine sets are scaled down.
+	BlueGreenCompleted BlueGreenPhase = "Completed"
+
+	// BlueGreenSwitchedBack means that the machines of the old machine sets are uncordoned again and
+	// the new machine set is scaled down.
+	BlueGreenSwitchedBack BlueGreenPhase = "SwitchedBack"
+)
+
+// BlueGreenStatus is the most recently observed status of a blue/green update.
+type BlueGreenStatus struct {
+	// MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.
+	MachineTemplateHash string
+
+	// Phase is the phase of the blue/green update.
+	Phase BlueGreenPhase
+
+	// SwitchTime is the time at which the machines of the old machine sets have been cordoned.
+	SwitchTime *metav1.Time
+}
+
 // MachineDeploymentStatus is the most recently observed status of the MachineDeployment.
 type MachineDeploymentStatus struct {
 	// The generation observed by the MachineDeployment controller.
@@ -556,6 +956,26 @@ type MachineDeploymentStatus struct {
 
 	// FailedMachines has summary of machines on which lastOperation Failed
 	FailedMachines []*MachineSummary
+
+	// Canary is the status of the ongoing canary update. Present only if MachineDeploymentStrategyType =
+	// Canary.
+	Canary *CanaryStatus
+
+	// BlueGreen is the status of the ongoing blue/green update. Present only if MachineDeploymentStrategyType =
+	// BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenStatus
+
+	// Zones is the status of the zones of a MachineDeployment spreading its machines across zones.
+	// +optional
+	Zones []MachineDeploymentZoneStatus
+
+	// Total number of machines of the warm pool targeted by this MachineDeployment.
+	StandbyReplicas int32
+
+	// ScheduledScaling is the status of the schedules of a MachineDeployment with scheduled scaling.
+	// +optional
+	ScheduledScaling []ScalingScheduleStatus
 }
 
 // MachineDeploymentConditionType are the valid conditions of a MachineDeployment.
@@ -616,7 +1036,6 @@ type MachineDeploymentList struct {
 }
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // MachineClass can be used to templatize and re-use provider configuration
@@ -646,6 +1065,9 @@ type MachineClass struct {
 
 	// SecretRef stores the necessary secrets such as credentials or userdata.
 	SecretRef *corev1.SecretReference
+
+	// Status holds the most recently observed status of the MachineClass.
+	Status MachineClassStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -657,6 +1079,43 @@ type MachineClassList struct {
 	Items []MachineClass
 }
 
+// MachineClassStatus holds the most recently observed status of the MachineClass.
+type MachineClassStatus struct {
+	// MachineCount is the number of machines referencing the MachineClass.
+	MachineCount int32
+
+	// LastValidation is the result of the last validation of the MachineClass by the machine controller.
+	LastValidation *MachineClassValidation
+
+	// LastSuccessfulDriverCallTime is the time of the last successful call to the driver with the MachineClass.
+	LastSuccessfulDriverCallTime *metav1.Time
+
+	// OrphanVMs are the VMs of the MachineClass without a machine that were found in the last orphan VM sweep
+	// of the safety controller.
+	OrphanVMs []OrphanVM
+}
+
+// MachineClassValidation is the result of a validation of a MachineClass.
+type MachineClassValidation struct {
+	// Valid tells whether machines can be reconciled with the MachineClass.
+	Valid bool
+
+	// Message describes why the MachineClass is not valid.
+	Message string
+
+	// LastUpdateTime is the time at which the result of the validation last changed.
+	LastUpdateTime metav1.Time
+}
+
+// OrphanVM is a VM at the provider without a machine.
+type OrphanVM struct {
+	// ProviderID is the ID of the VM at the provider.
+	ProviderID string
+
+	// MachineName is the name of the machine the VM was created for.
+	MachineName string
+}
+
 // NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero
 type NodeTemplate struct {
 
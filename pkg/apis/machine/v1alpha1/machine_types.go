@@ -9,6 +9,8 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -26,6 +28,7 @@ const (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:shortName="mc"
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.currentStatus.phase`,description="Current status of the machine."
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.\nPopulated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata"
@@ -65,6 +68,20 @@ type MachineSpec struct {
 	// Configuration for the machine-controller.
 	// +optional
 	*MachineConfiguration `json:",inline"`
+
+	// DeletionPriority is the priority of the machine on a scale-down of its machine set. Machines with a lower
+	// priority are deleted first. If the machinepriority.machine.sapcloud.io annotation, which is still accepted,
+	// holds a lower priority, that one is taken. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DeletionPriority *int32 `json:"deletionPriority,omitempty"`
+}
+
+// MarshalJSON marshals the machine spec. The reflection based conversion of server-side apply cannot handle the
+// embedded MachineConfiguration if it is nil, but falls back to MarshalJSON if it is implemented.
+func (s MachineSpec) MarshalJSON() ([]byte, error) {
+	type machineSpec MachineSpec
+	return json.Marshal(machineSpec(s))
 }
 
 // ClassSpec is the class specification of machine
@@ -151,6 +168,12 @@ const (
 
 	// MachineCrashLoopBackOff means machine creation is failing. It means that machine object is present but there is no corresponding VM.
 	MachineCrashLoopBackOff MachinePhase = "CrashLoopBackOff"
+
+	// MachineMaintenance means the node is cordoned and drained for maintenance and health timeouts are suspended
+	MachineMaintenance MachinePhase = "Maintenance"
+
+	// MachineStandby means the VM backing the machine is stopped as part of a warm pool and health timeouts are suspended
+	MachineStandby MachinePhase = "Standby"
 )
 
 // MachineState is a current state of the operation.
@@ -184,6 +207,15 @@ const (
 
 	// MachineOperationDelete indicates that the operation was a delete
 	MachineOperationDelete MachineOperationType = "Delete"
+
+	// MachineOperationMaintenance indicates that the operation was a maintenance of the node
+	MachineOperationMaintenance MachineOperationType = "Maintenance"
+
+	// MachineOperationStop indicates that the operation was a stop of the VM backing a warm pool machine
+	MachineOperationStop MachineOperationType = "Stop"
+
+	// MachineOperationStart indicates that the operation was a start of the VM backing a warm pool machine
+	MachineOperationStart MachineOperationType = "Start"
 )
 
 // The below types are used by kube_client and api_server.
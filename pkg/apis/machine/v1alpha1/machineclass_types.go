@@ -15,10 +15,14 @@ import (
 )
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:shortName="mcc"
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machines",type=integer,JSONPath=`.status.machineCount`,description="Number of machines referencing the machine class"
+// +kubebuilder:printcolumn:name="Valid",type=boolean,JSONPath=`.status.lastValidation.valid`,description="Whether the last validation of the machine class succeeded"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.\nPopulated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata"
 
 // MachineClass can be used to templatize and re-use provider configuration
 // across multiple Machines / MachineSets / MachineDeployments.
@@ -48,6 +52,10 @@ type MachineClass struct {
 
 	// SecretRef stores the necessary secrets such as credentials or userdata.
 	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+
+	// Status holds the most recently observed status of the MachineClass.
+	// +optional
+	Status MachineClassStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -60,6 +68,49 @@ type MachineClassList struct {
 	Items           []MachineClass `json:"items"`
 }
 
+// MachineClassStatus holds the most recently observed status of the MachineClass.
+type MachineClassStatus struct {
+	// MachineCount is the number of machines referencing the MachineClass.
+	// +optional
+	MachineCount int32 `json:"machineCount,omitempty"`
+
+	// LastValidation is the result of the last validation of the MachineClass by the machine controller.
+	// +optional
+	LastValidation *MachineClassValidation `json:"lastValidation,omitempty"`
+
+	// LastSuccessfulDriverCallTime is the time of the last successful call to the driver with the MachineClass.
+	// +optional
+	LastSuccessfulDriverCallTime *metav1.Time `json:"lastSuccessfulDriverCallTime,omitempty"`
+
+	// OrphanVMs are the VMs of the MachineClass without a machine that were found in the last orphan VM sweep
+	// of the safety controller.
+	// +optional
+	OrphanVMs []OrphanVM `json:"orphanVMs,omitempty"`
+}
+
+// MachineClassValidation is the result of a validation of a MachineClass.
+type MachineClassValidation struct {
+	// Valid tells whether machines can be reconciled with the MachineClass.
+	Valid bool `json:"valid"`
+
+	// Message describes why the MachineClass is not valid.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastUpdateTime is the time at which the result of the validation last changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// OrphanVM is a VM at the provider without a machine.
+type OrphanVM struct {
+	// ProviderID is the ID of the VM at the provider.
+	ProviderID string `json:"providerID"`
+
+	// MachineName is the name of the machine the VM was created for.
+	MachineName string `json:"machineName"`
+}
+
 // NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero
 type NodeTemplate struct {
 
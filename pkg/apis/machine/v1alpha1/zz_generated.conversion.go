@@ -13,8 +13,8 @@ import (
 	unsafe "unsafe"
 
 	machine "github.com/gardener/machine-controller-manager/pkg/apis/machine"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -27,6 +27,96 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AutoRollbackPolicy)(nil), (*machine.AutoRollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(a.(*AutoRollbackPolicy), b.(*machine.AutoRollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.AutoRollbackPolicy)(nil), (*AutoRollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_AutoRollbackPolicy_To_v1alpha1_AutoRollbackPolicy(a.(*machine.AutoRollbackPolicy), b.(*AutoRollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenMachineDeployment)(nil), (*machine.BlueGreenMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(a.(*BlueGreenMachineDeployment), b.(*machine.BlueGreenMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.BlueGreenMachineDeployment)(nil), (*BlueGreenMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_BlueGreenMachineDeployment_To_v1alpha1_BlueGreenMachineDeployment(a.(*machine.BlueGreenMachineDeployment), b.(*BlueGreenMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenStatus)(nil), (*machine.BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueGreenStatus_To_machine_BlueGreenStatus(a.(*BlueGreenStatus), b.(*machine.BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.BlueGreenStatus)(nil), (*BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(a.(*machine.BlueGreenStatus), b.(*BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryMachineDeployment)(nil), (*machine.CanaryMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(a.(*CanaryMachineDeployment), b.(*machine.CanaryMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryMachineDeployment)(nil), (*CanaryMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryMachineDeployment_To_v1alpha1_CanaryMachineDeployment(a.(*machine.CanaryMachineDeployment), b.(*CanaryMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryPause)(nil), (*machine.CanaryPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryPause_To_machine_CanaryPause(a.(*CanaryPause), b.(*machine.CanaryPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryPause)(nil), (*CanaryPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryPause_To_v1alpha1_CanaryPause(a.(*machine.CanaryPause), b.(*CanaryPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStatus)(nil), (*machine.CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStatus_To_machine_CanaryStatus(a.(*CanaryStatus), b.(*machine.CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryStatus)(nil), (*CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryStatus_To_v1alpha1_CanaryStatus(a.(*machine.CanaryStatus), b.(*CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStep)(nil), (*machine.CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStep_To_machine_CanaryStep(a.(*CanaryStep), b.(*machine.CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryStep)(nil), (*CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryStep_To_v1alpha1_CanaryStep(a.(*machine.CanaryStep), b.(*CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClassFallback)(nil), (*machine.ClassFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClassFallback_To_machine_ClassFallback(a.(*ClassFallback), b.(*machine.ClassFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ClassFallback)(nil), (*ClassFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ClassFallback_To_v1alpha1_ClassFallback(a.(*machine.ClassFallback), b.(*ClassFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClassFallbackStatus)(nil), (*machine.ClassFallbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClassFallbackStatus_To_machine_ClassFallbackStatus(a.(*ClassFallbackStatus), b.(*machine.ClassFallbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ClassFallbackStatus)(nil), (*ClassFallbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ClassFallbackStatus_To_v1alpha1_ClassFallbackStatus(a.(*machine.ClassFallbackStatus), b.(*ClassFallbackStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClassSpec)(nil), (*machine.ClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ClassSpec_To_machine_ClassSpec(a.(*ClassSpec), b.(*machine.ClassSpec), scope)
 	}); err != nil {
@@ -87,6 +177,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineClassStatus)(nil), (*machine.MachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus(a.(*MachineClassStatus), b.(*machine.MachineClassStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineClassStatus)(nil), (*MachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus(a.(*machine.MachineClassStatus), b.(*MachineClassStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineClassValidation)(nil), (*machine.MachineClassValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineClassValidation_To_machine_MachineClassValidation(a.(*MachineClassValidation), b.(*machine.MachineClassValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineClassValidation)(nil), (*MachineClassValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClassValidation_To_v1alpha1_MachineClassValidation(a.(*machine.MachineClassValidation), b.(*MachineClassValidation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineConfiguration)(nil), (*machine.MachineConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineConfiguration_To_machine_MachineConfiguration(a.(*MachineConfiguration), b.(*machine.MachineConfiguration), scope)
 	}); err != nil {
@@ -127,6 +237,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentMaintenanceWindow)(nil), (*machine.MachineDeploymentMaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(a.(*MachineDeploymentMaintenanceWindow), b.(*machine.MachineDeploymentMaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentMaintenanceWindow)(nil), (*MachineDeploymentMaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentMaintenanceWindow_To_v1alpha1_MachineDeploymentMaintenanceWindow(a.(*machine.MachineDeploymentMaintenanceWindow), b.(*MachineDeploymentMaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentScheduledScaling)(nil), (*machine.MachineDeploymentScheduledScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(a.(*MachineDeploymentScheduledScaling), b.(*machine.MachineDeploymentScheduledScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentScheduledScaling)(nil), (*MachineDeploymentScheduledScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentScheduledScaling_To_v1alpha1_MachineDeploymentScheduledScaling(a.(*machine.MachineDeploymentScheduledScaling), b.(*MachineDeploymentScheduledScaling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineDeploymentSpec)(nil), (*machine.MachineDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(a.(*MachineDeploymentSpec), b.(*machine.MachineDeploymentSpec), scope)
 	}); err != nil {
@@ -157,6 +287,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZone)(nil), (*machine.MachineDeploymentZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentZone_To_machine_MachineDeploymentZone(a.(*MachineDeploymentZone), b.(*machine.MachineDeploymentZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZone)(nil), (*MachineDeploymentZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZone_To_v1alpha1_MachineDeploymentZone(a.(*machine.MachineDeploymentZone), b.(*MachineDeploymentZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZoneSpread)(nil), (*machine.MachineDeploymentZoneSpread)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(a.(*MachineDeploymentZoneSpread), b.(*machine.MachineDeploymentZoneSpread), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZoneSpread)(nil), (*MachineDeploymentZoneSpread)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZoneSpread_To_v1alpha1_MachineDeploymentZoneSpread(a.(*machine.MachineDeploymentZoneSpread), b.(*MachineDeploymentZoneSpread), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZoneStatus)(nil), (*machine.MachineDeploymentZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(a.(*MachineDeploymentZoneStatus), b.(*machine.MachineDeploymentZoneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZoneStatus)(nil), (*MachineDeploymentZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZoneStatus_To_v1alpha1_MachineDeploymentZoneStatus(a.(*machine.MachineDeploymentZoneStatus), b.(*MachineDeploymentZoneStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*machine.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineList_To_machine_MachineList(a.(*MachineList), b.(*machine.MachineList), scope)
 	}); err != nil {
@@ -257,6 +417,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineWarmPool)(nil), (*machine.MachineWarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineWarmPool_To_machine_MachineWarmPool(a.(*MachineWarmPool), b.(*machine.MachineWarmPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineWarmPool)(nil), (*MachineWarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineWarmPool_To_v1alpha1_MachineWarmPool(a.(*machine.MachineWarmPool), b.(*MachineWarmPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*machine.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_machine_MaintenanceWindow(a.(*MaintenanceWindow), b.(*machine.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*machine.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeTemplate)(nil), (*machine.NodeTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeTemplate_To_machine_NodeTemplate(a.(*NodeTemplate), b.(*machine.NodeTemplate), scope)
 	}); err != nil {
@@ -277,6 +457,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OrphanVM)(nil), (*machine.OrphanVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanVM_To_machine_OrphanVM(a.(*OrphanVM), b.(*machine.OrphanVM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.OrphanVM)(nil), (*OrphanVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_OrphanVM_To_v1alpha1_OrphanVM(a.(*machine.OrphanVM), b.(*OrphanVM), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RollbackConfig)(nil), (*machine.RollbackConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RollbackConfig_To_machine_RollbackConfig(a.(*RollbackConfig), b.(*machine.RollbackConfig), scope)
 	}); err != nil {
@@ -297,9 +487,237 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScalingSchedule)(nil), (*machine.ScalingSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScalingSchedule_To_machine_ScalingSchedule(a.(*ScalingSchedule), b.(*machine.ScalingSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ScalingSchedule)(nil), (*ScalingSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ScalingSchedule_To_v1alpha1_ScalingSchedule(a.(*machine.ScalingSchedule), b.(*ScalingSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScalingScheduleStatus)(nil), (*machine.ScalingScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(a.(*ScalingScheduleStatus), b.(*machine.ScalingScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ScalingScheduleStatus)(nil), (*ScalingScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ScalingScheduleStatus_To_v1alpha1_ScalingScheduleStatus(a.(*machine.ScalingScheduleStatus), b.(*ScalingScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in *AutoRollbackPolicy, out *machine.AutoRollbackPolicy, s conversion.Scope) error {
+	out.MaxFailedMachines = (*int32)(unsafe.Pointer(in.MaxFailedMachines))
+	return nil
+}
+
+// Convert_v1alpha1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in *AutoRollbackPolicy, out *machine.AutoRollbackPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in, out, s)
+}
+
+func autoConvert_machine_AutoRollbackPolicy_To_v1alpha1_AutoRollbackPolicy(in *machine.AutoRollbackPolicy, out *AutoRollbackPolicy, s conversion.Scope) error {
+	out.MaxFailedMachines = (*int32)(unsafe.Pointer(in.MaxFailedMachines))
+	return nil
+}
+
+// Convert_machine_AutoRollbackPolicy_To_v1alpha1_AutoRollbackPolicy is an autogenerated conversion function.
+func Convert_machine_AutoRollbackPolicy_To_v1alpha1_AutoRollbackPolicy(in *machine.AutoRollbackPolicy, out *AutoRollbackPolicy, s conversion.Scope) error {
+	return autoConvert_machine_AutoRollbackPolicy_To_v1alpha1_AutoRollbackPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in *BlueGreenMachineDeployment, out *machine.BlueGreenMachineDeployment, s conversion.Scope) error {
+	out.Hooks = *(*[]string)(unsafe.Pointer(&in.Hooks))
+	out.ConfirmationWindow = (*v1.Duration)(unsafe.Pointer(in.ConfirmationWindow))
+	return nil
+}
+
+// Convert_v1alpha1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment is an autogenerated conversion function.
+func Convert_v1alpha1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in *BlueGreenMachineDeployment, out *machine.BlueGreenMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_BlueGreenMachineDeployment_To_v1alpha1_BlueGreenMachineDeployment(in *machine.BlueGreenMachineDeployment, out *BlueGreenMachineDeployment, s conversion.Scope) error {
+	out.Hooks = *(*[]string)(unsafe.Pointer(&in.Hooks))
+	out.ConfirmationWindow = (*v1.Duration)(unsafe.Pointer(in.ConfirmationWindow))
+	return nil
+}
+
+// Convert_machine_BlueGreenMachineDeployment_To_v1alpha1_BlueGreenMachineDeployment is an autogenerated conversion function.
+func Convert_machine_BlueGreenMachineDeployment_To_v1alpha1_BlueGreenMachineDeployment(in *machine.BlueGreenMachineDeployment, out *BlueGreenMachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_BlueGreenMachineDeployment_To_v1alpha1_BlueGreenMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueGreenStatus_To_machine_BlueGreenStatus(in *BlueGreenStatus, out *machine.BlueGreenStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.Phase = machine.BlueGreenPhase(in.Phase)
+	out.SwitchTime = (*v1.Time)(unsafe.Pointer(in.SwitchTime))
+	return nil
+}
+
+// Convert_v1alpha1_BlueGreenStatus_To_machine_BlueGreenStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BlueGreenStatus_To_machine_BlueGreenStatus(in *BlueGreenStatus, out *machine.BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueGreenStatus_To_machine_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_machine_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in *machine.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.Phase = BlueGreenPhase(in.Phase)
+	out.SwitchTime = (*v1.Time)(unsafe.Pointer(in.SwitchTime))
+	return nil
+}
+
+// Convert_machine_BlueGreenStatus_To_v1alpha1_BlueGreenStatus is an autogenerated conversion function.
+func Convert_machine_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in *machine.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_machine_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in *CanaryMachineDeployment, out *machine.CanaryMachineDeployment, s conversion.Scope) error {
+	out.Steps = *(*[]machine.CanaryStep)(unsafe.Pointer(&in.Steps))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in *CanaryMachineDeployment, out *machine.CanaryMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_CanaryMachineDeployment_To_v1alpha1_CanaryMachineDeployment(in *machine.CanaryMachineDeployment, out *CanaryMachineDeployment, s conversion.Scope) error {
+	out.Steps = *(*[]CanaryStep)(unsafe.Pointer(&in.Steps))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_machine_CanaryMachineDeployment_To_v1alpha1_CanaryMachineDeployment is an autogenerated conversion function.
+func Convert_machine_CanaryMachineDeployment_To_v1alpha1_CanaryMachineDeployment(in *machine.CanaryMachineDeployment, out *CanaryMachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_CanaryMachineDeployment_To_v1alpha1_CanaryMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryPause_To_machine_CanaryPause(in *CanaryPause, out *machine.CanaryPause, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryPause_To_machine_CanaryPause is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryPause_To_machine_CanaryPause(in *CanaryPause, out *machine.CanaryPause, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryPause_To_machine_CanaryPause(in, out, s)
+}
+
+func autoConvert_machine_CanaryPause_To_v1alpha1_CanaryPause(in *machine.CanaryPause, out *CanaryPause, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_machine_CanaryPause_To_v1alpha1_CanaryPause is an autogenerated conversion function.
+func Convert_machine_CanaryPause_To_v1alpha1_CanaryPause(in *machine.CanaryPause, out *CanaryPause, s conversion.Scope) error {
+	return autoConvert_machine_CanaryPause_To_v1alpha1_CanaryPause(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryStatus_To_machine_CanaryStatus(in *CanaryStatus, out *machine.CanaryStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.CurrentStep = in.CurrentStep
+	out.PauseStartTime = (*v1.Time)(unsafe.Pointer(in.PauseStartTime))
+	out.Aborted = in.Aborted
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStatus_To_machine_CanaryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryStatus_To_machine_CanaryStatus(in *CanaryStatus, out *machine.CanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryStatus_To_machine_CanaryStatus(in, out, s)
+}
+
+func autoConvert_machine_CanaryStatus_To_v1alpha1_CanaryStatus(in *machine.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.CurrentStep = in.CurrentStep
+	out.PauseStartTime = (*v1.Time)(unsafe.Pointer(in.PauseStartTime))
+	out.Aborted = in.Aborted
+	return nil
+}
+
+// Convert_machine_CanaryStatus_To_v1alpha1_CanaryStatus is an autogenerated conversion function.
+func Convert_machine_CanaryStatus_To_v1alpha1_CanaryStatus(in *machine.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	return autoConvert_machine_CanaryStatus_To_v1alpha1_CanaryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryStep_To_machine_CanaryStep(in *CanaryStep, out *machine.CanaryStep, s conversion.Scope) error {
+	out.Replicas = (*intstr.IntOrString)(unsafe.Pointer(in.Replicas))
+	out.Pause = (*machine.CanaryPause)(unsafe.Pointer(in.Pause))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStep_To_machine_CanaryStep is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryStep_To_machine_CanaryStep(in *CanaryStep, out *machine.CanaryStep, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryStep_To_machine_CanaryStep(in, out, s)
+}
+
+func autoConvert_machine_CanaryStep_To_v1alpha1_CanaryStep(in *machine.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	out.Replicas = (*intstr.IntOrString)(unsafe.Pointer(in.Replicas))
+	out.Pause = (*CanaryPause)(unsafe.Pointer(in.Pause))
+	return nil
+}
+
+// Convert_machine_CanaryStep_To_v1alpha1_CanaryStep is an autogenerated conversion function.
+func Convert_machine_CanaryStep_To_v1alpha1_CanaryStep(in *machine.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	return autoConvert_machine_CanaryStep_To_v1alpha1_CanaryStep(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClassFallback_To_machine_ClassFallback(in *ClassFallback, out *machine.ClassFallback, s conversion.Scope) error {
+	out.Classes = *(*[]machine.ClassSpec)(unsafe.Pointer(&in.Classes))
+	out.CapacityErrorThreshold = (*int32)(unsafe.Pointer(in.CapacityErrorThreshold))
+	out.PreferredClassRetryPeriod = (*v1.Duration)(unsafe.Pointer(in.PreferredClassRetryPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ClassFallback_To_machine_ClassFallback is an autogenerated conversion function.
+func Convert_v1alpha1_ClassFallback_To_machine_ClassFallback(in *ClassFallback, out *machine.ClassFallback, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClassFallback_To_machine_ClassFallback(in, out, s)
+}
+
+func autoConvert_machine_ClassFallback_To_v1alpha1_ClassFallback(in *machine.ClassFallback, out *ClassFallback, s conversion.Scope) error {
+	out.Classes = *(*[]ClassSpec)(unsafe.Pointer(&in.Classes))
+	out.CapacityErrorThreshold = (*int32)(unsafe.Pointer(in.CapacityErrorThreshold))
+	out.PreferredClassRetryPeriod = (*v1.Duration)(unsafe.Pointer(in.PreferredClassRetryPeriod))
+	return nil
+}
+
+// Convert_machine_ClassFallback_To_v1alpha1_ClassFallback is an autogenerated conversion function.
+func Convert_machine_ClassFallback_To_v1alpha1_ClassFallback(in *machine.ClassFallback, out *ClassFallback, s conversion.Scope) error {
+	return autoConvert_machine_ClassFallback_To_v1alpha1_ClassFallback(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in *ClassFallbackStatus, out *machine.ClassFallbackStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ClassSpec_To_machine_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_ClassFallbackStatus_To_machine_ClassFallbackStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in *ClassFallbackStatus, out *machine.ClassFallbackStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in, out, s)
+}
+
+func autoConvert_machine_ClassFallbackStatus_To_v1alpha1_ClassFallbackStatus(in *machine.ClassFallbackStatus, out *ClassFallbackStatus, s conversion.Scope) error {
+	if err := Convert_machine_ClassSpec_To_v1alpha1_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.LastTransitionTime = in.LastTransitionTime
 	return nil
 }
 
+// Convert_machine_ClassFallbackStatus_To_v1alpha1_ClassFallbackStatus is an autogenerated conversion function.
+func Convert_machine_ClassFallbackStatus_To_v1alpha1_ClassFallbackStatus(in *machine.ClassFallbackStatus, out *ClassFallbackStatus, s conversion.Scope) error {
+	return autoConvert_machine_ClassFallbackStatus_To_v1alpha1_ClassFallbackStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ClassSpec_To_machine_ClassSpec(in *ClassSpec, out *machine.ClassSpec, s conversion.Scope) error {
 	out.APIGroup = in.APIGroup
 	out.Kind = in.Kind
@@ -411,10 +829,13 @@ func Convert_machine_Machine_To_v1alpha1_Machine(in *machine.Machine, out *Machi
 func autoConvert_v1alpha1_MachineClass_To_machine_MachineClass(in *MachineClass, out *machine.MachineClass, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.NodeTemplate = (*machine.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
-	out.CredentialsSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	out.CredentialsSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
 	out.ProviderSpec = in.ProviderSpec
 	out.Provider = in.Provider
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	if err := Convert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -426,10 +847,13 @@ func Convert_v1alpha1_MachineClass_To_machine_MachineClass(in *MachineClass, out
 func autoConvert_machine_MachineClass_To_v1alpha1_MachineClass(in *machine.MachineClass, out *MachineClass, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.NodeTemplate = (*NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
-	out.CredentialsSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	out.CredentialsSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
 	out.Provider = in.Provider
 	out.ProviderSpec = in.ProviderSpec
-	out.SecretRef = (*v1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	if err := Convert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -480,10 +904,60 @@ func Convert_machine_MachineClassList_To_v1alpha1_MachineClassList(in *machine.M
 	return autoConvert_machine_MachineClassList_To_v1alpha1_MachineClassList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus(in *MachineClassStatus, out *machine.MachineClassStatus, s conversion.Scope) error {
+	out.MachineCount = in.MachineCount
+	out.LastValidation = (*machine.MachineClassValidation)(unsafe.Pointer(in.LastValidation))
+	out.LastSuccessfulDriverCallTime = (*v1.Time)(unsafe.Pointer(in.LastSuccessfulDriverCallTime))
+	out.OrphanVMs = *(*[]machine.OrphanVM)(unsafe.Pointer(&in.OrphanVMs))
+	return nil
+}
+
+// Convert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus(in *MachineClassStatus, out *machine.MachineClassStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineClassStatus_To_machine_MachineClassStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus(in *machine.MachineClassStatus, out *MachineClassStatus, s conversion.Scope) error {
+	out.MachineCount = in.MachineCount
+	out.LastValidation = (*MachineClassValidation)(unsafe.Pointer(in.LastValidation))
+	out.LastSuccessfulDriverCallTime = (*v1.Time)(unsafe.Pointer(in.LastSuccessfulDriverCallTime))
+	out.OrphanVMs = *(*[]OrphanVM)(unsafe.Pointer(&in.OrphanVMs))
+	return nil
+}
+
+// Convert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus is an autogenerated conversion function.
+func Convert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus(in *machine.MachineClassStatus, out *MachineClassStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineClassStatus_To_v1alpha1_MachineClassStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineClassValidation_To_machine_MachineClassValidation(in *MachineClassValidation, out *machine.MachineClassValidation, s conversion.Scope) error {
+	out.Valid = in.Valid
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1alpha1_MachineClassValidation_To_machine_MachineClassValidation is an autogenerated conversion function.
+func Convert_v1alpha1_MachineClassValidation_To_machine_MachineClassValidation(in *MachineClassValidation, out *machine.MachineClassValidation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineClassValidation_To_machine_MachineClassValidation(in, out, s)
+}
+
+func autoConvert_machine_MachineClassValidation_To_v1alpha1_MachineClassValidation(in *machine.MachineClassValidation, out *MachineClassValidation, s conversion.Scope) error {
+	out.Valid = in.Valid
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_machine_MachineClassValidation_To_v1alpha1_MachineClassValidation is an autogenerated conversion function.
+func Convert_machine_MachineClassValidation_To_v1alpha1_MachineClassValidation(in *machine.MachineClassValidation, out *MachineClassValidation, s conversion.Scope) error {
+	return autoConvert_machine_MachineClassValidation_To_v1alpha1_MachineClassValidation(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineConfiguration_To_machine_MachineConfiguration(in *MachineConfiguration, out *machine.MachineConfiguration, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = (*string)(unsafe.Pointer(in.NodeConditions))
 	return nil
@@ -495,9 +969,9 @@ func Convert_v1alpha1_MachineConfiguration_To_machine_MachineConfiguration(in *M
 }
 
 func autoConvert_machine_MachineConfiguration_To_v1alpha1_MachineConfiguration(in *machine.MachineConfiguration, out *MachineConfiguration, s conversion.Scope) error {
-	out.MachineDrainTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
-	out.MachineHealthTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
-	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = (*string)(unsafe.Pointer(in.NodeConditions))
 	return nil
@@ -592,9 +1066,53 @@ func Convert_machine_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(in
 	return autoConvert_machine_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in *MachineDeploymentMaintenanceWindow, out *machine.MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	out.Windows = *(*[]machine.MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in *MachineDeploymentMaintenanceWindow, out *machine.MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentMaintenanceWindow_To_v1alpha1_MachineDeploymentMaintenanceWindow(in *machine.MachineDeploymentMaintenanceWindow, out *MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentMaintenanceWindow_To_v1alpha1_MachineDeploymentMaintenanceWindow is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentMaintenanceWindow_To_v1alpha1_MachineDeploymentMaintenanceWindow(in *machine.MachineDeploymentMaintenanceWindow, out *MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentMaintenanceWindow_To_v1alpha1_MachineDeploymentMaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in *MachineDeploymentScheduledScaling, out *machine.MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	out.Schedules = *(*[]machine.ScalingSchedule)(unsafe.Pointer(&in.Schedules))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in *MachineDeploymentScheduledScaling, out *machine.MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentScheduledScaling_To_v1alpha1_MachineDeploymentScheduledScaling(in *machine.MachineDeploymentScheduledScaling, out *MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	out.Schedules = *(*[]ScalingSchedule)(unsafe.Pointer(&in.Schedules))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentScheduledScaling_To_v1alpha1_MachineDeploymentScheduledScaling is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentScheduledScaling_To_v1alpha1_MachineDeploymentScheduledScaling(in *machine.MachineDeploymentScheduledScaling, out *MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentScheduledScaling_To_v1alpha1_MachineDeploymentScheduledScaling(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(in *MachineDeploymentSpec, out *machine.MachineDeploymentSpec, s conversion.Scope) error {
 	out.Replicas = in.Replicas
-	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
 	if err := Convert_v1alpha1_MachineTemplateSpec_To_machine_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
@@ -606,6 +1124,14 @@ func autoConvert_v1alpha1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec
 	out.Paused = in.Paused
 	out.RollbackTo = (*machine.RollbackConfig)(unsafe.Pointer(in.RollbackTo))
 	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.MaintenanceWindow = (*machine.MachineDeploymentMaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.AutoRollback = (*machine.AutoRollbackPolicy)(unsafe.Pointer(in.AutoRollback))
+	out.DeletePolicy = machine.MachineSetDeletePolicy(in.DeletePolicy)
+	out.ZoneSpread = (*machine.MachineDeploymentZoneSpread)(unsafe.Pointer(in.ZoneSpread))
+	out.ClassFallback = (*machine.ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*machine.MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.ScheduledScaling = (*machine.MachineDeploymentScheduledScaling)(unsafe.Pointer(in.ScheduledScaling))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -616,7 +1142,7 @@ func Convert_v1alpha1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(in
 
 func autoConvert_machine_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(in *machine.MachineDeploymentSpec, out *MachineDeploymentSpec, s conversion.Scope) error {
 	out.Replicas = in.Replicas
-	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
 	if err := Convert_machine_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
@@ -628,6 +1154,14 @@ func autoConvert_machine_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec
 	out.Paused = in.Paused
 	out.RollbackTo = (*RollbackConfig)(unsafe.Pointer(in.RollbackTo))
 	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.MaintenanceWindow = (*MachineDeploymentMaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.AutoRollback = (*AutoRollbackPolicy)(unsafe.Pointer(in.AutoRollback))
+	out.DeletePolicy = MachineSetDeletePolicy(in.DeletePolicy)
+	out.ZoneSpread = (*MachineDeploymentZoneSpread)(unsafe.Pointer(in.ZoneSpread))
+	out.ClassFallback = (*ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.ScheduledScaling = (*MachineDeploymentScheduledScaling)(unsafe.Pointer(in.ScheduledScaling))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -646,6 +1180,11 @@ func autoConvert_v1alpha1_MachineDeploymentStatus_To_machine_MachineDeploymentSt
 	out.Conditions = *(*[]machine.MachineDeploymentCondition)(unsafe.Pointer(&in.Conditions))
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.FailedMachines = *(*[]*machine.MachineSummary)(unsafe.Pointer(&in.FailedMachines))
+	out.Canary = (*machine.CanaryStatus)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*machine.BlueGreenStatus)(unsafe.Pointer(in.BlueGreen))
+	out.Zones = *(*[]machine.MachineDeploymentZoneStatus)(unsafe.Pointer(&in.Zones))
+	out.StandbyReplicas = in.StandbyReplicas
+	out.ScheduledScaling = *(*[]machine.ScalingScheduleStatus)(unsafe.Pointer(&in.ScheduledScaling))
 	return nil
 }
 
@@ -664,6 +1203,11 @@ func autoConvert_machine_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentSt
 	out.Conditions = *(*[]MachineDeploymentCondition)(unsafe.Pointer(&in.Conditions))
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.FailedMachines = *(*[]*MachineSummary)(unsafe.Pointer(&in.FailedMachines))
+	out.Canary = (*CanaryStatus)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*BlueGreenStatus)(unsafe.Pointer(in.BlueGreen))
+	out.Zones = *(*[]MachineDeploymentZoneStatus)(unsafe.Pointer(&in.Zones))
+	out.StandbyReplicas = in.StandbyReplicas
+	out.ScheduledScaling = *(*[]ScalingScheduleStatus)(unsafe.Pointer(&in.ScheduledScaling))
 	return nil
 }
 
@@ -675,6 +1219,8 @@ func Convert_machine_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus
 func autoConvert_v1alpha1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(in *MachineDeploymentStrategy, out *machine.MachineDeploymentStrategy, s conversion.Scope) error {
 	out.Type = machine.MachineDeploymentStrategyType(in.Type)
 	out.RollingUpdate = (*machine.RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*machine.CanaryMachineDeployment)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*machine.BlueGreenMachineDeployment)(unsafe.Pointer(in.BlueGreen))
 	return nil
 }
 
@@ -686,6 +1232,8 @@ func Convert_v1alpha1_MachineDeploymentStrategy_To_machine_MachineDeploymentStra
 func autoConvert_machine_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(in *machine.MachineDeploymentStrategy, out *MachineDeploymentStrategy, s conversion.Scope) error {
 	out.Type = MachineDeploymentStrategyType(in.Type)
 	out.RollingUpdate = (*RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*CanaryMachineDeployment)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*BlueGreenMachineDeployment)(unsafe.Pointer(in.BlueGreen))
 	return nil
 }
 
@@ -694,6 +1242,88 @@ func Convert_machine_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStra
 	return autoConvert_machine_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in *MachineDeploymentZone, out *machine.MachineDeploymentZone, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_ClassSpec_To_machine_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentZone_To_machine_MachineDeploymentZone is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in *MachineDeploymentZone, out *machine.MachineDeploymentZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZone_To_v1alpha1_MachineDeploymentZone(in *machine.MachineDeploymentZone, out *MachineDeploymentZone, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_machine_ClassSpec_To_v1alpha1_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZone_To_v1alpha1_MachineDeploymentZone is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZone_To_v1alpha1_MachineDeploymentZone(in *machine.MachineDeploymentZone, out *MachineDeploymentZone, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZone_To_v1alpha1_MachineDeploymentZone(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in *MachineDeploymentZoneSpread, out *machine.MachineDeploymentZoneSpread, s conversion.Scope) error {
+	out.Zones = *(*[]machine.MachineDeploymentZone)(unsafe.Pointer(&in.Zones))
+	out.Policy = machine.ZoneSpreadPolicy(in.Policy)
+	out.MaxSkew = (*int32)(unsafe.Pointer(in.MaxSkew))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in *MachineDeploymentZoneSpread, out *machine.MachineDeploymentZoneSpread, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZoneSpread_To_v1alpha1_MachineDeploymentZoneSpread(in *machine.MachineDeploymentZoneSpread, out *MachineDeploymentZoneSpread, s conversion.Scope) error {
+	out.Zones = *(*[]MachineDeploymentZone)(unsafe.Pointer(&in.Zones))
+	out.Policy = ZoneSpreadPolicy(in.Policy)
+	out.MaxSkew = (*int32)(unsafe.Pointer(in.MaxSkew))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZoneSpread_To_v1alpha1_MachineDeploymentZoneSpread is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZoneSpread_To_v1alpha1_MachineDeploymentZoneSpread(in *machine.MachineDeploymentZoneSpread, out *MachineDeploymentZoneSpread, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZoneSpread_To_v1alpha1_MachineDeploymentZoneSpread(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in *MachineDeploymentZoneStatus, out *machine.MachineDeploymentZoneStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineDeployment = in.MachineDeployment
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in *MachineDeploymentZoneStatus, out *machine.MachineDeploymentZoneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZoneStatus_To_v1alpha1_MachineDeploymentZoneStatus(in *machine.MachineDeploymentZoneStatus, out *MachineDeploymentZoneStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineDeployment = in.MachineDeployment
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZoneStatus_To_v1alpha1_MachineDeploymentZoneStatus is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZoneStatus_To_v1alpha1_MachineDeploymentZoneStatus(in *machine.MachineDeploymentZoneStatus, out *MachineDeploymentZoneStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZoneStatus_To_v1alpha1_MachineDeploymentZoneStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineList_To_machine_MachineList(in *MachineList, out *machine.MachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]machine.Machine)(unsafe.Pointer(&in.Items))
@@ -800,7 +1430,7 @@ func Convert_machine_MachineSetList_To_v1alpha1_MachineSetList(in *machine.Machi
 
 func autoConvert_v1alpha1_MachineSetSpec_To_machine_MachineSetSpec(in *MachineSetSpec, out *machine.MachineSetSpec, s conversion.Scope) error {
 	out.Replicas = in.Replicas
-	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
 	if err := Convert_v1alpha1_ClassSpec_To_machine_ClassSpec(&in.MachineClass, &out.MachineClass, s); err != nil {
 		return err
 	}
@@ -808,6 +1438,10 @@ func autoConvert_v1alpha1_MachineSetSpec_To_machine_MachineSetSpec(in *MachineSe
 		return err
 	}
 	out.MinReadySeconds = in.MinReadySeconds
+	out.DeletePolicy = machine.MachineSetDeletePolicy(in.DeletePolicy)
+	out.ClassFallback = (*machine.ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*machine.MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -818,7 +1452,7 @@ func Convert_v1alpha1_MachineSetSpec_To_machine_MachineSetSpec(in *MachineSetSpe
 
 func autoConvert_machine_MachineSetSpec_To_v1alpha1_MachineSetSpec(in *machine.MachineSetSpec, out *MachineSetSpec, s conversion.Scope) error {
 	out.Replicas = in.Replicas
-	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
 	if err := Convert_machine_ClassSpec_To_v1alpha1_ClassSpec(&in.MachineClass, &out.MachineClass, s); err != nil {
 		return err
 	}
@@ -826,6 +1460,10 @@ func autoConvert_machine_MachineSetSpec_To_v1alpha1_MachineSetSpec(in *machine.M
 		return err
 	}
 	out.MinReadySeconds = in.MinReadySeconds
+	out.DeletePolicy = MachineSetDeletePolicy(in.DeletePolicy)
+	out.ClassFallback = (*ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -845,6 +1483,8 @@ func autoConvert_v1alpha1_MachineSetStatus_To_machine_MachineSetStatus(in *Machi
 		return err
 	}
 	out.FailedMachines = (*[]machine.MachineSummary)(unsafe.Pointer(in.FailedMachines))
+	out.ClassFallback = (*machine.ClassFallbackStatus)(unsafe.Pointer(in.ClassFallback))
+	out.StandbyReplicas = in.StandbyReplicas
 	return nil
 }
 
@@ -864,6 +1504,8 @@ func autoConvert_machine_MachineSetStatus_To_v1alpha1_MachineSetStatus(in *machi
 		return err
 	}
 	out.FailedMachines = (*[]MachineSummary)(unsafe.Pointer(in.FailedMachines))
+	out.ClassFallback = (*ClassFallbackStatus)(unsafe.Pointer(in.ClassFallback))
+	out.StandbyReplicas = in.StandbyReplicas
 	return nil
 }
 
@@ -881,6 +1523,7 @@ func autoConvert_v1alpha1_MachineSpec_To_machine_MachineSpec(in *MachineSpec, ou
 		return err
 	}
 	out.MachineConfiguration = (*machine.MachineConfiguration)(unsafe.Pointer(in.MachineConfiguration))
+	out.DeletionPriority = (*int32)(unsafe.Pointer(in.DeletionPriority))
 	return nil
 }
 
@@ -898,6 +1541,7 @@ func autoConvert_machine_MachineSpec_To_v1alpha1_MachineSpec(in *machine.Machine
 		return err
 	}
 	out.MachineConfiguration = (*MachineConfiguration)(unsafe.Pointer(in.MachineConfiguration))
+	out.DeletionPriority = (*int32)(unsafe.Pointer(in.DeletionPriority))
 	return nil
 }
 
@@ -907,7 +1551,7 @@ func Convert_machine_MachineSpec_To_v1alpha1_MachineSpec(in *machine.MachineSpec
 }
 
 func autoConvert_v1alpha1_MachineStatus_To_machine_MachineStatus(in *MachineStatus, out *machine.MachineStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]v1.NodeCondition)(unsafe.Pointer(&in.Conditions))
+	out.Conditions = *(*[]corev1.NodeCondition)(unsafe.Pointer(&in.Conditions))
 	if err := Convert_v1alpha1_LastOperation_To_machine_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
 		return err
 	}
@@ -924,7 +1568,7 @@ func Convert_v1alpha1_MachineStatus_To_machine_MachineStatus(in *MachineStatus,
 }
 
 func autoConvert_machine_MachineStatus_To_v1alpha1_MachineStatus(in *machine.MachineStatus, out *MachineStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]v1.NodeCondition)(unsafe.Pointer(&in.Conditions))
+	out.Conditions = *(*[]corev1.NodeCondition)(unsafe.Pointer(&in.Conditions))
 	if err := Convert_machine_LastOperation_To_v1alpha1_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
 		return err
 	}
@@ -996,8 +1640,50 @@ func Convert_machine_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(in *mac
 	return autoConvert_machine_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineWarmPool_To_machine_MachineWarmPool(in *MachineWarmPool, out *machine.MachineWarmPool, s conversion.Scope) error {
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1alpha1_MachineWarmPool_To_machine_MachineWarmPool is an autogenerated conversion function.
+func Convert_v1alpha1_MachineWarmPool_To_machine_MachineWarmPool(in *MachineWarmPool, out *machine.MachineWarmPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineWarmPool_To_machine_MachineWarmPool(in, out, s)
+}
+
+func autoConvert_machine_MachineWarmPool_To_v1alpha1_MachineWarmPool(in *machine.MachineWarmPool, out *MachineWarmPool, s conversion.Scope) error {
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_machine_MachineWarmPool_To_v1alpha1_MachineWarmPool is an autogenerated conversion function.
+func Convert_machine_MachineWarmPool_To_v1alpha1_MachineWarmPool(in *machine.MachineWarmPool, out *MachineWarmPool, s conversion.Scope) error {
+	return autoConvert_machine_MachineWarmPool_To_v1alpha1_MachineWarmPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceWindow_To_machine_MaintenanceWindow(in *MaintenanceWindow, out *machine.MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_machine_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_machine_MaintenanceWindow(in *MaintenanceWindow, out *machine.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_machine_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_machine_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *machine.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_machine_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_machine_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *machine.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_machine_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeTemplate_To_machine_NodeTemplate(in *NodeTemplate, out *machine.NodeTemplate, s conversion.Scope) error {
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.InstanceType = in.InstanceType
 	out.Region = in.Region
 	out.Zone = in.Zone
@@ -1011,7 +1697,7 @@ func Convert_v1alpha1_NodeTemplate_To_machine_NodeTemplate(in *NodeTemplate, out
 }
 
 func autoConvert_machine_NodeTemplate_To_v1alpha1_NodeTemplate(in *machine.NodeTemplate, out *NodeTemplate, s conversion.Scope) error {
-	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.InstanceType = in.InstanceType
 	out.Region = in.Region
 	out.Zone = in.Zone
@@ -1046,6 +1732,28 @@ func Convert_machine_NodeTemplateSpec_To_v1alpha1_NodeTemplateSpec(in *machine.N
 	return autoConvert_machine_NodeTemplateSpec_To_v1alpha1_NodeTemplateSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_OrphanVM_To_machine_OrphanVM(in *OrphanVM, out *machine.OrphanVM, s conversion.Scope) error {
+	out.ProviderID = in.ProviderID
+	out.MachineName = in.MachineName
+	return nil
+}
+
+// Convert_v1alpha1_OrphanVM_To_machine_OrphanVM is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanVM_To_machine_OrphanVM(in *OrphanVM, out *machine.OrphanVM, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanVM_To_machine_OrphanVM(in, out, s)
+}
+
+func autoConvert_machine_OrphanVM_To_v1alpha1_OrphanVM(in *machine.OrphanVM, out *OrphanVM, s conversion.Scope) error {
+	out.ProviderID = in.ProviderID
+	out.MachineName = in.MachineName
+	return nil
+}
+
+// Convert_machine_OrphanVM_To_v1alpha1_OrphanVM is an autogenerated conversion function.
+func Convert_machine_OrphanVM_To_v1alpha1_OrphanVM(in *machine.OrphanVM, out *OrphanVM, s conversion.Scope) error {
+	return autoConvert_machine_OrphanVM_To_v1alpha1_OrphanVM(in, out, s)
+}
+
 func autoConvert_v1alpha1_RollbackConfig_To_machine_RollbackConfig(in *RollbackConfig, out *machine.RollbackConfig, s conversion.Scope) error {
 	out.Revision = in.Revision
 	return nil
@@ -1087,3 +1795,55 @@ func autoConvert_machine_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdat
 func Convert_machine_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(in *machine.RollingUpdateMachineDeployment, out *RollingUpdateMachineDeployment, s conversion.Scope) error {
 	return autoConvert_machine_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(in, out, s)
 }
+
+func autoConvert_v1alpha1_ScalingSchedule_To_machine_ScalingSchedule(in *ScalingSchedule, out *machine.ScalingSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_v1alpha1_ScalingSchedule_To_machine_ScalingSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_ScalingSchedule_To_machine_ScalingSchedule(in *ScalingSchedule, out *machine.ScalingSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScalingSchedule_To_machine_ScalingSchedule(in, out, s)
+}
+
+func autoConvert_machine_ScalingSchedule_To_v1alpha1_ScalingSchedule(in *machine.ScalingSchedule, out *ScalingSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_machine_ScalingSchedule_To_v1alpha1_ScalingSchedule is an autogenerated conversion function.
+func Convert_machine_ScalingSchedule_To_v1alpha1_ScalingSchedule(in *machine.ScalingSchedule, out *ScalingSchedule, s conversion.Scope) error {
+	return autoConvert_machine_ScalingSchedule_To_v1alpha1_ScalingSchedule(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in *ScalingScheduleStatus, out *machine.ScalingScheduleStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastScheduleTime = (*v1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.NextScheduleTime = (*v1.Time)(unsafe.Pointer(in.NextScheduleTime))
+	return nil
+}
+
+// Convert_v1alpha1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in *ScalingScheduleStatus, out *machine.ScalingScheduleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in, out, s)
+}
+
+func autoConvert_machine_ScalingScheduleStatus_To_v1alpha1_ScalingScheduleStatus(in *machine.ScalingScheduleStatus, out *ScalingScheduleStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastScheduleTime = (*v1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.NextScheduleTime = (*v1.Time)(unsafe.Pointer(in.NextScheduleTime))
+	return nil
+}
+
+// Convert_machine_ScalingScheduleStatus_To_v1alpha1_ScalingScheduleStatus is an autogenerated conversion function.
+func Convert_machine_ScalingScheduleStatus_To_v1alpha1_ScalingScheduleStatus(in *machine.ScalingScheduleStatus, out *ScalingScheduleStatus, s conversion.Scope) error {
+	return autoConvert_machine_ScalingScheduleStatus_To_v1alpha1_ScalingScheduleStatus(in, out, s)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// Convert_machine_CurrentStatus_To_v1beta1_CurrentStatus converts an internal CurrentStatus to v1beta1, which
+// drops the unused timeoutActive field.
+func Convert_machine_CurrentStatus_To_v1beta1_CurrentStatus(in *machine.CurrentStatus, out *CurrentStatus, s conversion.Scope) error {
+	return autoConvert_machine_CurrentStatus_To_v1beta1_CurrentStatus(in, out, s)
+}
+
+// Convert_v1beta1_MachineClass_To_machine_MachineClass converts a v1beta1 MachineClass to the internal version,
+// which holds the name of the provider and its spec in separate fields.
+func Convert_v1beta1_MachineClass_To_machine_MachineClass(in *MachineClass, out *machine.MachineClass, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_MachineClass_To_machine_MachineClass(in, out, s); err != nil {
+		return err
+	}
+	out.Provider = in.Provider.Name
+	out.ProviderSpec = in.Provider.Spec
+	return nil
+}
+
+// Convert_machine_MachineClass_To_v1beta1_MachineClass converts an internal MachineClass to v1beta1, which
+// holds the name of the provider and its spec in the provider field.
+func Convert_machine_MachineClass_To_v1beta1_MachineClass(in *machine.MachineClass, out *MachineClass, s conversion.Scope) error {
+	if err := autoConvert_machine_MachineClass_To_v1beta1_MachineClass(in, out, s); err != nil {
+		return err
+	}
+	out.Provider = MachineClassProvider{
+		Name: in.Provider,
+		Spec: in.ProviderSpec,
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// WARNING!
+// IF YOU MODIFY ANY OF THE TYPES HERE MAKE SURE THEY CAN BE CONVERTED FROM AND TO ../types.go
+// AND RUN `make generate`
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="mcc"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machines",type=integer,JSONPath=`.status.machineCount`,description="Number of machines referencing the machine class"
+// +kubebuilder:printcolumn:name="Valid",type=boolean,JSONPath=`.status.lastValidation.valid`,description="Whether the last validation of the machine class succeeded"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.\nPopulated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata"
+
+// MachineClass can be used to templatize and re-use provider configuration
+// across multiple Machines / MachineSets / MachineDeployments.
+// +k8s:openapi-gen=true
+// +resource:path=machineclasses
+type MachineClass struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero
+	NodeTemplate *NodeTemplate `json:"nodeTemplate,omitempty"`
+
+	// CredentialsSecretRef can optionally store the credentials (in this case the SecretRef does not need to store them).
+	// This might be useful if multiple machine classes with the same credentials but different user-datas are used.
+	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
+
+	// Provider describes the cloud-specific driver and its configuration.
+	Provider MachineClassProvider `json:"provider"`
+
+	// SecretRef stores the necessary secrets such as credentials or userdata.
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+
+	// Status holds the most recently observed status of the MachineClass.
+	// +optional
+	Status MachineClassStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// MachineClassList contains a list of MachineClasses
+type MachineClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineClass `json:"items"`
+}
+
+// MachineClassProvider describes the cloud-specific driver of a MachineClass and its configuration.
+type MachineClassProvider struct {
+	// Name is the combination of name and location of cloud-specific drivers.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// Spec is the provider-specific configuration to use during node creation.
+	Spec runtime.RawExtension `json:"spec"`
+}
+
+// MachineClassStatus holds the most recently observed status of the MachineClass.
+type MachineClassStatus struct {
+	// MachineCount is the number of machines referencing the MachineClass.
+	// +optional
+	MachineCount int32 `json:"machineCount,omitempty"`
+
+	// LastValidation is the result of the last validation of the MachineClass by the machine controller.
+	// +optional
+	LastValidation *MachineClassValidation `json:"lastValidation,omitempty"`
+
+	// LastSuccessfulDriverCallTime is the time of the last successful call to the driver with the MachineClass.
+	// +optional
+	LastSuccessfulDriverCallTime *metav1.Time `json:"lastSuccessfulDriverCallTime,omitempty"`
+
+	// OrphanVMs are the VMs of the MachineClass without a machine that were found in the last orphan VM sweep
+	// of the safety controller.
+	// +optional
+	OrphanVMs []OrphanVM `json:"orphanVMs,omitempty"`
+}
+
+// MachineClassValidation is the result of a validation of a MachineClass.
+type MachineClassValidation struct {
+	// Valid tells whether machines can be reconciled with the MachineClass.
+	Valid bool `json:"valid"`
+
+	// Message describes why the MachineClass is not valid.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastUpdateTime is the time at which the result of the validation last changed.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// OrphanVM is a VM at the provider without a machine.
+type OrphanVM struct {
+	// ProviderID is the ID of the VM at the provider.
+	ProviderID string `json:"providerID"`
+
+	// MachineName is the name of the machine the VM was created for.
+	MachineName string `json:"machineName"`
+}
+
+// NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero
+type NodeTemplate struct {
+
+	// Capacity contains subfields to track all node resources required to scale nodegroup from zero
+	Capacity corev1.ResourceList `json:"capacity"`
+
+	// Instance type of the node belonging to nodeGroup
+	InstanceType string `json:"instanceType"`
+
+	// Region of the expected node belonging to nodeGroup
+	Region string `json:"region"`
+
+	// Zone of the expected node belonging to nodeGroup
+	Zone string `json:"zone"`
+
+	// +optional
+	// CPU Architecture of the node belonging to nodeGroup
+	Architecture *string `json:"architecture,omitempty"`
+}
@@ -0,0 +1,612 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// WARNING!
+// IF YOU MODIFY ANY OF THE TYPES HERE MAKE SURE THEY CAN BE CONVERTED FROM AND TO ../types.go
+// AND RUN `make generate`
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="mcd"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`,description="Total number of ready machines targeted by this machine deployment."
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.spec.replicas`,description="Number of desired machines."
+// +kubebuilder:printcolumn:name="Up-to-date",type=integer,JSONPath=`.status.updatedReplicas`,description="Total number of non-terminated machines targeted by this machine deployment that have the desired template spec."
+// +kubebuilder:printcolumn:name="Available",type=integer,JSONPath=`.status.availableReplicas`,description="Total number of available machines (ready for at least minReadySeconds) targeted by this machine deployment."
+// +kubebuilder:printcolumn:name="Standby",type=integer,JSONPath=`.status.standbyReplicas`,description="Total number of machines of the warm pool targeted by this machine deployment.",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.\nPopulated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata"
+
+// MachineDeployment enables declarative updates for machines and MachineSets.
+type MachineDeployment struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of the MachineDeployment.
+	// +optional
+	Spec MachineDeploymentSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the MachineDeployment.
+	// +optional
+	Status MachineDeploymentStatus `json:"status,omitempty"`
+}
+
+// MachineDeploymentSpec is the specification of the desired behavior of the MachineDeployment.
+type MachineDeploymentSpec struct {
+	// Number of desired machines. This is a pointer to distinguish between explicit
+	// zero and not specified. Defaults to 0.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Label selector for machines. Existing MachineSets whose machines are
+	// selected by this will be the ones affected by this MachineDeployment.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Template describes the machines that will be created.
+	Template MachineTemplateSpec `json:"template"`
+
+	// The MachineDeployment strategy to use to replace existing machines with new ones.
+	// +optional
+	// +patchStrategy=retainKeys
+	Strategy MachineDeploymentStrategy `json:"strategy,omitempty" patchStrategy:"retainKeys"`
+
+	// Minimum number of seconds for which a newly created machine should be ready
+	// without any of its container crashing, for it to be considered available.
+	// Defaults to 0 (machine will be considered available as soon as it is ready)
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// The number of old MachineSets to retain to allow rollback.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Indicates that the MachineDeployment is paused and will not be processed by the
+	// MachineDeployment controller.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// DEPRECATED.
+	// The config this MachineDeployment is rolling back to. Will be cleared after rollback is done.
+	// +optional
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+
+	// The maximum time in seconds for a MachineDeployment to make progress before it
+	// is considered to be failed. The MachineDeployment controller will continue to
+	// process failed MachineDeployments and a condition with a ProgressDeadlineExceeded
+	// reason will be surfaced in the MachineDeployment status. Note that progress will
+	// not be estimated during the time a MachineDeployment is paused. This is not set
+	// by default, which is treated as infinite deadline.
+	// +optional
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
+	// MaintenanceWindow restricts disruptive operations, i.e. the scale-down of old machine sets
+	// during a rollout and the deletion of surplus machines, to the given windows.
+	// Replacement of failed machines is not restricted. If not set, disruptive operations
+	// are allowed at any time.
+	// +optional
+	MaintenanceWindow *MachineDeploymentMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// AutoRollback enables the automatic rollback of a failing rollout to the last revision
+	// which has been rolled out completely. If not set, failing rollouts are not rolled back.
+	// +optional
+	AutoRollback *AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
+	// DeletePolicy defines which machines of the machine sets are deleted first on a scale-down. Machines are
+	// still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.
+	// +optional
+	DeletePolicy MachineSetDeletePolicy `json:"deletePolicy,omitempty"`
+
+	// ZoneSpread spreads the machines across several zones, each with its own machine class. The
+	// MachineDeployment manages one MachineDeployment per zone, which rolls out changes with the
+	// strategy of this MachineDeployment. If not set, all machines use the class of the template.
+	// +optional
+	ZoneSpread *MachineDeploymentZoneSpread `json:"zoneSpread,omitempty"`
+
+	// ClassFallback lists the machine classes new machines are created from when the provider has no capacity
+	// for the machine class of the template. If not set, machines are always created from the class of the template.
+	// +optional
+	ClassFallback *ClassFallback `json:"classFallback,omitempty"`
+
+	// WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up,
+	// machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.
+	// +optional
+	WarmPool *MachineWarmPool `json:"warmPool,omitempty"`
+
+	// ScheduledScaling changes the replicas of the MachineDeployment on recurring schedules. The
+	// replicas may still be changed in between, e.g. by the cluster-autoscaler. If not set, the
+	// replicas are only changed by clients.
+	// +optional
+	ScheduledScaling *MachineDeploymentScheduledScaling `json:"scheduledScaling,omitempty"`
+
+	// Frozen is set by the safety controller when the machine sets of the MachineDeployment have more machines
+	// than allowed, which stops them from creating machines. It is reset once the number of machines decreased.
+	// The freeze label is still accepted.
+	// +optional
+	Frozen bool `json:"frozen,omitempty"`
+}
+
+// MachineDeploymentZoneSpread describes how the machines of a MachineDeployment are spread across zones.
+type MachineDeploymentZoneSpread struct {
+	// Zones lists the zones to spread the machines across.
+	Zones []MachineDeploymentZone `json:"zones"`
+
+	// Policy defines how the replicas are distributed across the zones. Defaults to Even.
+	// +optional
+	Policy ZoneSpreadPolicy `json:"policy,omitempty"`
+
+	// MaxSkew is the maximum difference between the replicas of any two zones up to which the MaxSkew policy
+	// moves replicas out of failing zones. Unlike the maxSkew of topology spread constraints, it does not
+	// rebalance the replicas of the other zones. Required for the MaxSkew policy.
+	// +optional
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+}
+
+// MachineDeploymentZone is a zone of a MachineDeployment with its machine class.
+type MachineDeploymentZone struct {
+	// Name is the name of the zone. It is part of the name of the MachineDeployment managing the zone.
+	Name string `json:"name"`
+
+	// Class is the machine class used for the machines in the zone.
+	Class ClassSpec `json:"class"`
+
+	// Weight is the share of the replicas of the zone for the Weighted policy. Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// ZoneSpreadPolicy describes how the replicas of a MachineDeployment are distributed across zones.
+type ZoneSpreadPolicy string
+
+const (
+	// EvenZoneSpreadPolicy distributes the replicas evenly across the zones. This is the default.
+	EvenZoneSpreadPolicy ZoneSpreadPolicy = "Even"
+
+	// WeightedZoneSpreadPolicy distributes the replicas proportionally to the weights of the zones.
+	WeightedZoneSpreadPolicy ZoneSpreadPolicy = "Weighted"
+
+	// MaxSkewZoneSpreadPolicy distributes the replicas evenly across the zones, but moves replicas
+	// from zones exceeding their progress deadline to the other zones within the max skew.
+	MaxSkewZoneSpreadPolicy ZoneSpreadPolicy = "MaxSkew"
+)
+
+// MachineDeploymentZoneStatus is the status of a zone of a MachineDeployment.
+type MachineDeploymentZoneStatus struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+
+	// MachineDeployment is the name of the MachineDeployment managing the zone.
+	MachineDeployment string `json:"machineDeployment"`
+
+	// Replicas is the number of desired replicas of the zone.
+	Replicas int32 `json:"replicas"`
+
+	// UpdatedReplicas is the number of machines of the zone that have the desired template spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// ReadyReplicas is the number of ready machines of the zone.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// AvailableReplicas is the number of available machines of the zone.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+}
+
+// AutoRollbackPolicy describes when a rollout of a MachineDeployment is rolled back automatically.
+type AutoRollbackPolicy struct {
+	// MaxFailedMachines is the number of failed machines of the new machine set which is tolerated.
+	// The rollout is rolled back once more machines of the new machine set have failed.
+	// If not set, the rollout is only rolled back once it exceeds its progress deadline,
+	// which requires progressDeadlineSeconds to be set.
+	// +optional
+	MaxFailedMachines *int32 `json:"maxFailedMachines,omitempty"`
+}
+
+// MachineDeploymentMaintenanceWindow describes when disruptive operations are allowed for a MachineDeployment.
+type MachineDeploymentMaintenanceWindow struct {
+	// Windows is the list of windows during which disruptive operations are allowed.
+	Windows []MaintenanceWindow `json:"windows"`
+
+	// TimeZone is the IANA name of the time zone in which the window schedules are evaluated (ex: Europe/Berlin).
+	// Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindow is a recurring window of time.
+type MaintenanceWindow struct {
+	// Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week)
+	// at which the window opens (ex: "0 22 * * 1-5").
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of time for which the window stays open.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// MachineDeploymentScheduledScaling describes when and how the replicas of a MachineDeployment are changed.
+type MachineDeploymentScheduledScaling struct {
+	// Schedules is the list of schedules changing the replicas.
+	Schedules []ScalingSchedule `json:"schedules"`
+
+	// TimeZone is the IANA name of the time zone in which the schedules are evaluated (ex: Europe/Berlin).
+	// Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ScalingSchedule is a recurring change of the replicas of a MachineDeployment.
+type ScalingSchedule struct {
+	// Name is the name of the schedule. It is unique among the schedules of the MachineDeployment.
+	Name string `json:"name"`
+
+	// Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week)
+	// at which the replicas are changed (ex: "0 7 * * 1-5").
+	Schedule string `json:"schedule"`
+
+	// Replicas is the number of replicas the MachineDeployment is scaled to.
+	// If not set, the replicas are only kept within minReplicas and maxReplicas.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// MinReplicas is the minimum number of replicas the MachineDeployment is scaled to.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas the MachineDeployment is scaled to.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// ScalingScheduleStatus is the status of a schedule of a MachineDeployment with scheduled scaling.
+type ScalingScheduleStatus struct {
+	// Name is the name of the schedule.
+	Name string `json:"name"`
+
+	// LastScheduleTime is the last time the replicas have been changed by the schedule.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the next time the replicas are changed by the schedule.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+const (
+	// DefaultMachineDeploymentUniqueLabelKey is the default key of the selector that is added
+	// to existing MCs (and label key that is added to its machines) to prevent the existing MCs
+	// to select new machines (and old machines being select by new MC).
+	DefaultMachineDeploymentUniqueLabelKey string = "machine-template-hash"
+)
+
+// MachineDeploymentStrategy describes how to replace existing machines with new ones.
+type MachineDeploymentStrategy struct {
+	// Type of MachineDeployment. Can be "Recreate", "RollingUpdate", "Canary" or "BlueGreen". Default is RollingUpdate.
+	// +optional
+	Type MachineDeploymentStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if MachineDeploymentStrategyType =
+	// RollingUpdate.
+	//---
+	// TODO: Update this to follow our convention for oneOf, whatever we decide it
+	// to be.
+	// +optional
+	RollingUpdate *RollingUpdateMachineDeployment `json:"rollingUpdate,omitempty"`
+
+	// Canary update config params. Present only if MachineDeploymentStrategyType =
+	// Canary.
+	// +optional
+	Canary *CanaryMachineDeployment `json:"canary,omitempty"`
+
+	// Blue/green update config params. Present only if MachineDeploymentStrategyType =
+	// BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenMachineDeployment `json:"blueGreen,omitempty"`
+}
+
+// MachineDeploymentStrategyType are valid strategy types for rolling MachineDeployments
+type MachineDeploymentStrategyType string
+
+const (
+	// RecreateMachineDeploymentStrategyType means that all existing machines will be killed before creating new ones.
+	RecreateMachineDeploymentStrategyType MachineDeploymentStrategyType = "Recreate"
+
+	// RollingUpdateMachineDeploymentStrategyType means that old MCs will be replaced by new one using rolling update i.e gradually scale down the old MCs and scale up the new one.
+	RollingUpdateMachineDeploymentStrategyType MachineDeploymentStrategyType = "RollingUpdate"
+
+	// CanaryMachineDeploymentStrategyType means that old MCs will be replaced by new one in explicit steps, which can pause the rollout until the new machines proved healthy.
+	CanaryMachineDeploymentStrategyType MachineDeploymentStrategyType = "Canary"
+
+	// BlueGreenMachineDeploymentStrategyType means that a new MC is scaled up completely next to the old MCs, whose machines are cordoned and drained at once before the old MCs are scaled down.
+	BlueGreenMachineDeploymentStrategyType MachineDeploymentStrategyType = "BlueGreen"
+)
+
+// RollingUpdateMachineDeployment is the spec to control the desired behavior of rolling update.
+type RollingUpdateMachineDeployment struct {
+	// The maximum number of machines that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// Example: when this is set to 30%, the old machine set can be scaled down to 70% of desired machines
+	// immediately when the rolling update starts. Once new machines are ready, old machine set
+	// can be scaled down further, followed by scaling up the new machine set, ensuring
+	// that the total number of machines available at all times during the update is at
+	// least 70% of desired machines.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of machines that can be scheduled above the desired number of
+	// machines.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// This can not be 0 if MaxUnavailable is 0.
+	// Absolute number is calculated from percentage by rounding up.
+	// Example: when this is set to 30%, the new machine set can be scaled up immediately when
+	// the rolling update starts, such that the total number of old and new machines does not exceed
+	// 130% of desired machines. Once old machines have been killed,
+	// new machine set can be scaled up further, ensuring that total number of machines running
+	// at any time during the update is utmost 130% of desired machines.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// CanaryMachineDeployment is the spec to control the desired behavior of canary update.
+type CanaryMachineDeployment struct {
+	// Steps are executed in order to roll out a new machine template. Once all steps are completed,
+	// all machines are moved to the new machine template.
+	Steps []CanaryStep `json:"steps"`
+
+	// The maximum number of machines that can be unavailable while the machines of a step are replaced
+	// and while an aborted canary update moves the machines back to the old machine template.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// Defaults to 0.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of machines that can be scheduled above the desired number of machines
+	// while the machines of a step are replaced and while an aborted canary update moves the machines
+	// back to the old machine template.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// CanaryStep is a single step of a canary update. Exactly one of its fields has to be set.
+type CanaryStep struct {
+	// Replicas is the number of machines which should run the new machine template after this step.
+	// Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// The old machine sets are scaled down within maxSurge and maxUnavailable.
+	// +optional
+	Replicas *intstr.IntOrString `json:"replicas,omitempty"`
+
+	// Pause holds the rollout until all new machines are available for the given duration.
+	// +optional
+	Pause *CanaryPause `json:"pause,omitempty"`
+}
+
+// CanaryPause describes a pause of a canary update.
+type CanaryPause struct {
+	// Duration for which all new machines have to be available before the rollout continues.
+	// If not set, the rollout continues only once it is promoted.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CanaryStatus is the most recently observed status of a canary update.
+type CanaryStatus struct {
+	// MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.
+	// +optional
+	MachineTemplateHash string `json:"machineTemplateHash,omitempty"`
+
+	// CurrentStep is the index of the step which is currently executed.
+	// It is equal to the number of steps once all steps are completed.
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// PauseStartTime is the time since which all new machines are available during a pause step.
+	// +optional
+	PauseStartTime *metav1.Time `json:"pauseStartTime,omitempty"`
+
+	// Aborted is true if the rollout was aborted and the machines were moved back to the old machine template.
+	// +optional
+	Aborted bool `json:"aborted,omitempty"`
+}
+
+// BlueGreenMachineDeployment is the spec to control the desired behavior of blue/green update.
+type BlueGreenMachineDeployment struct {
+	// Hooks are the names of checks which have to pass before the machines are switched to the new
+	// machine set. A check passes once the MachineDeployment is annotated with
+	// bluegreen-hook.deployment.machine.sapcloud.io/<name> set to the machine-template-hash of the new machine set.
+	// +optional
+	Hooks []string `json:"hooks,omitempty"`
+
+	// ConfirmationWindow is the time for which the cordoned machines of the old machine sets are kept
+	// after the switch, so that the switch can be reverted instantly. If not set, they are kept until
+	// the switch is confirmed.
+	// +optional
+	ConfirmationWindow *metav1.Duration `json:"confirmationWindow,omitempty"`
+}
+
+// BlueGreenPhase is the phase of a blue/green update.
+type BlueGreenPhase string
+
+const (
+	// BlueGreenProvisioning means that the new machine set is scaled up next to the old machine sets, until
+	// all its machines have been running for minReadySeconds and all hooks have passed.
+	BlueGreenProvisioning BlueGreenPhase = "Provisioning"
+
+	// BlueGreenSwitched means that the machines of the old machine sets are cordoned and drained, and that
+	// the old machine sets are kept until the confirmation window has passed or the switch is confirmed.
+	BlueGreenSwitched BlueGreenPhase = "Switched"
+
+	// BlueGreenCompleted means that the switch is confirmed and the old machine sets are scaled down.
+	BlueGreenCompleted BlueGreenPhase = "Completed"
+
+	// BlueGreenSwitchedBack means that the machines of the old machine sets are uncordoned again and
+	// the new machine set is scaled down.
+	BlueGreenSwitchedBack BlueGreenPhase = "SwitchedBack"
+)
+
+// BlueGreenStatus is the most recently observed status of a blue/green update.
+type BlueGreenStatus struct {
+	// MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.
+	// +optional
+	MachineTemplateHash string `json:"machineTemplateHash,omitempty"`
+
+	// Phase is the phase of the blue/green update.
+	// +optional
+	Phase BlueGreenPhase `json:"phase,omitempty"`
+
+	// SwitchTime is the time at which the machines of the old machine sets have been cordoned.
+	// +optional
+	SwitchTime *metav1.Time `json:"switchTime,omitempty"`
+}
+
+// MachineDeploymentStatus is the most recently observed status of the MachineDeployment.
+type MachineDeploymentStatus struct {
+	// The generation observed by the MachineDeployment controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Total number of non-terminated machines targeted by this MachineDeployment (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Total number of non-terminated machines targeted by this MachineDeployment that have the desired template spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// Total number of ready machines targeted by this MachineDeployment.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Total number of available machines (ready for at least minReadySeconds) targeted by this MachineDeployment.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// Total number of unavailable machines targeted by this MachineDeployment. This is the total number of
+	// machines that are still required for the MachineDeployment to have 100% available capacity. They may
+	// either be machines that are running but not yet available or machines that still have not been created.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas,omitempty"`
+
+	// Represents the latest available observations of a MachineDeployment's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []MachineDeploymentCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Count of hash collisions for the MachineDeployment. The MachineDeployment controller uses this
+	// field as a collision avoidance mechanism when it needs to create the name for the
+	// newest MachineSet.
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+
+	// FailedMachines has summary of machines on which lastOperation Failed
+	// +optional
+	FailedMachines []*MachineSummary `json:"failedMachines,omitempty"`
+
+	// Canary is the status of the ongoing canary update. Present only if MachineDeploymentStrategyType =
+	// Canary.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+
+	// BlueGreen is the status of the ongoing blue/green update. Present only if MachineDeploymentStrategyType =
+	// BlueGreen.
+	// +optional
+	BlueGreen *BlueGreenStatus `json:"blueGreen,omitempty"`
+
+	// Zones is the status of the zones of a MachineDeployment spreading its machines across zones.
+	// +optional
+	Zones []MachineDeploymentZoneStatus `json:"zones,omitempty"`
+
+	// Total number of machines of the warm pool targeted by this MachineDeployment.
+	// +optional
+	StandbyReplicas int32 `json:"standbyReplicas,omitempty"`
+
+	// ScheduledScaling is the status of the schedules of a MachineDeployment with scheduled scaling.
+	// +optional
+	ScheduledScaling []ScalingScheduleStatus `json:"scheduledScaling,omitempty"`
+}
+
+// MachineDeploymentConditionType are valid conditions of MachineDeployments
+type MachineDeploymentConditionType string
+
+// These are valid conditions of a MachineDeployment.
+const (
+	// Available means the MachineDeployment is available, ie. at least the minimum available
+	// replicas required are up and running for at least minReadySeconds.
+	MachineDeploymentAvailable MachineDeploymentConditionType = "Available"
+
+	// Progressing means the MachineDeployment is progressing. Progress for a MachineDeployment is
+	// considered when a new machine set is created or adopted, and when new machines scale
+	// up or old machines scale down. Progress is not estimated for paused MachineDeployments. It is also updated
+	// if progressDeadlineSeconds is not specified(treated as infinite deadline), in which case it would never be updated to "false".
+	MachineDeploymentProgressing MachineDeploymentConditionType = "Progressing"
+
+	// ReplicaFailure is added in a MachineDeployment when one of its machines fails to be created
+	// or deleted.
+	MachineDeploymentReplicaFailure MachineDeploymentConditionType = "ReplicaFailure"
+
+	// MachineDeploymentFrozen is added in a MachineDeployment when one of its machines fails to be created
+	// or deleted.
+	MachineDeploymentFrozen MachineDeploymentConditionType = "Frozen"
+)
+
+// RollbackConfig is the config to rollback a MachineDeployment
+type RollbackConfig struct {
+	// The revision to rollback to. If set to 0, rollback to the last revision.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// MachineDeploymentCondition describes the state of a MachineDeployment at a certain point.
+type MachineDeploymentCondition struct {
+	// Type of MachineDeployment condition.
+	Type MachineDeploymentConditionType `json:"type"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status ConditionStatus `json:"status"`
+
+	// The last time this condition was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// MachineDeploymentList is a list of MachineDeployments.
+type MachineDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of MachineDeployments.
+	Items []MachineDeployment `json:"items"`
+}
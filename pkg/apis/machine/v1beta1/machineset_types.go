@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// WARNING!
+// IF YOU MODIFY ANY OF THE TYPES HERE MAKE SURE THEY CAN BE CONVERTED FROM AND TO ../types.go
+// AND RUN `make generate`
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+// +kubebuilder:resource:shortName="mcs"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.spec.replicas`,description="Number of desired replicas."
+// +kubebuilder:printcolumn:name="Current",type=integer,JSONPath=`.status.replicas`,description="Number of actual replicas."
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`,description="Number of ready replicas for this machine set."
+// +kubebuilder:printcolumn:name="Standby",type=integer,JSONPath=`.status.standbyReplicas`,description="Number of machines of the warm pool of this machine set.",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.\nPopulated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata"
+
+// MachineSet TODO
+type MachineSet struct {
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	Spec MachineSetSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status MachineSetStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// MachineSetList is a collection of MachineSet.
+type MachineSetList struct {
+	// +optional
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Items []MachineSet `json:"items"`
+}
+
+// MachineSetSpec is the specification of a MachineSet.
+type MachineSetSpec struct {
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// +optional
+	MachineClass ClassSpec `json:"machineClass,omitempty"`
+
+	// +optional
+	Template MachineTemplateSpec `json:"template,omitempty"`
+
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+
+	// DeletePolicy defines which machines are deleted first on a scale-down. Machines are still deleted
+	// by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.
+	// +optional
+	DeletePolicy MachineSetDeletePolicy `json:"deletePolicy,omitempty"`
+
+	// ClassFallback lists the machine classes new machines are created from when the provider has no capacity
+	// for the machine class of the template. If not set, machines are always created from the class of the template.
+	// +optional
+	ClassFallback *ClassFallback `json:"classFallback,omitempty"`
+
+	// WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up,
+	// machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.
+	// +optional
+	WarmPool *MachineWarmPool `json:"warmPool,omitempty"`
+
+	// Frozen stops the MachineSet from creating machines. It is set by the safety controller when the MachineSet
+	// has more machines than allowed and is reset once the number of machines decreased. The freeze label is
+	// still accepted.
+	// +optional
+	Frozen bool `json:"frozen,omitempty"`
+}
+
+// ClassFallback describes the machine classes to fall back to when the provider has no capacity for a machine class.
+type ClassFallback struct {
+	// Classes is the ordered list of machine classes to fall back to. They have to be of the same kind as the
+	// class of the template.
+	Classes []ClassSpec `json:"classes"`
+
+	// CapacityErrorThreshold is the number of machines failing to be created from a class for lack of capacity,
+	// after which new machines are created from the next class. Defaults to 1.
+	// +optional
+	CapacityErrorThreshold *int32 `json:"capacityErrorThreshold,omitempty"`
+
+	// PreferredClassRetryPeriod is the time after which new machines are created from the class of the template
+	// again after falling back to another class. Defaults to 30 minutes.
+	// +optional
+	PreferredClassRetryPeriod *metav1.Duration `json:"preferredClassRetryPeriod,omitempty"`
+}
+
+// ClassFallbackStatus describes the machine class new machines of a MachineSet are created from.
+type ClassFallbackStatus struct {
+	// Class is the machine class new machines are created from.
+	Class ClassSpec `json:"class"`
+
+	// LastTransitionTime is the last time new machines were switched to another class.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// MachineWarmPool describes the machines kept created ahead of time with their VMs stopped.
+type MachineWarmPool struct {
+	// Size is the number of machines kept in the warm pool.
+	Size int32 `json:"size"`
+}
+
+// MachineSetDeletePolicy describes which machines are deleted first on a scale-down of a MachineSet.
+type MachineSetDeletePolicy string
+
+const (
+	// OldestMachineSetDeletePolicy deletes the oldest machines first. This is the default.
+	OldestMachineSetDeletePolicy MachineSetDeletePolicy = "Oldest"
+
+	// NewestMachineSetDeletePolicy deletes the newest machines first.
+	NewestMachineSetDeletePolicy MachineSetDeletePolicy = "Newest"
+
+	// RandomMachineSetDeletePolicy deletes randomly chosen machines.
+	RandomMachineSetDeletePolicy MachineSetDeletePolicy = "Random"
+
+	// ZoneBalancedMachineSetDeletePolicy deletes machines from the zone with the most machines first.
+	ZoneBalancedMachineSetDeletePolicy MachineSetDeletePolicy = "ZoneBalanced"
+
+	// LeastPodsMachineSetDeletePolicy deletes the machines whose nodes run the fewest non-DaemonSet pods first.
+	LeastPodsMachineSetDeletePolicy MachineSetDeletePolicy = "LeastPods"
+)
+
+// MachineSetConditionType is the condition on machineset object
+type MachineSetConditionType string
+
+// These are valid conditions of a machine set.
+const (
+	// MachineSetReplicaFailure is added in a machine set when one of its machines fails to be created
+	// due to insufficient quota, limit ranges, machine security policy, node selectors, etc. or deleted
+	// due to kubelet being down or finalizers are failing.
+	MachineSetReplicaFailure MachineSetConditionType = "ReplicaFailure"
+	// MachineSetFrozen is set when the machineset has exceeded its replica threshold at the safety controller
+	MachineSetFrozen MachineSetConditionType = "Frozen"
+)
+
+// MachineSetCondition describes the state of a machine set at a certain point.
+type MachineSetCondition struct {
+	// Type of machine set condition.
+	Type MachineSetConditionType `json:"type"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status ConditionStatus `json:"status"`
+
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MachineSetStatus holds the most recently observed status of MachineSet.
+type MachineSetStatus struct {
+	// Replicas is the number of actual replicas.
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// The number of pods that have labels matching the labels of the pod template of the replicaset.
+	// +optional
+	FullyLabeledReplicas int32 `json:"fullyLabeledReplicas,omitempty"`
+
+	// The number of ready replicas for this replica set.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// The number of available replicas (ready for at least minReadySeconds) for this replica set.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Represents the latest available observations of a replica set's current state.
+	// +optional
+	Conditions []MachineSetCondition `json:"machineSetCondition,omitempty"`
+
+	// LastOperation performed
+	LastOperation LastOperation `json:"lastOperation,omitempty"`
+
+	// FailedMachines has summary of machines on which lastOperation Failed
+	// +optional
+	FailedMachines *[]MachineSummary `json:"failedMachines,omitempty"`
+
+	// ClassFallback is the machine class new machines are created from, if the MachineSet has class fallback.
+	// +optional
+	ClassFallback *ClassFallbackStatus `json:"classFallback,omitempty"`
+
+	// StandbyReplicas is the number of machines of the warm pool.
+	// +optional
+	StandbyReplicas int32 `json:"standbyReplicas,omitempty"`
+}
@@ -0,0 +1,1852 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	unsafe "unsafe"
+
+	machine "github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AutoRollbackPolicy)(nil), (*machine.AutoRollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(a.(*AutoRollbackPolicy), b.(*machine.AutoRollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.AutoRollbackPolicy)(nil), (*AutoRollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_AutoRollbackPolicy_To_v1beta1_AutoRollbackPolicy(a.(*machine.AutoRollbackPolicy), b.(*AutoRollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenMachineDeployment)(nil), (*machine.BlueGreenMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(a.(*BlueGreenMachineDeployment), b.(*machine.BlueGreenMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.BlueGreenMachineDeployment)(nil), (*BlueGreenMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_BlueGreenMachineDeployment_To_v1beta1_BlueGreenMachineDeployment(a.(*machine.BlueGreenMachineDeployment), b.(*BlueGreenMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenStatus)(nil), (*machine.BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BlueGreenStatus_To_machine_BlueGreenStatus(a.(*BlueGreenStatus), b.(*machine.BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.BlueGreenStatus)(nil), (*BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_BlueGreenStatus_To_v1beta1_BlueGreenStatus(a.(*machine.BlueGreenStatus), b.(*BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryMachineDeployment)(nil), (*machine.CanaryMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(a.(*CanaryMachineDeployment), b.(*machine.CanaryMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryMachineDeployment)(nil), (*CanaryMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryMachineDeployment_To_v1beta1_CanaryMachineDeployment(a.(*machine.CanaryMachineDeployment), b.(*CanaryMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryPause)(nil), (*machine.CanaryPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryPause_To_machine_CanaryPause(a.(*CanaryPause), b.(*machine.CanaryPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryPause)(nil), (*CanaryPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryPause_To_v1beta1_CanaryPause(a.(*machine.CanaryPause), b.(*CanaryPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStatus)(nil), (*machine.CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryStatus_To_machine_CanaryStatus(a.(*CanaryStatus), b.(*machine.CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryStatus)(nil), (*CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryStatus_To_v1beta1_CanaryStatus(a.(*machine.CanaryStatus), b.(*CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStep)(nil), (*machine.CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryStep_To_machine_CanaryStep(a.(*CanaryStep), b.(*machine.CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.CanaryStep)(nil), (*CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CanaryStep_To_v1beta1_CanaryStep(a.(*machine.CanaryStep), b.(*CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClassFallback)(nil), (*machine.ClassFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClassFallback_To_machine_ClassFallback(a.(*ClassFallback), b.(*machine.ClassFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ClassFallback)(nil), (*ClassFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ClassFallback_To_v1beta1_ClassFallback(a.(*machine.ClassFallback), b.(*ClassFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClassFallbackStatus)(nil), (*machine.ClassFallbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClassFallbackStatus_To_machine_ClassFallbackStatus(a.(*ClassFallbackStatus), b.(*machine.ClassFallbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ClassFallbackStatus)(nil), (*ClassFallbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ClassFallbackStatus_To_v1beta1_ClassFallbackStatus(a.(*machine.ClassFallbackStatus), b.(*ClassFallbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClassSpec)(nil), (*machine.ClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClassSpec_To_machine_ClassSpec(a.(*ClassSpec), b.(*machine.ClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ClassSpec)(nil), (*ClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ClassSpec_To_v1beta1_ClassSpec(a.(*machine.ClassSpec), b.(*ClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CurrentStatus)(nil), (*machine.CurrentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CurrentStatus_To_machine_CurrentStatus(a.(*CurrentStatus), b.(*machine.CurrentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LastOperation)(nil), (*machine.LastOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LastOperation_To_machine_LastOperation(a.(*LastOperation), b.(*machine.LastOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.LastOperation)(nil), (*LastOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_LastOperation_To_v1beta1_LastOperation(a.(*machine.LastOperation), b.(*LastOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*machine.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Machine_To_machine_Machine(a.(*Machine), b.(*machine.Machine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.Machine)(nil), (*Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_Machine_To_v1beta1_Machine(a.(*machine.Machine), b.(*Machine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineClassList)(nil), (*machine.MachineClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineClassList_To_machine_MachineClassList(a.(*MachineClassList), b.(*machine.MachineClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineClassList)(nil), (*MachineClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClassList_To_v1beta1_MachineClassList(a.(*machine.MachineClassList), b.(*MachineClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineClassStatus)(nil), (*machine.MachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus(a.(*MachineClassStatus), b.(*machine.MachineClassStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineClassStatus)(nil), (*MachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus(a.(*machine.MachineClassStatus), b.(*MachineClassStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineClassValidation)(nil), (*machine.MachineClassValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineClassValidation_To_machine_MachineClassValidation(a.(*MachineClassValidation), b.(*machine.MachineClassValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineClassValidation)(nil), (*MachineClassValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClassValidation_To_v1beta1_MachineClassValidation(a.(*machine.MachineClassValidation), b.(*MachineClassValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineConfiguration)(nil), (*machine.MachineConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineConfiguration_To_machine_MachineConfiguration(a.(*MachineConfiguration), b.(*machine.MachineConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineConfiguration)(nil), (*MachineConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineConfiguration_To_v1beta1_MachineConfiguration(a.(*machine.MachineConfiguration), b.(*MachineConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeployment)(nil), (*machine.MachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeployment_To_machine_MachineDeployment(a.(*MachineDeployment), b.(*machine.MachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeployment)(nil), (*MachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeployment_To_v1beta1_MachineDeployment(a.(*machine.MachineDeployment), b.(*MachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentCondition)(nil), (*machine.MachineDeploymentCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentCondition_To_machine_MachineDeploymentCondition(a.(*MachineDeploymentCondition), b.(*machine.MachineDeploymentCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentCondition)(nil), (*MachineDeploymentCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentCondition_To_v1beta1_MachineDeploymentCondition(a.(*machine.MachineDeploymentCondition), b.(*MachineDeploymentCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentList)(nil), (*machine.MachineDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentList_To_machine_MachineDeploymentList(a.(*MachineDeploymentList), b.(*machine.MachineDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentList)(nil), (*MachineDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentList_To_v1beta1_MachineDeploymentList(a.(*machine.MachineDeploymentList), b.(*MachineDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentMaintenanceWindow)(nil), (*machine.MachineDeploymentMaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(a.(*MachineDeploymentMaintenanceWindow), b.(*machine.MachineDeploymentMaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentMaintenanceWindow)(nil), (*MachineDeploymentMaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentMaintenanceWindow_To_v1beta1_MachineDeploymentMaintenanceWindow(a.(*machine.MachineDeploymentMaintenanceWindow), b.(*MachineDeploymentMaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentScheduledScaling)(nil), (*machine.MachineDeploymentScheduledScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(a.(*MachineDeploymentScheduledScaling), b.(*machine.MachineDeploymentScheduledScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentScheduledScaling)(nil), (*MachineDeploymentScheduledScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentScheduledScaling_To_v1beta1_MachineDeploymentScheduledScaling(a.(*machine.MachineDeploymentScheduledScaling), b.(*MachineDeploymentScheduledScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentSpec)(nil), (*machine.MachineDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(a.(*MachineDeploymentSpec), b.(*machine.MachineDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentSpec)(nil), (*MachineDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec(a.(*machine.MachineDeploymentSpec), b.(*MachineDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentStatus)(nil), (*machine.MachineDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus(a.(*MachineDeploymentStatus), b.(*machine.MachineDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentStatus)(nil), (*MachineDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(a.(*machine.MachineDeploymentStatus), b.(*MachineDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentStrategy)(nil), (*machine.MachineDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(a.(*MachineDeploymentStrategy), b.(*machine.MachineDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentStrategy)(nil), (*MachineDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(a.(*machine.MachineDeploymentStrategy), b.(*MachineDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZone)(nil), (*machine.MachineDeploymentZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentZone_To_machine_MachineDeploymentZone(a.(*MachineDeploymentZone), b.(*machine.MachineDeploymentZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZone)(nil), (*MachineDeploymentZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZone_To_v1beta1_MachineDeploymentZone(a.(*machine.MachineDeploymentZone), b.(*MachineDeploymentZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZoneSpread)(nil), (*machine.MachineDeploymentZoneSpread)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(a.(*MachineDeploymentZoneSpread), b.(*machine.MachineDeploymentZoneSpread), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZoneSpread)(nil), (*MachineDeploymentZoneSpread)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZoneSpread_To_v1beta1_MachineDeploymentZoneSpread(a.(*machine.MachineDeploymentZoneSpread), b.(*MachineDeploymentZoneSpread), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineDeploymentZoneStatus)(nil), (*machine.MachineDeploymentZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(a.(*MachineDeploymentZoneStatus), b.(*machine.MachineDeploymentZoneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineDeploymentZoneStatus)(nil), (*MachineDeploymentZoneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineDeploymentZoneStatus_To_v1beta1_MachineDeploymentZoneStatus(a.(*machine.MachineDeploymentZoneStatus), b.(*MachineDeploymentZoneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*machine.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineList_To_machine_MachineList(a.(*MachineList), b.(*machine.MachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineList)(nil), (*MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineList_To_v1beta1_MachineList(a.(*machine.MachineList), b.(*MachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSet)(nil), (*machine.MachineSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSet_To_machine_MachineSet(a.(*MachineSet), b.(*machine.MachineSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSet)(nil), (*MachineSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSet_To_v1beta1_MachineSet(a.(*machine.MachineSet), b.(*MachineSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSetCondition)(nil), (*machine.MachineSetCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSetCondition_To_machine_MachineSetCondition(a.(*MachineSetCondition), b.(*machine.MachineSetCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSetCondition)(nil), (*MachineSetCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSetCondition_To_v1beta1_MachineSetCondition(a.(*machine.MachineSetCondition), b.(*MachineSetCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSetList)(nil), (*machine.MachineSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSetList_To_machine_MachineSetList(a.(*MachineSetList), b.(*machine.MachineSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSetList)(nil), (*MachineSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSetList_To_v1beta1_MachineSetList(a.(*machine.MachineSetList), b.(*MachineSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSetSpec)(nil), (*machine.MachineSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec(a.(*MachineSetSpec), b.(*machine.MachineSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSetSpec)(nil), (*MachineSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec(a.(*machine.MachineSetSpec), b.(*MachineSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSetStatus)(nil), (*machine.MachineSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus(a.(*MachineSetStatus), b.(*machine.MachineSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSetStatus)(nil), (*MachineSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus(a.(*machine.MachineSetStatus), b.(*MachineSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSpec)(nil), (*machine.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSpec_To_machine_MachineSpec(a.(*MachineSpec), b.(*machine.MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSpec)(nil), (*MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSpec_To_v1beta1_MachineSpec(a.(*machine.MachineSpec), b.(*MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineStatus)(nil), (*machine.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineStatus_To_machine_MachineStatus(a.(*MachineStatus), b.(*machine.MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineStatus)(nil), (*MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineStatus_To_v1beta1_MachineStatus(a.(*machine.MachineStatus), b.(*MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSummary)(nil), (*machine.MachineSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineSummary_To_machine_MachineSummary(a.(*MachineSummary), b.(*machine.MachineSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineSummary)(nil), (*MachineSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineSummary_To_v1beta1_MachineSummary(a.(*machine.MachineSummary), b.(*MachineSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineTemplateSpec)(nil), (*machine.MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(a.(*MachineTemplateSpec), b.(*machine.MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineTemplateSpec)(nil), (*MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(a.(*machine.MachineTemplateSpec), b.(*MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineWarmPool)(nil), (*machine.MachineWarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineWarmPool_To_machine_MachineWarmPool(a.(*MachineWarmPool), b.(*machine.MachineWarmPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MachineWarmPool)(nil), (*MachineWarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineWarmPool_To_v1beta1_MachineWarmPool(a.(*machine.MachineWarmPool), b.(*MachineWarmPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*machine.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceWindow_To_machine_MaintenanceWindow(a.(*MaintenanceWindow), b.(*machine.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MaintenanceWindow_To_v1beta1_MaintenanceWindow(a.(*machine.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeTemplate)(nil), (*machine.NodeTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeTemplate_To_machine_NodeTemplate(a.(*NodeTemplate), b.(*machine.NodeTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.NodeTemplate)(nil), (*NodeTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_NodeTemplate_To_v1beta1_NodeTemplate(a.(*machine.NodeTemplate), b.(*NodeTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeTemplateSpec)(nil), (*machine.NodeTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec(a.(*NodeTemplateSpec), b.(*machine.NodeTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.NodeTemplateSpec)(nil), (*NodeTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec(a.(*machine.NodeTemplateSpec), b.(*NodeTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanVM)(nil), (*machine.OrphanVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OrphanVM_To_machine_OrphanVM(a.(*OrphanVM), b.(*machine.OrphanVM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.OrphanVM)(nil), (*OrphanVM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_OrphanVM_To_v1beta1_OrphanVM(a.(*machine.OrphanVM), b.(*OrphanVM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RollbackConfig)(nil), (*machine.RollbackConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RollbackConfig_To_machine_RollbackConfig(a.(*RollbackConfig), b.(*machine.RollbackConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.RollbackConfig)(nil), (*RollbackConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_RollbackConfig_To_v1beta1_RollbackConfig(a.(*machine.RollbackConfig), b.(*RollbackConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RollingUpdateMachineDeployment)(nil), (*machine.RollingUpdateMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RollingUpdateMachineDeployment_To_machine_RollingUpdateMachineDeployment(a.(*RollingUpdateMachineDeployment), b.(*machine.RollingUpdateMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.RollingUpdateMachineDeployment)(nil), (*RollingUpdateMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_RollingUpdateMachineDeployment_To_v1beta1_RollingUpdateMachineDeployment(a.(*machine.RollingUpdateMachineDeployment), b.(*RollingUpdateMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScalingSchedule)(nil), (*machine.ScalingSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ScalingSchedule_To_machine_ScalingSchedule(a.(*ScalingSchedule), b.(*machine.ScalingSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ScalingSchedule)(nil), (*ScalingSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ScalingSchedule_To_v1beta1_ScalingSchedule(a.(*machine.ScalingSchedule), b.(*ScalingSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScalingScheduleStatus)(nil), (*machine.ScalingScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(a.(*ScalingScheduleStatus), b.(*machine.ScalingScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*machine.ScalingScheduleStatus)(nil), (*ScalingScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_ScalingScheduleStatus_To_v1beta1_ScalingScheduleStatus(a.(*machine.ScalingScheduleStatus), b.(*ScalingScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*machine.CurrentStatus)(nil), (*CurrentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_CurrentStatus_To_v1beta1_CurrentStatus(a.(*machine.CurrentStatus), b.(*CurrentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*machine.MachineClass)(nil), (*MachineClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_machine_MachineClass_To_v1beta1_MachineClass(a.(*machine.MachineClass), b.(*MachineClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*MachineClass)(nil), (*machine.MachineClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineClass_To_machine_MachineClass(a.(*MachineClass), b.(*machine.MachineClass), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in *AutoRollbackPolicy, out *machine.AutoRollbackPolicy, s conversion.Scope) error {
+	out.MaxFailedMachines = (*int32)(unsafe.Pointer(in.MaxFailedMachines))
+	return nil
+}
+
+// Convert_v1beta1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy is an autogenerated conversion function.
+func Convert_v1beta1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in *AutoRollbackPolicy, out *machine.AutoRollbackPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_AutoRollbackPolicy_To_machine_AutoRollbackPolicy(in, out, s)
+}
+
+func autoConvert_machine_AutoRollbackPolicy_To_v1beta1_AutoRollbackPolicy(in *machine.AutoRollbackPolicy, out *AutoRollbackPolicy, s conversion.Scope) error {
+	out.MaxFailedMachines = (*int32)(unsafe.Pointer(in.MaxFailedMachines))
+	return nil
+}
+
+// Convert_machine_AutoRollbackPolicy_To_v1beta1_AutoRollbackPolicy is an autogenerated conversion function.
+func Convert_machine_AutoRollbackPolicy_To_v1beta1_AutoRollbackPolicy(in *machine.AutoRollbackPolicy, out *AutoRollbackPolicy, s conversion.Scope) error {
+	return autoConvert_machine_AutoRollbackPolicy_To_v1beta1_AutoRollbackPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in *BlueGreenMachineDeployment, out *machine.BlueGreenMachineDeployment, s conversion.Scope) error {
+	out.Hooks = *(*[]string)(unsafe.Pointer(&in.Hooks))
+	out.ConfirmationWindow = (*v1.Duration)(unsafe.Pointer(in.ConfirmationWindow))
+	return nil
+}
+
+// Convert_v1beta1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment is an autogenerated conversion function.
+func Convert_v1beta1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in *BlueGreenMachineDeployment, out *machine.BlueGreenMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1beta1_BlueGreenMachineDeployment_To_machine_BlueGreenMachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_BlueGreenMachineDeployment_To_v1beta1_BlueGreenMachineDeployment(in *machine.BlueGreenMachineDeployment, out *BlueGreenMachineDeployment, s conversion.Scope) error {
+	out.Hooks = *(*[]string)(unsafe.Pointer(&in.Hooks))
+	out.ConfirmationWindow = (*v1.Duration)(unsafe.Pointer(in.ConfirmationWindow))
+	return nil
+}
+
+// Convert_machine_BlueGreenMachineDeployment_To_v1beta1_BlueGreenMachineDeployment is an autogenerated conversion function.
+func Convert_machine_BlueGreenMachineDeployment_To_v1beta1_BlueGreenMachineDeployment(in *machine.BlueGreenMachineDeployment, out *BlueGreenMachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_BlueGreenMachineDeployment_To_v1beta1_BlueGreenMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1beta1_BlueGreenStatus_To_machine_BlueGreenStatus(in *BlueGreenStatus, out *machine.BlueGreenStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.Phase = machine.BlueGreenPhase(in.Phase)
+	out.SwitchTime = (*v1.Time)(unsafe.Pointer(in.SwitchTime))
+	return nil
+}
+
+// Convert_v1beta1_BlueGreenStatus_To_machine_BlueGreenStatus is an autogenerated conversion function.
+func Convert_v1beta1_BlueGreenStatus_To_machine_BlueGreenStatus(in *BlueGreenStatus, out *machine.BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BlueGreenStatus_To_machine_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_machine_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in *machine.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.Phase = BlueGreenPhase(in.Phase)
+	out.SwitchTime = (*v1.Time)(unsafe.Pointer(in.SwitchTime))
+	return nil
+}
+
+// Convert_machine_BlueGreenStatus_To_v1beta1_BlueGreenStatus is an autogenerated conversion function.
+func Convert_machine_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in *machine.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_machine_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in *CanaryMachineDeployment, out *machine.CanaryMachineDeployment, s conversion.Scope) error {
+	out.Steps = *(*[]machine.CanaryStep)(unsafe.Pointer(&in.Steps))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1beta1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment is an autogenerated conversion function.
+func Convert_v1beta1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in *CanaryMachineDeployment, out *machine.CanaryMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryMachineDeployment_To_machine_CanaryMachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_CanaryMachineDeployment_To_v1beta1_CanaryMachineDeployment(in *machine.CanaryMachineDeployment, out *CanaryMachineDeployment, s conversion.Scope) error {
+	out.Steps = *(*[]CanaryStep)(unsafe.Pointer(&in.Steps))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_machine_CanaryMachineDeployment_To_v1beta1_CanaryMachineDeployment is an autogenerated conversion function.
+func Convert_machine_CanaryMachineDeployment_To_v1beta1_CanaryMachineDeployment(in *machine.CanaryMachineDeployment, out *CanaryMachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_CanaryMachineDeployment_To_v1beta1_CanaryMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryPause_To_machine_CanaryPause(in *CanaryPause, out *machine.CanaryPause, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1beta1_CanaryPause_To_machine_CanaryPause is an autogenerated conversion function.
+func Convert_v1beta1_CanaryPause_To_machine_CanaryPause(in *CanaryPause, out *machine.CanaryPause, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryPause_To_machine_CanaryPause(in, out, s)
+}
+
+func autoConvert_machine_CanaryPause_To_v1beta1_CanaryPause(in *machine.CanaryPause, out *CanaryPause, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_machine_CanaryPause_To_v1beta1_CanaryPause is an autogenerated conversion function.
+func Convert_machine_CanaryPause_To_v1beta1_CanaryPause(in *machine.CanaryPause, out *CanaryPause, s conversion.Scope) error {
+	return autoConvert_machine_CanaryPause_To_v1beta1_CanaryPause(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryStatus_To_machine_CanaryStatus(in *CanaryStatus, out *machine.CanaryStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.CurrentStep = in.CurrentStep
+	out.PauseStartTime = (*v1.Time)(unsafe.Pointer(in.PauseStartTime))
+	out.Aborted = in.Aborted
+	return nil
+}
+
+// Convert_v1beta1_CanaryStatus_To_machine_CanaryStatus is an autogenerated conversion function.
+func Convert_v1beta1_CanaryStatus_To_machine_CanaryStatus(in *CanaryStatus, out *machine.CanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryStatus_To_machine_CanaryStatus(in, out, s)
+}
+
+func autoConvert_machine_CanaryStatus_To_v1beta1_CanaryStatus(in *machine.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	out.MachineTemplateHash = in.MachineTemplateHash
+	out.CurrentStep = in.CurrentStep
+	out.PauseStartTime = (*v1.Time)(unsafe.Pointer(in.PauseStartTime))
+	out.Aborted = in.Aborted
+	return nil
+}
+
+// Convert_machine_CanaryStatus_To_v1beta1_CanaryStatus is an autogenerated conversion function.
+func Convert_machine_CanaryStatus_To_v1beta1_CanaryStatus(in *machine.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	return autoConvert_machine_CanaryStatus_To_v1beta1_CanaryStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryStep_To_machine_CanaryStep(in *CanaryStep, out *machine.CanaryStep, s conversion.Scope) error {
+	out.Replicas = (*intstr.IntOrString)(unsafe.Pointer(in.Replicas))
+	out.Pause = (*machine.CanaryPause)(unsafe.Pointer(in.Pause))
+	return nil
+}
+
+// Convert_v1beta1_CanaryStep_To_machine_CanaryStep is an autogenerated conversion function.
+func Convert_v1beta1_CanaryStep_To_machine_CanaryStep(in *CanaryStep, out *machine.CanaryStep, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryStep_To_machine_CanaryStep(in, out, s)
+}
+
+func autoConvert_machine_CanaryStep_To_v1beta1_CanaryStep(in *machine.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	out.Replicas = (*intstr.IntOrString)(unsafe.Pointer(in.Replicas))
+	out.Pause = (*CanaryPause)(unsafe.Pointer(in.Pause))
+	return nil
+}
+
+// Convert_machine_CanaryStep_To_v1beta1_CanaryStep is an autogenerated conversion function.
+func Convert_machine_CanaryStep_To_v1beta1_CanaryStep(in *machine.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	return autoConvert_machine_CanaryStep_To_v1beta1_CanaryStep(in, out, s)
+}
+
+func autoConvert_v1beta1_ClassFallback_To_machine_ClassFallback(in *ClassFallback, out *machine.ClassFallback, s conversion.Scope) error {
+	out.Classes = *(*[]machine.ClassSpec)(unsafe.Pointer(&in.Classes))
+	out.CapacityErrorThreshold = (*int32)(unsafe.Pointer(in.CapacityErrorThreshold))
+	out.PreferredClassRetryPeriod = (*v1.Duration)(unsafe.Pointer(in.PreferredClassRetryPeriod))
+	return nil
+}
+
+// Convert_v1beta1_ClassFallback_To_machine_ClassFallback is an autogenerated conversion function.
+func Convert_v1beta1_ClassFallback_To_machine_ClassFallback(in *ClassFallback, out *machine.ClassFallback, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClassFallback_To_machine_ClassFallback(in, out, s)
+}
+
+func autoConvert_machine_ClassFallback_To_v1beta1_ClassFallback(in *machine.ClassFallback, out *ClassFallback, s conversion.Scope) error {
+	out.Classes = *(*[]ClassSpec)(unsafe.Pointer(&in.Classes))
+	out.CapacityErrorThreshold = (*int32)(unsafe.Pointer(in.CapacityErrorThreshold))
+	out.PreferredClassRetryPeriod = (*v1.Duration)(unsafe.Pointer(in.PreferredClassRetryPeriod))
+	return nil
+}
+
+// Convert_machine_ClassFallback_To_v1beta1_ClassFallback is an autogenerated conversion function.
+func Convert_machine_ClassFallback_To_v1beta1_ClassFallback(in *machine.ClassFallback, out *ClassFallback, s conversion.Scope) error {
+	return autoConvert_machine_ClassFallback_To_v1beta1_ClassFallback(in, out, s)
+}
+
+func autoConvert_v1beta1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in *ClassFallbackStatus, out *machine.ClassFallbackStatus, s conversion.Scope) error {
+	if err := Convert_v1beta1_ClassSpec_To_machine_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1beta1_ClassFallbackStatus_To_machine_ClassFallbackStatus is an autogenerated conversion function.
+func Convert_v1beta1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in *ClassFallbackStatus, out *machine.ClassFallbackStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClassFallbackStatus_To_machine_ClassFallbackStatus(in, out, s)
+}
+
+func autoConvert_machine_ClassFallbackStatus_To_v1beta1_ClassFallbackStatus(in *machine.ClassFallbackStatus, out *ClassFallbackStatus, s conversion.Scope) error {
+	if err := Convert_machine_ClassSpec_To_v1beta1_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_machine_ClassFallbackStatus_To_v1beta1_ClassFallbackStatus is an autogenerated conversion function.
+func Convert_machine_ClassFallbackStatus_To_v1beta1_ClassFallbackStatus(in *machine.ClassFallbackStatus, out *ClassFallbackStatus, s conversion.Scope) error {
+	return autoConvert_machine_ClassFallbackStatus_To_v1beta1_ClassFallbackStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ClassSpec_To_machine_ClassSpec(in *ClassSpec, out *machine.ClassSpec, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ClassSpec_To_machine_ClassSpec is an autogenerated conversion function.
+func Convert_v1beta1_ClassSpec_To_machine_ClassSpec(in *ClassSpec, out *machine.ClassSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClassSpec_To_machine_ClassSpec(in, out, s)
+}
+
+func autoConvert_machine_ClassSpec_To_v1beta1_ClassSpec(in *machine.ClassSpec, out *ClassSpec, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_machine_ClassSpec_To_v1beta1_ClassSpec is an autogenerated conversion function.
+func Convert_machine_ClassSpec_To_v1beta1_ClassSpec(in *machine.ClassSpec, out *ClassSpec, s conversion.Scope) error {
+	return autoConvert_machine_ClassSpec_To_v1beta1_ClassSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_CurrentStatus_To_machine_CurrentStatus(in *CurrentStatus, out *machine.CurrentStatus, s conversion.Scope) error {
+	out.Phase = machine.MachinePhase(in.Phase)
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_CurrentStatus_To_machine_CurrentStatus is an autogenerated conversion function.
+func Convert_v1beta1_CurrentStatus_To_machine_CurrentStatus(in *CurrentStatus, out *machine.CurrentStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CurrentStatus_To_machine_CurrentStatus(in, out, s)
+}
+
+func autoConvert_machine_CurrentStatus_To_v1beta1_CurrentStatus(in *machine.CurrentStatus, out *CurrentStatus, s conversion.Scope) error {
+	out.Phase = MachinePhase(in.Phase)
+	// WARNING: in.TimeoutActive requires manual conversion: does not exist in peer-type
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+func autoConvert_v1beta1_LastOperation_To_machine_LastOperation(in *LastOperation, out *machine.LastOperation, s conversion.Scope) error {
+	out.Description = in.Description
+	out.ErrorCode = in.ErrorCode
+	out.LastUpdateTime = in.LastUpdateTime
+	out.State = machine.MachineState(in.State)
+	out.Type = machine.MachineOperationType(in.Type)
+	return nil
+}
+
+// Convert_v1beta1_LastOperation_To_machine_LastOperation is an autogenerated conversion function.
+func Convert_v1beta1_LastOperation_To_machine_LastOperation(in *LastOperation, out *machine.LastOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_LastOperation_To_machine_LastOperation(in, out, s)
+}
+
+func autoConvert_machine_LastOperation_To_v1beta1_LastOperation(in *machine.LastOperation, out *LastOperation, s conversion.Scope) error {
+	out.Description = in.Description
+	out.ErrorCode = in.ErrorCode
+	out.LastUpdateTime = in.LastUpdateTime
+	out.State = MachineState(in.State)
+	out.Type = MachineOperationType(in.Type)
+	return nil
+}
+
+// Convert_machine_LastOperation_To_v1beta1_LastOperation is an autogenerated conversion function.
+func Convert_machine_LastOperation_To_v1beta1_LastOperation(in *machine.LastOperation, out *LastOperation, s conversion.Scope) error {
+	return autoConvert_machine_LastOperation_To_v1beta1_LastOperation(in, out, s)
+}
+
+func autoConvert_v1beta1_Machine_To_machine_Machine(in *Machine, out *machine.Machine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MachineSpec_To_machine_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MachineStatus_To_machine_MachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Machine_To_machine_Machine is an autogenerated conversion function.
+func Convert_v1beta1_Machine_To_machine_Machine(in *Machine, out *machine.Machine, s conversion.Scope) error {
+	return autoConvert_v1beta1_Machine_To_machine_Machine(in, out, s)
+}
+
+func autoConvert_machine_Machine_To_v1beta1_Machine(in *machine.Machine, out *Machine, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_machine_MachineSpec_To_v1beta1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_MachineStatus_To_v1beta1_MachineStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_machine_Machine_To_v1beta1_Machine is an autogenerated conversion function.
+func Convert_machine_Machine_To_v1beta1_Machine(in *machine.Machine, out *Machine, s conversion.Scope) error {
+	return autoConvert_machine_Machine_To_v1beta1_Machine(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineClass_To_machine_MachineClass(in *MachineClass, out *machine.MachineClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.NodeTemplate = (*machine.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.CredentialsSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	// WARNING: in.Provider requires manual conversion: inconvertible types (github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassProvider vs string)
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	if err := Convert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_machine_MachineClass_To_v1beta1_MachineClass(in *machine.MachineClass, out *MachineClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.NodeTemplate = (*NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.CredentialsSecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	// WARNING: in.Provider requires manual conversion: inconvertible types (string vs github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassProvider)
+	// WARNING: in.ProviderSpec requires manual conversion: does not exist in peer-type
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	if err := Convert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_MachineClassList_To_machine_MachineClassList(in *MachineClassList, out *machine.MachineClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]machine.MachineClass, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_MachineClass_To_machine_MachineClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineClassList_To_machine_MachineClassList is an autogenerated conversion function.
+func Convert_v1beta1_MachineClassList_To_machine_MachineClassList(in *MachineClassList, out *machine.MachineClassList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineClassList_To_machine_MachineClassList(in, out, s)
+}
+
+func autoConvert_machine_MachineClassList_To_v1beta1_MachineClassList(in *machine.MachineClassList, out *MachineClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineClass, len(*in))
+		for i := range *in {
+			if err := Convert_machine_MachineClass_To_v1beta1_MachineClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_machine_MachineClassList_To_v1beta1_MachineClassList is an autogenerated conversion function.
+func Convert_machine_MachineClassList_To_v1beta1_MachineClassList(in *machine.MachineClassList, out *MachineClassList, s conversion.Scope) error {
+	return autoConvert_machine_MachineClassList_To_v1beta1_MachineClassList(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus(in *MachineClassStatus, out *machine.MachineClassStatus, s conversion.Scope) error {
+	out.MachineCount = in.MachineCount
+	out.LastValidation = (*machine.MachineClassValidation)(unsafe.Pointer(in.LastValidation))
+	out.LastSuccessfulDriverCallTime = (*v1.Time)(unsafe.Pointer(in.LastSuccessfulDriverCallTime))
+	out.OrphanVMs = *(*[]machine.OrphanVM)(unsafe.Pointer(&in.OrphanVMs))
+	return nil
+}
+
+// Convert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus(in *MachineClassStatus, out *machine.MachineClassStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineClassStatus_To_machine_MachineClassStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus(in *machine.MachineClassStatus, out *MachineClassStatus, s conversion.Scope) error {
+	out.MachineCount = in.MachineCount
+	out.LastValidation = (*MachineClassValidation)(unsafe.Pointer(in.LastValidation))
+	out.LastSuccessfulDriverCallTime = (*v1.Time)(unsafe.Pointer(in.LastSuccessfulDriverCallTime))
+	out.OrphanVMs = *(*[]OrphanVM)(unsafe.Pointer(&in.OrphanVMs))
+	return nil
+}
+
+// Convert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus is an autogenerated conversion function.
+func Convert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus(in *machine.MachineClassStatus, out *MachineClassStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineClassStatus_To_v1beta1_MachineClassStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineClassValidation_To_machine_MachineClassValidation(in *MachineClassValidation, out *machine.MachineClassValidation, s conversion.Scope) error {
+	out.Valid = in.Valid
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_MachineClassValidation_To_machine_MachineClassValidation is an autogenerated conversion function.
+func Convert_v1beta1_MachineClassValidation_To_machine_MachineClassValidation(in *MachineClassValidation, out *machine.MachineClassValidation, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineClassValidation_To_machine_MachineClassValidation(in, out, s)
+}
+
+func autoConvert_machine_MachineClassValidation_To_v1beta1_MachineClassValidation(in *machine.MachineClassValidation, out *MachineClassValidation, s conversion.Scope) error {
+	out.Valid = in.Valid
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_machine_MachineClassValidation_To_v1beta1_MachineClassValidation is an autogenerated conversion function.
+func Convert_machine_MachineClassValidation_To_v1beta1_MachineClassValidation(in *machine.MachineClassValidation, out *MachineClassValidation, s conversion.Scope) error {
+	return autoConvert_machine_MachineClassValidation_To_v1beta1_MachineClassValidation(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineConfiguration_To_machine_MachineConfiguration(in *MachineConfiguration, out *machine.MachineConfiguration, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	out.NodeConditions = (*string)(unsafe.Pointer(in.NodeConditions))
+	return nil
+}
+
+// Convert_v1beta1_MachineConfiguration_To_machine_MachineConfiguration is an autogenerated conversion function.
+func Convert_v1beta1_MachineConfiguration_To_machine_MachineConfiguration(in *MachineConfiguration, out *machine.MachineConfiguration, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineConfiguration_To_machine_MachineConfiguration(in, out, s)
+}
+
+func autoConvert_machine_MachineConfiguration_To_v1beta1_MachineConfiguration(in *machine.MachineConfiguration, out *MachineConfiguration, s conversion.Scope) error {
+	out.MachineDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDrainTimeout))
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MachineCreationTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	out.NodeConditions = (*string)(unsafe.Pointer(in.NodeConditions))
+	return nil
+}
+
+// Convert_machine_MachineConfiguration_To_v1beta1_MachineConfiguration is an autogenerated conversion function.
+func Convert_machine_MachineConfiguration_To_v1beta1_MachineConfiguration(in *machine.MachineConfiguration, out *MachineConfiguration, s conversion.Scope) error {
+	return autoConvert_machine_MachineConfiguration_To_v1beta1_MachineConfiguration(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeployment_To_machine_MachineDeployment(in *MachineDeployment, out *machine.MachineDeployment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineDeployment_To_machine_MachineDeployment is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeployment_To_machine_MachineDeployment(in *MachineDeployment, out *machine.MachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeployment_To_machine_MachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_MachineDeployment_To_v1beta1_MachineDeployment(in *machine.MachineDeployment, out *MachineDeployment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_machine_MachineDeployment_To_v1beta1_MachineDeployment is an autogenerated conversion function.
+func Convert_machine_MachineDeployment_To_v1beta1_MachineDeployment(in *machine.MachineDeployment, out *MachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeployment_To_v1beta1_MachineDeployment(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentCondition_To_machine_MachineDeploymentCondition(in *MachineDeploymentCondition, out *machine.MachineDeploymentCondition, s conversion.Scope) error {
+	out.Type = machine.MachineDeploymentConditionType(in.Type)
+	out.Status = machine.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentCondition_To_machine_MachineDeploymentCondition is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentCondition_To_machine_MachineDeploymentCondition(in *MachineDeploymentCondition, out *machine.MachineDeploymentCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentCondition_To_machine_MachineDeploymentCondition(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentCondition_To_v1beta1_MachineDeploymentCondition(in *machine.MachineDeploymentCondition, out *MachineDeploymentCondition, s conversion.Scope) error {
+	out.Type = MachineDeploymentConditionType(in.Type)
+	out.Status = ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_machine_MachineDeploymentCondition_To_v1beta1_MachineDeploymentCondition is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentCondition_To_v1beta1_MachineDeploymentCondition(in *machine.MachineDeploymentCondition, out *MachineDeploymentCondition, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentCondition_To_v1beta1_MachineDeploymentCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentList_To_machine_MachineDeploymentList(in *MachineDeploymentList, out *machine.MachineDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]machine.MachineDeployment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentList_To_machine_MachineDeploymentList is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentList_To_machine_MachineDeploymentList(in *MachineDeploymentList, out *machine.MachineDeploymentList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentList_To_machine_MachineDeploymentList(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentList_To_v1beta1_MachineDeploymentList(in *machine.MachineDeploymentList, out *MachineDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MachineDeployment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentList_To_v1beta1_MachineDeploymentList is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentList_To_v1beta1_MachineDeploymentList(in *machine.MachineDeploymentList, out *MachineDeploymentList, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentList_To_v1beta1_MachineDeploymentList(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in *MachineDeploymentMaintenanceWindow, out *machine.MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	out.Windows = *(*[]machine.MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in *MachineDeploymentMaintenanceWindow, out *machine.MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentMaintenanceWindow_To_machine_MachineDeploymentMaintenanceWindow(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentMaintenanceWindow_To_v1beta1_MachineDeploymentMaintenanceWindow(in *machine.MachineDeploymentMaintenanceWindow, out *MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	out.Windows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentMaintenanceWindow_To_v1beta1_MachineDeploymentMaintenanceWindow is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentMaintenanceWindow_To_v1beta1_MachineDeploymentMaintenanceWindow(in *machine.MachineDeploymentMaintenanceWindow, out *MachineDeploymentMaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentMaintenanceWindow_To_v1beta1_MachineDeploymentMaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in *MachineDeploymentScheduledScaling, out *machine.MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	out.Schedules = *(*[]machine.ScalingSchedule)(unsafe.Pointer(&in.Schedules))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in *MachineDeploymentScheduledScaling, out *machine.MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentScheduledScaling_To_machine_MachineDeploymentScheduledScaling(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentScheduledScaling_To_v1beta1_MachineDeploymentScheduledScaling(in *machine.MachineDeploymentScheduledScaling, out *MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	out.Schedules = *(*[]ScalingSchedule)(unsafe.Pointer(&in.Schedules))
+	out.TimeZone = (*string)(unsafe.Pointer(in.TimeZone))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentScheduledScaling_To_v1beta1_MachineDeploymentScheduledScaling is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentScheduledScaling_To_v1beta1_MachineDeploymentScheduledScaling(in *machine.MachineDeploymentScheduledScaling, out *MachineDeploymentScheduledScaling, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentScheduledScaling_To_v1beta1_MachineDeploymentScheduledScaling(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(in *MachineDeploymentSpec, out *machine.MachineDeploymentSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.MinReadySeconds = in.MinReadySeconds
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.RollbackTo = (*machine.RollbackConfig)(unsafe.Pointer(in.RollbackTo))
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.MaintenanceWindow = (*machine.MachineDeploymentMaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.AutoRollback = (*machine.AutoRollbackPolicy)(unsafe.Pointer(in.AutoRollback))
+	out.DeletePolicy = machine.MachineSetDeletePolicy(in.DeletePolicy)
+	out.ZoneSpread = (*machine.MachineDeploymentZoneSpread)(unsafe.Pointer(in.ZoneSpread))
+	out.ClassFallback = (*machine.ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*machine.MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.ScheduledScaling = (*machine.MachineDeploymentScheduledScaling)(unsafe.Pointer(in.ScheduledScaling))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(in *MachineDeploymentSpec, out *machine.MachineDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentSpec_To_machine_MachineDeploymentSpec(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec(in *machine.MachineDeploymentSpec, out *MachineDeploymentSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.MinReadySeconds = in.MinReadySeconds
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.RollbackTo = (*RollbackConfig)(unsafe.Pointer(in.RollbackTo))
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.MaintenanceWindow = (*MachineDeploymentMaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.AutoRollback = (*AutoRollbackPolicy)(unsafe.Pointer(in.AutoRollback))
+	out.DeletePolicy = MachineSetDeletePolicy(in.DeletePolicy)
+	out.ZoneSpread = (*MachineDeploymentZoneSpread)(unsafe.Pointer(in.ZoneSpread))
+	out.ClassFallback = (*ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.ScheduledScaling = (*MachineDeploymentScheduledScaling)(unsafe.Pointer(in.ScheduledScaling))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec(in *machine.MachineDeploymentSpec, out *MachineDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentSpec_To_v1beta1_MachineDeploymentSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus(in *MachineDeploymentStatus, out *machine.MachineDeploymentStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.UnavailableReplicas = in.UnavailableReplicas
+	out.Conditions = *(*[]machine.MachineDeploymentCondition)(unsafe.Pointer(&in.Conditions))
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.FailedMachines = *(*[]*machine.MachineSummary)(unsafe.Pointer(&in.FailedMachines))
+	out.Canary = (*machine.CanaryStatus)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*machine.BlueGreenStatus)(unsafe.Pointer(in.BlueGreen))
+	out.Zones = *(*[]machine.MachineDeploymentZoneStatus)(unsafe.Pointer(&in.Zones))
+	out.StandbyReplicas = in.StandbyReplicas
+	out.ScheduledScaling = *(*[]machine.ScalingScheduleStatus)(unsafe.Pointer(&in.ScheduledScaling))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus(in *MachineDeploymentStatus, out *machine.MachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentStatus_To_machine_MachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(in *machine.MachineDeploymentStatus, out *MachineDeploymentStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.UnavailableReplicas = in.UnavailableReplicas
+	out.Conditions = *(*[]MachineDeploymentCondition)(unsafe.Pointer(&in.Conditions))
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.FailedMachines = *(*[]*MachineSummary)(unsafe.Pointer(&in.FailedMachines))
+	out.Canary = (*CanaryStatus)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*BlueGreenStatus)(unsafe.Pointer(in.BlueGreen))
+	out.Zones = *(*[]MachineDeploymentZoneStatus)(unsafe.Pointer(&in.Zones))
+	out.StandbyReplicas = in.StandbyReplicas
+	out.ScheduledScaling = *(*[]ScalingScheduleStatus)(unsafe.Pointer(&in.ScheduledScaling))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(in *machine.MachineDeploymentStatus, out *MachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentStatus_To_v1beta1_MachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(in *MachineDeploymentStrategy, out *machine.MachineDeploymentStrategy, s conversion.Scope) error {
+	out.Type = machine.MachineDeploymentStrategyType(in.Type)
+	out.RollingUpdate = (*machine.RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*machine.CanaryMachineDeployment)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*machine.BlueGreenMachineDeployment)(unsafe.Pointer(in.BlueGreen))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(in *MachineDeploymentStrategy, out *machine.MachineDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentStrategy_To_machine_MachineDeploymentStrategy(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(in *machine.MachineDeploymentStrategy, out *MachineDeploymentStrategy, s conversion.Scope) error {
+	out.Type = MachineDeploymentStrategyType(in.Type)
+	out.RollingUpdate = (*RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*CanaryMachineDeployment)(unsafe.Pointer(in.Canary))
+	out.BlueGreen = (*BlueGreenMachineDeployment)(unsafe.Pointer(in.BlueGreen))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(in *machine.MachineDeploymentStrategy, out *MachineDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in *MachineDeploymentZone, out *machine.MachineDeploymentZone, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_ClassSpec_To_machine_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentZone_To_machine_MachineDeploymentZone is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in *MachineDeploymentZone, out *machine.MachineDeploymentZone, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentZone_To_machine_MachineDeploymentZone(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZone_To_v1beta1_MachineDeploymentZone(in *machine.MachineDeploymentZone, out *MachineDeploymentZone, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_machine_ClassSpec_To_v1beta1_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZone_To_v1beta1_MachineDeploymentZone is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZone_To_v1beta1_MachineDeploymentZone(in *machine.MachineDeploymentZone, out *MachineDeploymentZone, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZone_To_v1beta1_MachineDeploymentZone(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in *MachineDeploymentZoneSpread, out *machine.MachineDeploymentZoneSpread, s conversion.Scope) error {
+	out.Zones = *(*[]machine.MachineDeploymentZone)(unsafe.Pointer(&in.Zones))
+	out.Policy = machine.ZoneSpreadPolicy(in.Policy)
+	out.MaxSkew = (*int32)(unsafe.Pointer(in.MaxSkew))
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in *MachineDeploymentZoneSpread, out *machine.MachineDeploymentZoneSpread, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentZoneSpread_To_machine_MachineDeploymentZoneSpread(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZoneSpread_To_v1beta1_MachineDeploymentZoneSpread(in *machine.MachineDeploymentZoneSpread, out *MachineDeploymentZoneSpread, s conversion.Scope) error {
+	out.Zones = *(*[]MachineDeploymentZone)(unsafe.Pointer(&in.Zones))
+	out.Policy = ZoneSpreadPolicy(in.Policy)
+	out.MaxSkew = (*int32)(unsafe.Pointer(in.MaxSkew))
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZoneSpread_To_v1beta1_MachineDeploymentZoneSpread is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZoneSpread_To_v1beta1_MachineDeploymentZoneSpread(in *machine.MachineDeploymentZoneSpread, out *MachineDeploymentZoneSpread, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZoneSpread_To_v1beta1_MachineDeploymentZoneSpread(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in *MachineDeploymentZoneStatus, out *machine.MachineDeploymentZoneStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineDeployment = in.MachineDeployment
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	return nil
+}
+
+// Convert_v1beta1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in *MachineDeploymentZoneStatus, out *machine.MachineDeploymentZoneStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineDeploymentZoneStatus_To_machine_MachineDeploymentZoneStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineDeploymentZoneStatus_To_v1beta1_MachineDeploymentZoneStatus(in *machine.MachineDeploymentZoneStatus, out *MachineDeploymentZoneStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachineDeployment = in.MachineDeployment
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	return nil
+}
+
+// Convert_machine_MachineDeploymentZoneStatus_To_v1beta1_MachineDeploymentZoneStatus is an autogenerated conversion function.
+func Convert_machine_MachineDeploymentZoneStatus_To_v1beta1_MachineDeploymentZoneStatus(in *machine.MachineDeploymentZoneStatus, out *MachineDeploymentZoneStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineDeploymentZoneStatus_To_v1beta1_MachineDeploymentZoneStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineList_To_machine_MachineList(in *MachineList, out *machine.MachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]machine.Machine, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Machine_To_machine_Machine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineList_To_machine_MachineList is an autogenerated conversion function.
+func Convert_v1beta1_MachineList_To_machine_MachineList(in *MachineList, out *machine.MachineList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineList_To_machine_MachineList(in, out, s)
+}
+
+func autoConvert_machine_MachineList_To_v1beta1_MachineList(in *machine.MachineList, out *MachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Machine, len(*in))
+		for i := range *in {
+			if err := Convert_machine_Machine_To_v1beta1_Machine(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_machine_MachineList_To_v1beta1_MachineList is an autogenerated conversion function.
+func Convert_machine_MachineList_To_v1beta1_MachineList(in *machine.MachineList, out *MachineList, s conversion.Scope) error {
+	return autoConvert_machine_MachineList_To_v1beta1_MachineList(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSet_To_machine_MachineSet(in *MachineSet, out *machine.MachineSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineSet_To_machine_MachineSet is an autogenerated conversion function.
+func Convert_v1beta1_MachineSet_To_machine_MachineSet(in *MachineSet, out *machine.MachineSet, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSet_To_machine_MachineSet(in, out, s)
+}
+
+func autoConvert_machine_MachineSet_To_v1beta1_MachineSet(in *machine.MachineSet, out *MachineSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_machine_MachineSet_To_v1beta1_MachineSet is an autogenerated conversion function.
+func Convert_machine_MachineSet_To_v1beta1_MachineSet(in *machine.MachineSet, out *MachineSet, s conversion.Scope) error {
+	return autoConvert_machine_MachineSet_To_v1beta1_MachineSet(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSetCondition_To_machine_MachineSetCondition(in *MachineSetCondition, out *machine.MachineSetCondition, s conversion.Scope) error {
+	out.Type = machine.MachineSetConditionType(in.Type)
+	out.Status = machine.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_MachineSetCondition_To_machine_MachineSetCondition is an autogenerated conversion function.
+func Convert_v1beta1_MachineSetCondition_To_machine_MachineSetCondition(in *MachineSetCondition, out *machine.MachineSetCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSetCondition_To_machine_MachineSetCondition(in, out, s)
+}
+
+func autoConvert_machine_MachineSetCondition_To_v1beta1_MachineSetCondition(in *machine.MachineSetCondition, out *MachineSetCondition, s conversion.Scope) error {
+	out.Type = MachineSetConditionType(in.Type)
+	out.Status = ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_machine_MachineSetCondition_To_v1beta1_MachineSetCondition is an autogenerated conversion function.
+func Convert_machine_MachineSetCondition_To_v1beta1_MachineSetCondition(in *machine.MachineSetCondition, out *MachineSetCondition, s conversion.Scope) error {
+	return autoConvert_machine_MachineSetCondition_To_v1beta1_MachineSetCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSetList_To_machine_MachineSetList(in *MachineSetList, out *machine.MachineSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]machine.MachineSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MachineSetList_To_machine_MachineSetList is an autogenerated conversion function.
+func Convert_v1beta1_MachineSetList_To_machine_MachineSetList(in *MachineSetList, out *machine.MachineSetList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSetList_To_machine_MachineSetList(in, out, s)
+}
+
+func autoConvert_machine_MachineSetList_To_v1beta1_MachineSetList(in *machine.MachineSetList, out *MachineSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MachineSet)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_machine_MachineSetList_To_v1beta1_MachineSetList is an autogenerated conversion function.
+func Convert_machine_MachineSetList_To_v1beta1_MachineSetList(in *machine.MachineSetList, out *MachineSetList, s conversion.Scope) error {
+	return autoConvert_machine_MachineSetList_To_v1beta1_MachineSetList(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec(in *MachineSetSpec, out *machine.MachineSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_v1beta1_ClassSpec_To_machine_ClassSpec(&in.MachineClass, &out.MachineClass, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.MinReadySeconds = in.MinReadySeconds
+	out.DeletePolicy = machine.MachineSetDeletePolicy(in.DeletePolicy)
+	out.ClassFallback = (*machine.ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*machine.MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec is an autogenerated conversion function.
+func Convert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec(in *MachineSetSpec, out *machine.MachineSetSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSetSpec_To_machine_MachineSetSpec(in, out, s)
+}
+
+func autoConvert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec(in *machine.MachineSetSpec, out *MachineSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_machine_ClassSpec_To_v1beta1_ClassSpec(&in.MachineClass, &out.MachineClass, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	out.MinReadySeconds = in.MinReadySeconds
+	out.DeletePolicy = MachineSetDeletePolicy(in.DeletePolicy)
+	out.ClassFallback = (*ClassFallback)(unsafe.Pointer(in.ClassFallback))
+	out.WarmPool = (*MachineWarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec is an autogenerated conversion function.
+func Convert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec(in *machine.MachineSetSpec, out *MachineSetSpec, s conversion.Scope) error {
+	return autoConvert_machine_MachineSetSpec_To_v1beta1_MachineSetSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus(in *MachineSetStatus, out *machine.MachineSetStatus, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.FullyLabeledReplicas = in.FullyLabeledReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]machine.MachineSetCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1beta1_LastOperation_To_machine_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	out.FailedMachines = (*[]machine.MachineSummary)(unsafe.Pointer(in.FailedMachines))
+	out.ClassFallback = (*machine.ClassFallbackStatus)(unsafe.Pointer(in.ClassFallback))
+	out.StandbyReplicas = in.StandbyReplicas
+	return nil
+}
+
+// Convert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus(in *MachineSetStatus, out *machine.MachineSetStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSetStatus_To_machine_MachineSetStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus(in *machine.MachineSetStatus, out *MachineSetStatus, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.FullyLabeledReplicas = in.FullyLabeledReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]MachineSetCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_machine_LastOperation_To_v1beta1_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	out.FailedMachines = (*[]MachineSummary)(unsafe.Pointer(in.FailedMachines))
+	out.ClassFallback = (*ClassFallbackStatus)(unsafe.Pointer(in.ClassFallback))
+	out.StandbyReplicas = in.StandbyReplicas
+	return nil
+}
+
+// Convert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus is an autogenerated conversion function.
+func Convert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus(in *machine.MachineSetStatus, out *MachineSetStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineSetStatus_To_v1beta1_MachineSetStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSpec_To_machine_MachineSpec(in *MachineSpec, out *machine.MachineSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_ClassSpec_To_machine_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.ProviderID = in.ProviderID
+	if err := Convert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec(&in.NodeTemplateSpec, &out.NodeTemplateSpec, s); err != nil {
+		return err
+	}
+	out.MachineConfiguration = (*machine.MachineConfiguration)(unsafe.Pointer(in.MachineConfiguration))
+	out.DeletionPriority = (*int32)(unsafe.Pointer(in.DeletionPriority))
+	return nil
+}
+
+// Convert_v1beta1_MachineSpec_To_machine_MachineSpec is an autogenerated conversion function.
+func Convert_v1beta1_MachineSpec_To_machine_MachineSpec(in *MachineSpec, out *machine.MachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSpec_To_machine_MachineSpec(in, out, s)
+}
+
+func autoConvert_machine_MachineSpec_To_v1beta1_MachineSpec(in *machine.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	if err := Convert_machine_ClassSpec_To_v1beta1_ClassSpec(&in.Class, &out.Class, s); err != nil {
+		return err
+	}
+	out.ProviderID = in.ProviderID
+	if err := Convert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec(&in.NodeTemplateSpec, &out.NodeTemplateSpec, s); err != nil {
+		return err
+	}
+	out.MachineConfiguration = (*MachineConfiguration)(unsafe.Pointer(in.MachineConfiguration))
+	out.DeletionPriority = (*int32)(unsafe.Pointer(in.DeletionPriority))
+	return nil
+}
+
+// Convert_machine_MachineSpec_To_v1beta1_MachineSpec is an autogenerated conversion function.
+func Convert_machine_MachineSpec_To_v1beta1_MachineSpec(in *machine.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	return autoConvert_machine_MachineSpec_To_v1beta1_MachineSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineStatus_To_machine_MachineStatus(in *MachineStatus, out *machine.MachineStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]corev1.NodeCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1beta1_LastOperation_To_machine_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_CurrentStatus_To_machine_CurrentStatus(&in.CurrentStatus, &out.CurrentStatus, s); err != nil {
+		return err
+	}
+	out.LastKnownState = in.LastKnownState
+	return nil
+}
+
+// Convert_v1beta1_MachineStatus_To_machine_MachineStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineStatus_To_machine_MachineStatus(in *MachineStatus, out *machine.MachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineStatus_To_machine_MachineStatus(in, out, s)
+}
+
+func autoConvert_machine_MachineStatus_To_v1beta1_MachineStatus(in *machine.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]corev1.NodeCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_machine_LastOperation_To_v1beta1_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	if err := Convert_machine_CurrentStatus_To_v1beta1_CurrentStatus(&in.CurrentStatus, &out.CurrentStatus, s); err != nil {
+		return err
+	}
+	out.LastKnownState = in.LastKnownState
+	return nil
+}
+
+// Convert_machine_MachineStatus_To_v1beta1_MachineStatus is an autogenerated conversion function.
+func Convert_machine_MachineStatus_To_v1beta1_MachineStatus(in *machine.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	return autoConvert_machine_MachineStatus_To_v1beta1_MachineStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineSummary_To_machine_MachineSummary(in *MachineSummary, out *machine.MachineSummary, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderID = in.ProviderID
+	if err := Convert_v1beta1_LastOperation_To_machine_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	out.OwnerRef = in.OwnerRef
+	return nil
+}
+
+// Convert_v1beta1_MachineSummary_To_machine_MachineSummary is an autogenerated conversion function.
+func Convert_v1beta1_MachineSummary_To_machine_MachineSummary(in *MachineSummary, out *machine.MachineSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineSummary_To_machine_MachineSummary(in, out, s)
+}
+
+func autoConvert_machine_MachineSummary_To_v1beta1_MachineSummary(in *machine.MachineSummary, out *MachineSummary, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderID = in.ProviderID
+	if err := Convert_machine_LastOperation_To_v1beta1_LastOperation(&in.LastOperation, &out.LastOperation, s); err != nil {
+		return err
+	}
+	out.OwnerRef = in.OwnerRef
+	return nil
+}
+
+// Convert_machine_MachineSummary_To_v1beta1_MachineSummary is an autogenerated conversion function.
+func Convert_machine_MachineSummary_To_v1beta1_MachineSummary(in *machine.MachineSummary, out *MachineSummary, s conversion.Scope) error {
+	return autoConvert_machine_MachineSummary_To_v1beta1_MachineSummary(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(in *MachineTemplateSpec, out *machine.MachineTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MachineSpec_To_machine_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(in *MachineTemplateSpec, out *machine.MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineTemplateSpec_To_machine_MachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(in *machine.MachineTemplateSpec, out *MachineTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_machine_MachineSpec_To_v1beta1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(in *machine.MachineTemplateSpec, out *MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_machine_MachineTemplateSpec_To_v1beta1_MachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineWarmPool_To_machine_MachineWarmPool(in *MachineWarmPool, out *machine.MachineWarmPool, s conversion.Scope) error {
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1beta1_MachineWarmPool_To_machine_MachineWarmPool is an autogenerated conversion function.
+func Convert_v1beta1_MachineWarmPool_To_machine_MachineWarmPool(in *MachineWarmPool, out *machine.MachineWarmPool, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineWarmPool_To_machine_MachineWarmPool(in, out, s)
+}
+
+func autoConvert_machine_MachineWarmPool_To_v1beta1_MachineWarmPool(in *machine.MachineWarmPool, out *MachineWarmPool, s conversion.Scope) error {
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_machine_MachineWarmPool_To_v1beta1_MachineWarmPool is an autogenerated conversion function.
+func Convert_machine_MachineWarmPool_To_v1beta1_MachineWarmPool(in *machine.MachineWarmPool, out *MachineWarmPool, s conversion.Scope) error {
+	return autoConvert_machine_MachineWarmPool_To_v1beta1_MachineWarmPool(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceWindow_To_machine_MaintenanceWindow(in *MaintenanceWindow, out *machine.MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceWindow_To_machine_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceWindow_To_machine_MaintenanceWindow(in *MaintenanceWindow, out *machine.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceWindow_To_machine_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_machine_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *machine.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	return nil
+}
+
+// Convert_machine_MaintenanceWindow_To_v1beta1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_machine_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in *machine.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_machine_MaintenanceWindow_To_v1beta1_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeTemplate_To_machine_NodeTemplate(in *NodeTemplate, out *machine.NodeTemplate, s conversion.Scope) error {
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.InstanceType = in.InstanceType
+	out.Region = in.Region
+	out.Zone = in.Zone
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1beta1_NodeTemplate_To_machine_NodeTemplate is an autogenerated conversion function.
+func Convert_v1beta1_NodeTemplate_To_machine_NodeTemplate(in *NodeTemplate, out *machine.NodeTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeTemplate_To_machine_NodeTemplate(in, out, s)
+}
+
+func autoConvert_machine_NodeTemplate_To_v1beta1_NodeTemplate(in *machine.NodeTemplate, out *NodeTemplate, s conversion.Scope) error {
+	out.Capacity = *(*corev1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.InstanceType = in.InstanceType
+	out.Region = in.Region
+	out.Zone = in.Zone
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_machine_NodeTemplate_To_v1beta1_NodeTemplate is an autogenerated conversion function.
+func Convert_machine_NodeTemplate_To_v1beta1_NodeTemplate(in *machine.NodeTemplate, out *NodeTemplate, s conversion.Scope) error {
+	return autoConvert_machine_NodeTemplate_To_v1beta1_NodeTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec(in *NodeTemplateSpec, out *machine.NodeTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec(in *NodeTemplateSpec, out *machine.NodeTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeTemplateSpec_To_machine_NodeTemplateSpec(in, out, s)
+}
+
+func autoConvert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec(in *machine.NodeTemplateSpec, out *NodeTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec is an autogenerated conversion function.
+func Convert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec(in *machine.NodeTemplateSpec, out *NodeTemplateSpec, s conversion.Scope) error {
+	return autoConvert_machine_NodeTemplateSpec_To_v1beta1_NodeTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_OrphanVM_To_machine_OrphanVM(in *OrphanVM, out *machine.OrphanVM, s conversion.Scope) error {
+	out.ProviderID = in.ProviderID
+	out.MachineName = in.MachineName
+	return nil
+}
+
+// Convert_v1beta1_OrphanVM_To_machine_OrphanVM is an autogenerated conversion function.
+func Convert_v1beta1_OrphanVM_To_machine_OrphanVM(in *OrphanVM, out *machine.OrphanVM, s conversion.Scope) error {
+	return autoConvert_v1beta1_OrphanVM_To_machine_OrphanVM(in, out, s)
+}
+
+func autoConvert_machine_OrphanVM_To_v1beta1_OrphanVM(in *machine.OrphanVM, out *OrphanVM, s conversion.Scope) error {
+	out.ProviderID = in.ProviderID
+	out.MachineName = in.MachineName
+	return nil
+}
+
+// Convert_machine_OrphanVM_To_v1beta1_OrphanVM is an autogenerated conversion function.
+func Convert_machine_OrphanVM_To_v1beta1_OrphanVM(in *machine.OrphanVM, out *OrphanVM, s conversion.Scope) error {
+	return autoConvert_machine_OrphanVM_To_v1beta1_OrphanVM(in, out, s)
+}
+
+func autoConvert_v1beta1_RollbackConfig_To_machine_RollbackConfig(in *RollbackConfig, out *machine.RollbackConfig, s conversion.Scope) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_v1beta1_RollbackConfig_To_machine_RollbackConfig is an autogenerated conversion function.
+func Convert_v1beta1_RollbackConfig_To_machine_RollbackConfig(in *RollbackConfig, out *machine.RollbackConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_RollbackConfig_To_machine_RollbackConfig(in, out, s)
+}
+
+func autoConvert_machine_RollbackConfig_To_v1beta1_RollbackConfig(in *machine.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_machine_RollbackConfig_To_v1beta1_RollbackConfig is an autogenerated conversion function.
+func Convert_machine_RollbackConfig_To_v1beta1_RollbackConfig(in *machine.RollbackConfig, out *RollbackConfig, s conversion.Scope) error {
+	return autoConvert_machine_RollbackConfig_To_v1beta1_RollbackConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_RollingUpdateMachineDeployment_To_machine_RollingUpdateMachineDeployment(in *RollingUpdateMachineDeployment, out *machine.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1beta1_RollingUpdateMachineDeployment_To_machine_RollingUpdateMachineDeployment is an autogenerated conversion function.
+func Convert_v1beta1_RollingUpdateMachineDeployment_To_machine_RollingUpdateMachineDeployment(in *RollingUpdateMachineDeployment, out *machine.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1beta1_RollingUpdateMachineDeployment_To_machine_RollingUpdateMachineDeployment(in, out, s)
+}
+
+func autoConvert_machine_RollingUpdateMachineDeployment_To_v1beta1_RollingUpdateMachineDeployment(in *machine.RollingUpdateMachineDeployment, out *RollingUpdateMachineDeployment, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_machine_RollingUpdateMachineDeployment_To_v1beta1_RollingUpdateMachineDeployment is an autogenerated conversion function.
+func Convert_machine_RollingUpdateMachineDeployment_To_v1beta1_RollingUpdateMachineDeployment(in *machine.RollingUpdateMachineDeployment, out *RollingUpdateMachineDeployment, s conversion.Scope) error {
+	return autoConvert_machine_RollingUpdateMachineDeployment_To_v1beta1_RollingUpdateMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1beta1_ScalingSchedule_To_machine_ScalingSchedule(in *ScalingSchedule, out *machine.ScalingSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_v1beta1_ScalingSchedule_To_machine_ScalingSchedule is an autogenerated conversion function.
+func Convert_v1beta1_ScalingSchedule_To_machine_ScalingSchedule(in *ScalingSchedule, out *machine.ScalingSchedule, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScalingSchedule_To_machine_ScalingSchedule(in, out, s)
+}
+
+func autoConvert_machine_ScalingSchedule_To_v1beta1_ScalingSchedule(in *machine.ScalingSchedule, out *ScalingSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_machine_ScalingSchedule_To_v1beta1_ScalingSchedule is an autogenerated conversion function.
+func Convert_machine_ScalingSchedule_To_v1beta1_ScalingSchedule(in *machine.ScalingSchedule, out *ScalingSchedule, s conversion.Scope) error {
+	return autoConvert_machine_ScalingSchedule_To_v1beta1_ScalingSchedule(in, out, s)
+}
+
+func autoConvert_v1beta1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in *ScalingScheduleStatus, out *machine.ScalingScheduleStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastScheduleTime = (*v1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.NextScheduleTime = (*v1.Time)(unsafe.Pointer(in.NextScheduleTime))
+	return nil
+}
+
+// Convert_v1beta1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus is an autogenerated conversion function.
+func Convert_v1beta1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in *ScalingScheduleStatus, out *machine.ScalingScheduleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScalingScheduleStatus_To_machine_ScalingScheduleStatus(in, out, s)
+}
+
+func autoConvert_machine_ScalingScheduleStatus_To_v1beta1_ScalingScheduleStatus(in *machine.ScalingScheduleStatus, out *ScalingScheduleStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastScheduleTime = (*v1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.NextScheduleTime = (*v1.Time)(unsafe.Pointer(in.NextScheduleTime))
+	return nil
+}
+
+// Convert_machine_ScalingScheduleStatus_To_v1beta1_ScalingScheduleStatus is an autogenerated conversion function.
+func Convert_machine_ScalingScheduleStatus_To_v1beta1_ScalingScheduleStatus(in *machine.ScalingScheduleStatus, out *ScalingScheduleStatus, s conversion.Scope) error {
+	return autoConvert_machine_ScalingScheduleStatus_To_v1beta1_ScalingScheduleStatus(in, out, s)
+}
@@ -24,6 +24,15 @@ func internalValidateMachine(machine *machine.Machine) field.ErrorList {
 func validateMachineSpec(spec *machine.MachineSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateClassReference(&spec.Class, field.NewPath("spec.class"))...)
+	allErrs = append(allErrs, validateDeletionPriority(spec.DeletionPriority, field.NewPath("spec.deletionPriority"))...)
+	return allErrs
+}
+
+func validateDeletionPriority(deletionPriority *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if deletionPriority != nil && *deletionPriority < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *deletionPriority, "DeletionPriority has to be positive"))
+	}
 	return allErrs
 }
 
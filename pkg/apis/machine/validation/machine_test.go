@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Machine Validation", func() {
+	Describe("#ValidateMachine", func() {
+		DescribeTable("##validation scenarios",
+			func(deletionPriority *int32, expectedFields []string) {
+				errs := ValidateMachine(&machine.Machine{
+					Spec: machine.MachineSpec{
+						Class:            machine.ClassSpec{Kind: "MachineClass", Name: "class"},
+						DeletionPriority: deletionPriority,
+					},
+				})
+				matchers := make([]interface{}, 0, len(expectedFields))
+				for _, f := range expectedFields {
+					matchers = append(matchers, HaveField("Field", f))
+				}
+				Expect(errs).To(ConsistOf(matchers...))
+			},
+			Entry("no deletion priority", nil, nil),
+			Entry("positive deletion priority", ptr.To[int32](1), nil),
+			Entry("zero deletion priority", ptr.To[int32](0), []string{"spec.deletionPriority"}),
+			Entry("negative deletion priority", ptr.To[int32](-3), []string{"spec.deletionPriority"}),
+		)
+	})
+})
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation is used to validate all the machine CRD objects
+package validation
+
+import (
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachineClass and returns a list of errors.
+func ValidateMachineClass(machineClass *machine.MachineClass) field.ErrorList {
+	return internalValidateMachineClass(machineClass)
+}
+
+func internalValidateMachineClass(machineClass *machine.MachineClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateNodeTemplate(machineClass.NodeTemplate, field.NewPath("nodeTemplate"))...)
+	allErrs = append(allErrs, validateSecretReference(machineClass.SecretRef, field.NewPath("secretRef"))...)
+	allErrs = append(allErrs, validateSecretReference(machineClass.CredentialsSecretRef, field.NewPath("credentialsSecretRef"))...)
+	return allErrs
+}
+
+// ValidateNodeTemplate validates the optional node template of a machine class. It is used by the admission webhook
+// and by the machine controller before reconciling a machine, so that both accept the same machine classes.
+func ValidateNodeTemplate(nodeTemplate *machine.NodeTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if nodeTemplate == nil {
+		return allErrs
+	}
+
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, "gpu", corev1.ResourceMemory} {
+		if _, ok := nodeTemplate.Capacity[resourceName]; !ok {
+			allErrs = append(allErrs, field.Required(fldPath.Child("capacity").Key(string(resourceName)), "Capacity of "+string(resourceName)+" is required"))
+		}
+	}
+	for resourceName, quantity := range nodeTemplate.Capacity {
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("capacity").Key(string(resourceName)), quantity.String(), "Capacity has to be non-negative"))
+		}
+	}
+	if nodeTemplate.InstanceType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("instanceType"), "InstanceType is required"))
+	}
+	if nodeTemplate.Region == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "Region is required"))
+	}
+	if nodeTemplate.Zone == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("zone"), "Zone is required"))
+	}
+	if nodeTemplate.Architecture != nil && *nodeTemplate.Architecture == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("architecture"), "", "Architecture must not be empty if set"))
+	}
+	return allErrs
+}
+
+func validateSecretReference(secretRef *corev1.SecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if secretRef == nil {
+		return allErrs
+	}
+	if secretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "Name is required"))
+	}
+	return allErrs
+}
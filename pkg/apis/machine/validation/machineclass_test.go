@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineClass Validation", func() {
+	Describe("#ValidateMachineClass", func() {
+		newNodeTemplate := func() *machine.NodeTemplate {
+			return &machine.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					"gpu":                 resource.MustParse("0"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+				InstanceType: "m5.xlarge",
+				Region:       "eu-west-1",
+				Zone:         "eu-west-1a",
+			}
+		}
+
+		DescribeTable("##validation scenarios",
+			func(mutate func(*machine.MachineClass), expectedFields []string) {
+				machineClass := &machine.MachineClass{
+					NodeTemplate: newNodeTemplate(),
+					SecretRef:    &corev1.SecretReference{Name: "secret", Namespace: "default"},
+				}
+				mutate(machineClass)
+				errs := ValidateMachineClass(machineClass)
+				matchers := make([]interface{}, 0, len(expectedFields))
+				for _, f := range expectedFields {
+					matchers = append(matchers, HaveField("Field", f))
+				}
+				Expect(errs).To(ConsistOf(matchers...))
+			},
+			Entry("valid machine class", func(*machine.MachineClass) {}, nil),
+			Entry("no node template", func(mc *machine.MachineClass) { mc.NodeTemplate = nil }, nil),
+			Entry("missing node template fields", func(mc *machine.MachineClass) { mc.NodeTemplate = &machine.NodeTemplate{} }, []string{
+				"nodeTemplate.capacity[cpu]",
+				"nodeTemplate.capacity[gpu]",
+				"nodeTemplate.capacity[memory]",
+				"nodeTemplate.instanceType",
+				"nodeTemplate.region",
+				"nodeTemplate.zone",
+			}),
+			Entry("negative capacity", func(mc *machine.MachineClass) {
+				mc.NodeTemplate.Capacity["nvidia.com/gpu"] = resource.MustParse("-1")
+			}, []string{"nodeTemplate.capacity[nvidia.com/gpu]"}),
+			Entry("empty architecture", func(mc *machine.MachineClass) { mc.NodeTemplate.Architecture = ptr.To("") }, []string{"nodeTemplate.architecture"}),
+			Entry("secret references without name", func(mc *machine.MachineClass) {
+				mc.SecretRef.Name = ""
+				mc.CredentialsSecretRef = &corev1.SecretReference{Namespace: "default"}
+			}, []string{"secretRef.name", "credentialsSecretRef.name"}),
+		)
+	})
+})
@@ -6,10 +6,15 @@
 package validation
 
 import (
+	"math"
+	"time"
+
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	"github.com/gardener/machine-controller-manager/pkg/util/cron"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"math"
 )
 
 // ValidateMachineDeployment and returns a list of errors.
@@ -20,6 +25,23 @@ func ValidateMachineDeployment(machineDeployment *machine.MachineDeployment) fie
 func internalValidateMachineDeployment(machineDeployment *machine.MachineDeployment) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateMachineDeploymentSpec(&machineDeployment.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateZoneMachineDeploymentNames(machineDeployment, field.NewPath("spec", "zoneSpread", "zones"))...)
+	return allErrs
+}
+
+// validateZoneMachineDeploymentNames validates the names of the MachineDeployments managing the zones of the
+// deployment, which are the name of the deployment and the name of the zone joined by a dash.
+func validateZoneMachineDeploymentNames(machineDeployment *machine.MachineDeployment, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if machineDeployment.Spec.ZoneSpread == nil || machineDeployment.Name == "" {
+		return allErrs
+	}
+	for i, zone := range machineDeployment.Spec.ZoneSpread.Zones {
+		name := machineDeployment.Name + "-" + zone.Name
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), zone.Name, "MachineDeployment name "+name+" of the zone is invalid: "+msg))
+		}
+	}
 	return allErrs
 }
 
@@ -36,8 +58,14 @@ func canConvertIntOrStringToInt32(val *intstr.IntOrString, replicas int) bool {
 
 func validateUpdateStrategy(spec *machine.MachineDeploymentSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	if spec.Strategy.Type != machine.RollingUpdateMachineDeploymentStrategyType && spec.Strategy.Type != machine.RecreateMachineDeploymentStrategyType {
-		allErrs = append(allErrs, field.Required(fldPath.Child("strategy.type"), "Type can either be RollingUpdate or Recreate"))
+	if spec.Strategy.Type != machine.RollingUpdateMachineDeploymentStrategyType && spec.Strategy.Type != machine.RecreateMachineDeploymentStrategyType && spec.Strategy.Type != machine.CanaryMachineDeploymentStrategyType && spec.Strategy.Type != machine.BlueGreenMachineDeploymentStrategyType {
+		allErrs = append(allErrs, field.Required(fldPath.Child("strategy.type"), "Type can either be RollingUpdate, Recreate, Canary or BlueGreen"))
+	}
+	if spec.Strategy.Type == machine.CanaryMachineDeploymentStrategyType {
+		allErrs = append(allErrs, validateCanaryStrategy(spec.Strategy.Canary, int(spec.Replicas), fldPath.Child("strategy.canary"))...)
+	}
+	if spec.Strategy.Type == machine.BlueGreenMachineDeploymentStrategyType {
+		allErrs = append(allErrs, validateBlueGreenStrategy(spec.Strategy.BlueGreen, fldPath.Child("strategy.blueGreen"))...)
 	}
 	if spec.Strategy.Type == machine.RollingUpdateMachineDeploymentStrategyType {
 		if spec.Strategy.RollingUpdate == nil {
@@ -54,18 +82,226 @@ func validateUpdateStrategy(spec *machine.MachineDeploymentSpec, fldPath *field.
 	return allErrs
 }
 
+func validateCanaryStrategy(canary *machine.CanaryMachineDeployment, replicas int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if canary == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "Canary parameter cannot be nil for canary strategy"))
+		return allErrs
+	}
+	if canary.MaxUnavailable != nil && !canConvertIntOrStringToInt32(canary.MaxUnavailable, replicas) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), canary.MaxUnavailable.String(), "unable to convert maxUnavailable to int32"))
+	}
+	if canary.MaxSurge != nil && !canConvertIntOrStringToInt32(canary.MaxSurge, replicas) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), canary.MaxSurge.String(), "unable to convert maxSurge to int32"))
+	}
+	for i, step := range canary.Steps {
+		stepPath := fldPath.Child("steps").Index(i)
+		if (step.Replicas == nil) == (step.Pause == nil) {
+			allErrs = append(allErrs, field.Invalid(stepPath, step, "Exactly one of replicas or pause has to be set"))
+			continue
+		}
+		if step.Replicas != nil && !canConvertIntOrStringToInt32(step.Replicas, replicas) {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("replicas"), step.Replicas.String(), "unable to convert replicas to int32"))
+		}
+		if step.Pause != nil && step.Pause.Duration != nil && step.Pause.Duration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("pause.duration"), step.Pause.Duration.String(), "Duration cannot be negative"))
+		}
+	}
+	return allErrs
+}
+
+func validateBlueGreenStrategy(blueGreen *machine.BlueGreenMachineDeployment, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if blueGreen == nil {
+		return allErrs
+	}
+	hooks := sets.New[string]()
+	for i, hook := range blueGreen.Hooks {
+		for _, msg := range utilvalidation.IsDNS1123Label(hook) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hooks").Index(i), hook, msg))
+		}
+		if hooks.Has(hook) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("hooks").Index(i), hook))
+		}
+		hooks.Insert(hook)
+	}
+	if blueGreen.ConfirmationWindow != nil && blueGreen.ConfirmationWindow.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("confirmationWindow"), blueGreen.ConfirmationWindow.String(), "Duration cannot be negative"))
+	}
+	return allErrs
+}
+
 func validateMachineDeploymentSpec(spec *machine.MachineDeploymentSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if spec.Replicas < 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("replicas"), "Replicas has to be a whole number"))
 	}
 	allErrs = append(allErrs, validateUpdateStrategy(spec, fldPath)...)
-	for k, v := range spec.Selector.MatchLabels {
-		if spec.Template.Labels[k] != v {
-			allErrs = append(allErrs, field.Required(fldPath.Child("selector.matchLabels"), "is not matching with spec.template.metadata.labels"))
-			break
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "Selector is required"))
+	} else {
+		for k, v := range spec.Selector.MatchLabels {
+			if spec.Template.Labels[k] != v {
+				allErrs = append(allErrs, field.Required(fldPath.Child("selector.matchLabels"), "is not matching with spec.template.metadata.labels"))
+				break
+			}
+		}
+	}
+	if spec.ZoneSpread == nil {
+		allErrs = append(allErrs, validateClassReference(&spec.Template.Spec.Class, field.NewPath("spec.template.spec.class"))...)
+	}
+	allErrs = append(allErrs, validateDeletionPriority(spec.Template.Spec.DeletionPriority, field.NewPath("spec.template.spec.deletionPriority"))...)
+	allErrs = append(allErrs, validateMaintenanceWindow(spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
+	allErrs = append(allErrs, validateAutoRollback(spec, fldPath.Child("autoRollback"))...)
+	allErrs = append(allErrs, validateDeletePolicy(spec.DeletePolicy, fldPath.Child("deletePolicy"))...)
+	allErrs = append(allErrs, validateZoneSpread(spec, fldPath.Child("zoneSpread"))...)
+	if spec.ClassFallback != nil && spec.ZoneSpread != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("classFallback"), "ClassFallback cannot be combined with spec.zoneSpread"))
+	} else {
+		allErrs = append(allErrs, validateClassFallback(spec.ClassFallback, &spec.Template.Spec.Class, fldPath.Child("classFallback"))...)
+	}
+	if spec.WarmPool != nil && spec.ZoneSpread != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("warmPool"), "WarmPool cannot be combined with spec.zoneSpread"))
+	} else {
+		allErrs = append(allErrs, validateWarmPool(spec.WarmPool, fldPath.Child("warmPool"))...)
+	}
+	allErrs = append(allErrs, validateScheduledScaling(spec.ScheduledScaling, fldPath.Child("scheduledScaling"))...)
+	return allErrs
+}
+
+func validateZoneSpread(spec *machine.MachineDeploymentSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	zoneSpread := spec.ZoneSpread
+	if zoneSpread == nil {
+		return allErrs
+	}
+	if len(zoneSpread.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("zones"), "At least one zone has to be specified"))
+	}
+	zoneNames := sets.New[string]()
+	var totalWeight int32
+	for i, zone := range zoneSpread.Zones {
+		zonePath := fldPath.Child("zones").Index(i)
+		for _, msg := range utilvalidation.IsDNS1123Label(zone.Name) {
+			allErrs = append(allErrs, field.Invalid(zonePath.Child("name"), zone.Name, msg))
+		}
+		if zoneNames.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(zonePath.Child("name"), zone.Name))
+		}
+		zoneNames.Insert(zone.Name)
+		allErrs = append(allErrs, validateClassReference(&zone.Class, zonePath.Child("class"))...)
+		if zone.Weight == nil {
+			totalWeight++
+		} else if *zone.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(zonePath.Child("weight"), *zone.Weight, "Weight has to be a whole number"))
+		} else {
+			totalWeight += *zone.Weight
+		}
+	}
+	switch zoneSpread.Policy {
+	case "", machine.EvenZoneSpreadPolicy:
+	case machine.MaxSkewZoneSpreadPolicy:
+		if zoneSpread.MaxSkew == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("maxSkew"), "MaxSkew is required for the MaxSkew policy"))
+		}
+	case machine.WeightedZoneSpreadPolicy:
+		if len(zoneSpread.Zones) > 0 && totalWeight == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zones"), totalWeight, "At least one zone has to have a positive weight"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), zoneSpread.Policy, []string{
+			string(machine.EvenZoneSpreadPolicy),
+			string(machine.WeightedZoneSpreadPolicy),
+			string(machine.MaxSkewZoneSpreadPolicy),
+		}))
+	}
+	if zoneSpread.MaxSkew != nil && *zoneSpread.MaxSkew < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSkew"), *zoneSpread.MaxSkew, "MaxSkew has to be positive"))
+	}
+	if spec.AutoRollback != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "ZoneSpread cannot be combined with spec.autoRollback"))
+	}
+	return allErrs
+}
+
+func validateAutoRollback(spec *machine.MachineDeploymentSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.AutoRollback == nil {
+		return allErrs
+	}
+	if spec.AutoRollback.MaxFailedMachines == nil {
+		if spec.ProgressDeadlineSeconds == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("maxFailedMachines"), "MaxFailedMachines or spec.progressDeadlineSeconds has to be specified"))
+		}
+	} else if *spec.AutoRollback.MaxFailedMachines < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxFailedMachines"), *spec.AutoRollback.MaxFailedMachines, "MaxFailedMachines has to be a whole number"))
+	}
+	return allErrs
+}
+
+func validateMaintenanceWindow(maintenanceWindow *machine.MachineDeploymentMaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if maintenanceWindow == nil {
+		return allErrs
+	}
+	if len(maintenanceWindow.Windows) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("windows"), "At least one window has to be specified"))
+	}
+	for i, window := range maintenanceWindow.Windows {
+		if _, err := cron.Parse(window.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("windows").Index(i).Child("schedule"), window.Schedule, err.Error()))
+		}
+		if window.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("windows").Index(i).Child("duration"), window.Duration.String(), "Duration has to be positive"))
+		}
+	}
+	if maintenanceWindow.TimeZone != nil {
+		if _, err := time.LoadLocation(*maintenanceWindow.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *maintenanceWindow.TimeZone, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateScheduledScaling(scheduledScaling *machine.MachineDeploymentScheduledScaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if scheduledScaling == nil {
+		return allErrs
+	}
+	if len(scheduledScaling.Schedules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedules"), "At least one schedule has to be specified"))
+	}
+	names := sets.New[string]()
+	for i, schedule := range scheduledScaling.Schedules {
+		schedulePath := fldPath.Child("schedules").Index(i)
+		if schedule.Name == "" {
+			allErrs = append(allErrs, field.Required(schedulePath.Child("name"), "Name is required"))
+		} else if names.Has(schedule.Name) {
+			allErrs = append(allErrs, field.Duplicate(schedulePath.Child("name"), schedule.Name))
+		}
+		names.Insert(schedule.Name)
+		if _, err := cron.Parse(schedule.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("schedule"), schedule.Schedule, err.Error()))
+		}
+		if schedule.Replicas == nil && schedule.MinReplicas == nil && schedule.MaxReplicas == nil {
+			allErrs = append(allErrs, field.Required(schedulePath, "At least one of replicas, minReplicas or maxReplicas has to be specified"))
+		}
+		for _, replicas := range []struct {
+			name  string
+			value *int32
+		}{{"replicas", schedule.Replicas}, {"minReplicas", schedule.MinReplicas}, {"maxReplicas", schedule.MaxReplicas}} {
+			if replicas.value != nil && *replicas.value < 0 {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child(replicas.name), *replicas.value, "Replicas has to be a whole number"))
+			}
+		}
+		if schedule.MinReplicas != nil && schedule.MaxReplicas != nil && *schedule.MinReplicas > *schedule.MaxReplicas {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("minReplicas"), *schedule.MinReplicas, "MinReplicas must not be greater than maxReplicas"))
+		}
+	}
+	if scheduledScaling.TimeZone != nil {
+		if _, err := time.LoadLocation(*scheduledScaling.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *scheduledScaling.TimeZone, err.Error()))
 		}
 	}
-	allErrs = append(allErrs, validateClassReference(&spec.Template.Spec.Class, field.NewPath("spec.template.spec.class"))...)
 	return allErrs
 }
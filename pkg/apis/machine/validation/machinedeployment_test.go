@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineDeployment Validation", func() {
+	Describe("#validateMaintenanceWindow", func() {
+		fldPath := field.NewPath("spec", "maintenanceWindow")
+
+		DescribeTable("##validation scenarios",
+			func(maintenanceWindow *machine.MachineDeploymentMaintenanceWindow, expectedFields []string) {
+				errs := validateMaintenanceWindow(maintenanceWindow, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no maintenance window", nil, []string{}),
+			Entry("valid maintenance window", &machine.MachineDeploymentMaintenanceWindow{
+				Windows:  []machine.MaintenanceWindow{{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 4 * time.Hour}}},
+				TimeZone: ptr.To("Europe/Berlin"),
+			}, []string{}),
+			Entry("no windows", &machine.MachineDeploymentMaintenanceWindow{}, []string{"spec.maintenanceWindow.windows"}),
+			Entry("invalid schedule and duration", &machine.MachineDeploymentMaintenanceWindow{
+				Windows: []machine.MaintenanceWindow{{Schedule: "0 25 * * *"}},
+			}, []string{"spec.maintenanceWindow.windows[0].schedule", "spec.maintenanceWindow.windows[0].duration"}),
+			Entry("never matching schedule", &machine.MachineDeploymentMaintenanceWindow{
+				Windows: []machine.MaintenanceWindow{{Schedule: "0 0 30 2 *", Duration: metav1.Duration{Duration: time.Hour}}},
+			}, []string{"spec.maintenanceWindow.windows[0].schedule"}),
+			Entry("unknown time zone", &machine.MachineDeploymentMaintenanceWindow{
+				Windows:  []machine.MaintenanceWindow{{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+				TimeZone: ptr.To("Mars/Olympus_Mons"),
+			}, []string{"spec.maintenanceWindow.timeZone"}),
+		)
+	})
+
+	Describe("#validateScheduledScaling", func() {
+		fldPath := field.NewPath("spec", "scheduledScaling")
+
+		DescribeTable("##validation scenarios",
+			func(scheduledScaling *machine.MachineDeploymentScheduledScaling, expectedFields []string) {
+				errs := validateScheduledScaling(scheduledScaling, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no scheduled scaling", nil, []string{}),
+			Entry("valid scheduled scaling", &machine.MachineDeploymentScheduledScaling{
+				Schedules: []machine.ScalingSchedule{
+					{Name: "morning", Schedule: "0 7 * * 1-5", Replicas: ptr.To[int32](10)},
+					{Name: "evening", Schedule: "0 19 * * 1-5", MinReplicas: ptr.To[int32](1), MaxReplicas: ptr.To[int32](3)},
+				},
+				TimeZone: ptr.To("Europe/Berlin"),
+			}, []string{}),
+			Entry("no schedules", &machine.MachineDeploymentScheduledScaling{}, []string{"spec.scheduledScaling.schedules"}),
+			Entry("missing name and replicas and invalid schedule", &machine.MachineDeploymentScheduledScaling{
+				Schedules: []machine.ScalingSchedule{{Schedule: "0 25 * * *"}},
+			}, []string{"spec.scheduledScaling.schedules[0].name", "spec.scheduledScaling.schedules[0].schedule", "spec.scheduledScaling.schedules[0]"}),
+			Entry("duplicate name and invalid bounds", &machine.MachineDeploymentScheduledScaling{
+				Schedules: []machine.ScalingSchedule{
+					{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](-1)},
+					{Name: "morning", Schedule: "0 8 * * *", MinReplicas: ptr.To[int32](3), MaxReplicas: ptr.To[int32](1)},
+				},
+			}, []string{"spec.scheduledScaling.schedules[0].replicas", "spec.scheduledScaling.schedules[1].name", "spec.scheduledScaling.schedules[1].minReplicas"}),
+			Entry("unknown time zone", &machine.MachineDeploymentScheduledScaling{
+				Schedules: []machine.ScalingSchedule{{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](1)}},
+				TimeZone:  ptr.To("Mars/Olympus_Mons"),
+			}, []string{"spec.scheduledScaling.timeZone"}),
+		)
+	})
+
+	Describe("#validateAutoRollback", func() {
+		fldPath := field.NewPath("spec", "autoRollback")
+
+		DescribeTable("##validation scenarios",
+			func(autoRollback *machine.AutoRollbackPolicy, progressDeadlineSeconds *int32, expectedFields []string) {
+				spec := &machine.MachineDeploymentSpec{AutoRollback: autoRollback, ProgressDeadlineSeconds: progressDeadlineSeconds}
+				errs := validateAutoRollback(spec, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no auto rollback", nil, nil, []string{}),
+			Entry("max failed machines", &machine.AutoRollbackPolicy{MaxFailedMachines: ptr.To[int32](0)}, nil, []string{}),
+			Entry("progress deadline", &machine.AutoRollbackPolicy{}, ptr.To[int32](600), []string{}),
+			Entry("neither max failed machines nor progress deadline", &machine.AutoRollbackPolicy{}, nil, []string{"spec.autoRollback.maxFailedMachines"}),
+			Entry("negative max failed machines", &machine.AutoRollbackPolicy{MaxFailedMachines: ptr.To[int32](-1)}, ptr.To[int32](600), []string{"spec.autoRollback.maxFailedMachines"}),
+		)
+	})
+
+	Describe("#validateZoneSpread", func() {
+		fldPath := field.NewPath("spec", "zoneSpread")
+		zone := func(name string, weight *int32) machine.MachineDeploymentZone {
+			return machine.MachineDeploymentZone{Name: name, Class: machine.ClassSpec{Kind: "MachineClass", Name: "class-" + name}, Weight: weight}
+		}
+
+		DescribeTable("##validation scenarios",
+			func(zoneSpread *machine.MachineDeploymentZoneSpread, autoRollback *machine.AutoRollbackPolicy, expectedFields []string) {
+				spec := &machine.MachineDeploymentSpec{ZoneSpread: zoneSpread, AutoRollback: autoRollback}
+				errs := validateZoneSpread(spec, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no zone spread", nil, nil, []string{}),
+			Entry("valid zone spread", &machine.MachineDeploymentZoneSpread{
+				Zones:   []machine.MachineDeploymentZone{zone("zone-a", nil), zone("zone-b", ptr.To[int32](2))},
+				Policy:  machine.MaxSkewZoneSpreadPolicy,
+				MaxSkew: ptr.To[int32](2),
+			}, nil, []string{}),
+			Entry("no zones", &machine.MachineDeploymentZoneSpread{}, nil, []string{"spec.zoneSpread.zones"}),
+			Entry("invalid and duplicate zone names", &machine.MachineDeploymentZoneSpread{
+				Zones: []machine.MachineDeploymentZone{zone("Zone_A", nil), zone("zone-b", nil), zone("zone-b", nil)},
+			}, nil, []string{"spec.zoneSpread.zones[0].name", "spec.zoneSpread.zones[2].name"}),
+			Entry("missing class", &machine.MachineDeploymentZoneSpread{
+				Zones: []machine.MachineDeploymentZone{{Name: "zone-a"}},
+			}, nil, []string{"spec.zoneSpread.zones[0].class.kind", "spec.zoneSpread.zones[0].class.name"}),
+			Entry("negative weight", &machine.MachineDeploymentZoneSpread{
+				Zones:  []machine.MachineDeploymentZone{zone("zone-a", ptr.To[int32](-1)), zone("zone-b", nil)},
+				Policy: machine.WeightedZoneSpreadPolicy,
+			}, nil, []string{"spec.zoneSpread.zones[0].weight"}),
+			Entry("no positive weight", &machine.MachineDeploymentZoneSpread{
+				Zones:  []machine.MachineDeploymentZone{zone("zone-a", ptr.To[int32](0))},
+				Policy: machine.WeightedZoneSpreadPolicy,
+			}, nil, []string{"spec.zoneSpread.zones"}),
+			Entry("max skew policy without max skew", &machine.MachineDeploymentZoneSpread{
+				Zones:  []machine.MachineDeploymentZone{zone("zone-a", nil)},
+				Policy: machine.MaxSkewZoneSpreadPolicy,
+			}, nil, []string{"spec.zoneSpread.maxSkew"}),
+			Entry("unknown policy and invalid max skew", &machine.MachineDeploymentZoneSpread{
+				Zones:   []machine.MachineDeploymentZone{zone("zone-a", nil)},
+				Policy:  "Packed",
+				MaxSkew: ptr.To[int32](0),
+			}, nil, []string{"spec.zoneSpread.policy", "spec.zoneSpread.maxSkew"}),
+			Entry("auto rollback", &machine.MachineDeploymentZoneSpread{
+				Zones: []machine.MachineDeploymentZone{zone("zone-a", nil)},
+			}, &machine.AutoRollbackPolicy{}, []string{"spec.zoneSpread"}),
+		)
+	})
+
+	Describe("#validateZoneMachineDeploymentNames", func() {
+		fldPath := field.NewPath("spec", "zoneSpread", "zones")
+
+		DescribeTable("##validation scenarios",
+			func(name string, zones []string, expectedFields []string) {
+				md := &machine.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				md.Spec.ZoneSpread = &machine.MachineDeploymentZoneSpread{}
+				for _, zone := range zones {
+					md.Spec.ZoneSpread.Zones = append(md.Spec.ZoneSpread.Zones, machine.MachineDeploymentZone{Name: zone})
+				}
+				errs := validateZoneMachineDeploymentNames(md, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("valid names", "md", []string{"zone-a", "zone-b"}, []string{}),
+			Entry("generated name", "", []string{"zone-a"}, []string{}),
+			Entry("too long name", strings.Repeat("m", 250), []string{"a", "zone-b"}, []string{"spec.zoneSpread.zones[1].name"}),
+		)
+	})
+
+	Describe("#validateCanaryStrategy", func() {
+		fldPath := field.NewPath("spec", "strategy.canary")
+		one := intstr.FromInt(1)
+		quarter := intstr.FromString("25%")
+		invalid := intstr.FromString("a%")
+
+		DescribeTable("##validation scenarios",
+			func(canary *machine.CanaryMachineDeployment, expectedFields []string) {
+				errs := validateCanaryStrategy(canary, 8, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no canary parameters", nil, []string{"spec.strategy.canary"}),
+			Entry("valid steps", &machine.CanaryMachineDeployment{Steps: []machine.CanaryStep{
+				{Replicas: &one},
+				{Pause: &machine.CanaryPause{Duration: &metav1.Duration{Duration: time.Hour}}},
+				{Replicas: &quarter},
+				{Pause: &machine.CanaryPause{}},
+			}}, []string{}),
+			Entry("step without replicas and pause", &machine.CanaryMachineDeployment{Steps: []machine.CanaryStep{{}}}, []string{"spec.strategy.canary.steps[0]"}),
+			Entry("step with replicas and pause", &machine.CanaryMachineDeployment{Steps: []machine.CanaryStep{
+				{Replicas: &one, Pause: &machine.CanaryPause{}},
+			}}, []string{"spec.strategy.canary.steps[0]"}),
+			Entry("invalid replicas and pause duration", &machine.CanaryMachineDeployment{Steps: []machine.CanaryStep{
+				{Replicas: &invalid},
+				{Pause: &machine.CanaryPause{Duration: &metav1.Duration{Duration: -time.Hour}}},
+			}}, []string{"spec.strategy.canary.steps[0].replicas", "spec.strategy.canary.steps[1].pause.duration"}),
+			Entry("valid maxSurge and maxUnavailable", &machine.CanaryMachineDeployment{MaxSurge: &quarter, MaxUnavailable: &one, Steps: []machine.CanaryStep{
+				{Replicas: &one},
+			}}, []string{}),
+			Entry("invalid maxSurge and maxUnavailable", &machine.CanaryMachineDeployment{MaxSurge: &invalid, MaxUnavailable: &invalid, Steps: []machine.CanaryStep{
+				{Replicas: &one},
+			}}, []string{"spec.strategy.canary.maxSurge", "spec.strategy.canary.maxUnavailable"}),
+		)
+	})
+
+	Describe("#validateBlueGreenStrategy", func() {
+		fldPath := field.NewPath("spec", "strategy.blueGreen")
+
+		DescribeTable("##validation scenarios",
+			func(blueGreen *machine.BlueGreenMachineDeployment, expectedFields []string) {
+				errs := validateBlueGreenStrategy(blueGreen, fldPath)
+				fields := make([]string, 0, len(errs))
+				for _, err := range errs {
+					fields = append(fields, err.Field)
+				}
+				Expect(fields).To(ConsistOf(expectedFields))
+			},
+			Entry("no blue/green parameters", nil, []string{}),
+			Entry("valid parameters", &machine.BlueGreenMachineDeployment{
+				Hooks:              []string{"smoke-test", "latency"},
+				ConfirmationWindow: &metav1.Duration{Duration: time.Hour},
+			}, []string{}),
+			Entry("invalid and duplicate hooks", &machine.BlueGreenMachineDeployment{
+				Hooks: []string{"Smoke_Test", "latency", "latency"},
+			}, []string{"spec.strategy.blueGreen.hooks[0]", "spec.strategy.blueGreen.hooks[2]"}),
+			Entry("negative confirmation window", &machine.BlueGreenMachineDeployment{
+				ConfirmationWindow: &metav1.Duration{Duration: -time.Hour},
+			}, []string{"spec.strategy.blueGreen.confirmationWindow"}),
+		)
+	})
+})
@@ -29,13 +29,71 @@ func validateMachineSetSpec(spec *machine.MachineSetSpec, fldPath *field.Path) f
 		allErrs = append(allErrs, field.Required(fldPath.Child("replicas"), "Replicas has to be a whole number"))
 	}
 
-	for k, v := range spec.Selector.MatchLabels {
-		if spec.Template.Labels[k] != v {
-			allErrs = append(allErrs, field.Required(fldPath.Child("selector.matchLabels"), "is not matching with spec.template.metadata.labels"))
-			break
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "Selector is required"))
+	} else {
+		for k, v := range spec.Selector.MatchLabels {
+			if spec.Template.Labels[k] != v {
+				allErrs = append(allErrs, field.Required(fldPath.Child("selector.matchLabels"), "is not matching with spec.template.metadata.labels"))
+				break
+			}
 		}
 	}
 
 	allErrs = append(allErrs, validateClassReference(&spec.Template.Spec.Class, field.NewPath("spec.template.spec.class"))...)
+	allErrs = append(allErrs, validateDeletionPriority(spec.Template.Spec.DeletionPriority, field.NewPath("spec.template.spec.deletionPriority"))...)
+	allErrs = append(allErrs, validateDeletePolicy(spec.DeletePolicy, fldPath.Child("deletePolicy"))...)
+	allErrs = append(allErrs, validateClassFallback(spec.ClassFallback, &spec.Template.Spec.Class, fldPath.Child("classFallback"))...)
+	allErrs = append(allErrs, validateWarmPool(spec.WarmPool, fldPath.Child("warmPool"))...)
+	return allErrs
+}
+
+func validateWarmPool(warmPool *machine.MachineWarmPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if warmPool != nil && warmPool.Size < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), warmPool.Size, "Size has to be non-negative"))
+	}
+	return allErrs
+}
+
+func validateClassFallback(classFallback *machine.ClassFallback, templateClass *machine.ClassSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if classFallback == nil {
+		return allErrs
+	}
+	if len(classFallback.Classes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("classes"), "At least one class has to be specified"))
+	}
+	for i := range classFallback.Classes {
+		class := &classFallback.Classes[i]
+		classPath := fldPath.Child("classes").Index(i)
+		allErrs = append(allErrs, validateClassReference(class, classPath)...)
+		if class.Kind != "" && class.Kind != templateClass.Kind {
+			allErrs = append(allErrs, field.Invalid(classPath.Child("kind"), class.Kind, "Kind has to match the kind of spec.template.spec.class"))
+		}
+	}
+	if classFallback.CapacityErrorThreshold != nil && *classFallback.CapacityErrorThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("capacityErrorThreshold"), *classFallback.CapacityErrorThreshold, "CapacityErrorThreshold has to be at least 1"))
+	}
+	if classFallback.PreferredClassRetryPeriod != nil && classFallback.PreferredClassRetryPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("preferredClassRetryPeriod"), classFallback.PreferredClassRetryPeriod.Duration.String(), "PreferredClassRetryPeriod has to be positive"))
+	}
+	return allErrs
+}
+
+func validateDeletePolicy(deletePolicy machine.MachineSetDeletePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch deletePolicy {
+	case "", machine.OldestMachineSetDeletePolicy, machine.NewestMachineSetDeletePolicy, machine.RandomMachineSetDeletePolicy,
+		machine.ZoneBalancedMachineSetDeletePolicy, machine.LeastPodsMachineSetDeletePolicy:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, deletePolicy, []string{
+			string(machine.OldestMachineSetDeletePolicy),
+			string(machine.NewestMachineSetDeletePolicy),
+			string(machine.RandomMachineSetDeletePolicy),
+			string(machine.ZoneBalancedMachineSetDeletePolicy),
+			string(machine.LeastPodsMachineSetDeletePolicy),
+		}))
+	}
 	return allErrs
 }
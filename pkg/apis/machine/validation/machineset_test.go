@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineSet Validation", func() {
+	Describe("#validateDeletePolicy", func() {
+		fldPath := field.NewPath("spec", "deletePolicy")
+
+		DescribeTable("##validation scenarios",
+			func(deletePolicy machine.MachineSetDeletePolicy, expectErr bool) {
+				errs := validateDeletePolicy(deletePolicy, fldPath)
+				if expectErr {
+					Expect(errs).To(ConsistOf(HaveField("Field", "spec.deletePolicy")))
+				} else {
+					Expect(errs).To(BeEmpty())
+				}
+			},
+			Entry("no delete policy", machine.MachineSetDeletePolicy(""), false),
+			Entry("Oldest", machine.OldestMachineSetDeletePolicy, false),
+			Entry("Newest", machine.NewestMachineSetDeletePolicy, false),
+			Entry("Random", machine.RandomMachineSetDeletePolicy, false),
+			Entry("ZoneBalanced", machine.ZoneBalancedMachineSetDeletePolicy, false),
+			Entry("LeastPods", machine.LeastPodsMachineSetDeletePolicy, false),
+			Entry("unknown delete policy", machine.MachineSetDeletePolicy("Busiest"), true),
+		)
+	})
+
+	Describe("#validateClassFallback", func() {
+		fldPath := field.NewPath("spec", "classFallback")
+		templateClass := &machine.ClassSpec{Kind: "MachineClass", Name: "large"}
+
+		DescribeTable("##validation scenarios",
+			func(classFallback *machine.ClassFallback, expectedFields []string) {
+				errs := validateClassFallback(classFallback, templateClass, fldPath)
+				matchers := make([]interface{}, 0, len(expectedFields))
+				for _, f := range expectedFields {
+					matchers = append(matchers, HaveField("Field", f))
+				}
+				Expect(errs).To(ConsistOf(matchers...))
+			},
+			Entry("no class fallback", nil, nil),
+			Entry("valid class fallback", &machine.ClassFallback{
+				Classes:                   []machine.ClassSpec{{Kind: "MachineClass", Name: "medium"}},
+				CapacityErrorThreshold:    ptr.To[int32](2),
+				PreferredClassRetryPeriod: &metav1.Duration{Duration: time.Hour},
+			}, nil),
+			Entry("no classes", &machine.ClassFallback{}, []string{"spec.classFallback.classes"}),
+			Entry("class without name", &machine.ClassFallback{
+				Classes: []machine.ClassSpec{{Kind: "MachineClass"}},
+			}, []string{"spec.classFallback.classes[0].name"}),
+			Entry("class of another kind", &machine.ClassFallback{
+				Classes: []machine.ClassSpec{{Kind: "AWSMachineClass", Name: "medium"}},
+			}, []string{"spec.classFallback.classes[0].kind"}),
+			Entry("invalid threshold and retry period", &machine.ClassFallback{
+				Classes:                   []machine.ClassSpec{{Kind: "MachineClass", Name: "medium"}},
+				CapacityErrorThreshold:    ptr.To[int32](0),
+				PreferredClassRetryPeriod: &metav1.Duration{},
+			}, []string{"spec.classFallback.capacityErrorThreshold", "spec.classFallback.preferredClassRetryPeriod"}),
+		)
+	})
+})
@@ -0,0 +1,1896 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package internal
+
+import (
+	"fmt"
+	"sync"
+
+	typed "sigs.k8s.io/structured-merge-diff/v4/typed"
+)
+
+func Parser() *typed.Parser {
+	parserOnce.Do(func() {
+		var err error
+		parser, err = typed.NewParser(schemaYAML)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to parse schema: %v", err))
+		}
+	})
+	return parser
+}
+
+var parserOnce sync.Once
+var parser *typed.Parser
+var schemaYAML = typed.YAMLObject(`types:
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.AutoRollbackPolicy
+  map:
+    fields:
+    - name: maxFailedMachines
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.BlueGreenMachineDeployment
+  map:
+    fields:
+    - name: confirmationWindow
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: hooks
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.BlueGreenStatus
+  map:
+    fields:
+    - name: machineTemplateHash
+      type:
+        scalar: string
+    - name: phase
+      type:
+        scalar: string
+    - name: switchTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryMachineDeployment
+  map:
+    fields:
+    - name: maxSurge
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: steps
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryStep
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryPause
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryStatus
+  map:
+    fields:
+    - name: aborted
+      type:
+        scalar: boolean
+    - name: currentStep
+      type:
+        scalar: numeric
+    - name: machineTemplateHash
+      type:
+        scalar: string
+    - name: pauseStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryStep
+  map:
+    fields:
+    - name: pause
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryPause
+    - name: replicas
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassFallback
+  map:
+    fields:
+    - name: capacityErrorThreshold
+      type:
+        scalar: numeric
+    - name: classes
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+          elementRelationship: atomic
+    - name: preferredClassRetryPeriod
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassFallbackStatus
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+      default: {}
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+  map:
+    fields:
+    - name: apiGroup
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CurrentStatus
+  map:
+    fields:
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: phase
+      type:
+        scalar: string
+    - name: timeoutActive
+      type:
+        scalar: boolean
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.LastOperation
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: errorCode
+      type:
+        scalar: string
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: state
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.Machine
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineClass
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: credentialsSecretRef
+      type:
+        namedType: io.k8s.api.core.v1.SecretReference
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: nodeTemplate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.NodeTemplate
+    - name: provider
+      type:
+        scalar: string
+    - name: providerSpec
+      type:
+        namedType: __untyped_atomic_
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.SecretReference
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineClassStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineClassStatus
+  map:
+    fields:
+    - name: lastSuccessfulDriverCallTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: lastValidation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineClassValidation
+    - name: machineCount
+      type:
+        scalar: numeric
+    - name: orphanVMs
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.OrphanVM
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineClassValidation
+  map:
+    fields:
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: valid
+      type:
+        scalar: boolean
+      default: false
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeployment
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentCondition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentMaintenanceWindow
+  map:
+    fields:
+    - name: timeZone
+      type:
+        scalar: string
+    - name: windows
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MaintenanceWindow
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentScheduledScaling
+  map:
+    fields:
+    - name: schedules
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ScalingSchedule
+          elementRelationship: atomic
+    - name: timeZone
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentSpec
+  map:
+    fields:
+    - name: autoRollback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.AutoRollbackPolicy
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassFallback
+    - name: deletePolicy
+      type:
+        scalar: string
+    - name: frozen
+      type:
+        scalar: boolean
+    - name: maintenanceWindow
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentMaintenanceWindow
+    - name: minReadySeconds
+      type:
+        scalar: numeric
+    - name: paused
+      type:
+        scalar: boolean
+    - name: progressDeadlineSeconds
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: revisionHistoryLimit
+      type:
+        scalar: numeric
+    - name: rollbackTo
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.RollbackConfig
+    - name: scheduledScaling
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentScheduledScaling
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: strategy
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentStrategy
+      default: {}
+    - name: template
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineTemplateSpec
+      default: {}
+    - name: warmPool
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineWarmPool
+    - name: zoneSpread
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZoneSpread
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: blueGreen
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.BlueGreenStatus
+    - name: canary
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryStatus
+    - name: collisionCount
+      type:
+        scalar: numeric
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentCondition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: failedMachines
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSummary
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: scheduledScaling
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ScalingScheduleStatus
+          elementRelationship: atomic
+    - name: standbyReplicas
+      type:
+        scalar: numeric
+    - name: unavailableReplicas
+      type:
+        scalar: numeric
+    - name: updatedReplicas
+      type:
+        scalar: numeric
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZoneStatus
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentStrategy
+  map:
+    fields:
+    - name: blueGreen
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.BlueGreenMachineDeployment
+    - name: canary
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CanaryMachineDeployment
+    - name: rollingUpdate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.RollingUpdateMachineDeployment
+    - name: type
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZone
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+      default: {}
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: weight
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZoneSpread
+  map:
+    fields:
+    - name: maxSkew
+      type:
+        scalar: numeric
+    - name: policy
+      type:
+        scalar: string
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZone
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineDeploymentZoneStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: machineDeployment
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+      default: 0
+    - name: updatedReplicas
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSet
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetCondition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetSpec
+  map:
+    fields:
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassFallback
+    - name: deletePolicy
+      type:
+        scalar: string
+    - name: frozen
+      type:
+        scalar: boolean
+    - name: machineClass
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+      default: {}
+    - name: minReadySeconds
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: template
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineTemplateSpec
+      default: {}
+    - name: warmPool
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineWarmPool
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassFallbackStatus
+    - name: failedMachines
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSummary
+          elementRelationship: atomic
+    - name: fullyLabeledReplicas
+      type:
+        scalar: numeric
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.LastOperation
+      default: {}
+    - name: machineSetCondition
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSetCondition
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: standbyReplicas
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSpec
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ClassSpec
+      default: {}
+    - name: creationTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: deletionPriority
+      type:
+        scalar: numeric
+    - name: drainTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: healthTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxEvictRetries
+      type:
+        scalar: numeric
+    - name: nodeConditions
+      type:
+        scalar: string
+    - name: nodeTemplate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.NodeTemplateSpec
+      default: {}
+    - name: providerID
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.NodeCondition
+          elementRelationship: atomic
+    - name: currentStatus
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.CurrentStatus
+      default: {}
+    - name: lastKnownState
+      type:
+        scalar: string
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.LastOperation
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSummary
+  map:
+    fields:
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.LastOperation
+      default: {}
+    - name: name
+      type:
+        scalar: string
+    - name: ownerRef
+      type:
+        scalar: string
+    - name: providerID
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineTemplateSpec
+  map:
+    fields:
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineSpec
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MachineWarmPool
+  map:
+    fields:
+    - name: size
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.MaintenanceWindow
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.NodeTemplate
+  map:
+    fields:
+    - name: architecture
+      type:
+        scalar: string
+    - name: capacity
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: instanceType
+      type:
+        scalar: string
+      default: ""
+    - name: region
+      type:
+        scalar: string
+      default: ""
+    - name: zone
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.NodeTemplateSpec
+  map:
+    fields:
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.api.core.v1.NodeSpec
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.OrphanVM
+  map:
+    fields:
+    - name: machineName
+      type:
+        scalar: string
+      default: ""
+    - name: providerID
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.RollbackConfig
+  map:
+    fields:
+    - name: revision
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.RollingUpdateMachineDeployment
+  map:
+    fields:
+    - name: maxSurge
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ScalingSchedule
+  map:
+    fields:
+    - name: maxReplicas
+      type:
+        scalar: numeric
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1alpha1.ScalingScheduleStatus
+  map:
+    fields:
+    - name: lastScheduleTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: nextScheduleTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.AutoRollbackPolicy
+  map:
+    fields:
+    - name: maxFailedMachines
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.BlueGreenMachineDeployment
+  map:
+    fields:
+    - name: confirmationWindow
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: hooks
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.BlueGreenStatus
+  map:
+    fields:
+    - name: machineTemplateHash
+      type:
+        scalar: string
+    - name: phase
+      type:
+        scalar: string
+    - name: switchTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryMachineDeployment
+  map:
+    fields:
+    - name: maxSurge
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: steps
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryStep
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryPause
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryStatus
+  map:
+    fields:
+    - name: aborted
+      type:
+        scalar: boolean
+    - name: currentStep
+      type:
+        scalar: numeric
+    - name: machineTemplateHash
+      type:
+        scalar: string
+    - name: pauseStartTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryStep
+  map:
+    fields:
+    - name: pause
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryPause
+    - name: replicas
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassFallback
+  map:
+    fields:
+    - name: capacityErrorThreshold
+      type:
+        scalar: numeric
+    - name: classes
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+          elementRelationship: atomic
+    - name: preferredClassRetryPeriod
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassFallbackStatus
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+      default: {}
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+  map:
+    fields:
+    - name: apiGroup
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CurrentStatus
+  map:
+    fields:
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: phase
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.LastOperation
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: errorCode
+      type:
+        scalar: string
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: state
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.Machine
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClass
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: credentialsSecretRef
+      type:
+        namedType: io.k8s.api.core.v1.SecretReference
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: nodeTemplate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.NodeTemplate
+    - name: provider
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassProvider
+      default: {}
+    - name: secretRef
+      type:
+        namedType: io.k8s.api.core.v1.SecretReference
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassProvider
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: spec
+      type:
+        namedType: __untyped_atomic_
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassStatus
+  map:
+    fields:
+    - name: lastSuccessfulDriverCallTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: lastValidation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassValidation
+    - name: machineCount
+      type:
+        scalar: numeric
+    - name: orphanVMs
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.OrphanVM
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineClassValidation
+  map:
+    fields:
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: valid
+      type:
+        scalar: boolean
+      default: false
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeployment
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentCondition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: lastUpdateTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentMaintenanceWindow
+  map:
+    fields:
+    - name: timeZone
+      type:
+        scalar: string
+    - name: windows
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MaintenanceWindow
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentScheduledScaling
+  map:
+    fields:
+    - name: schedules
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ScalingSchedule
+          elementRelationship: atomic
+    - name: timeZone
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentSpec
+  map:
+    fields:
+    - name: autoRollback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.AutoRollbackPolicy
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassFallback
+    - name: deletePolicy
+      type:
+        scalar: string
+    - name: frozen
+      type:
+        scalar: boolean
+    - name: maintenanceWindow
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentMaintenanceWindow
+    - name: minReadySeconds
+      type:
+        scalar: numeric
+    - name: paused
+      type:
+        scalar: boolean
+    - name: progressDeadlineSeconds
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: revisionHistoryLimit
+      type:
+        scalar: numeric
+    - name: rollbackTo
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.RollbackConfig
+    - name: scheduledScaling
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentScheduledScaling
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: strategy
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentStrategy
+      default: {}
+    - name: template
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineTemplateSpec
+      default: {}
+    - name: warmPool
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineWarmPool
+    - name: zoneSpread
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZoneSpread
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: blueGreen
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.BlueGreenStatus
+    - name: canary
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryStatus
+    - name: collisionCount
+      type:
+        scalar: numeric
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentCondition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: failedMachines
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSummary
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: scheduledScaling
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ScalingScheduleStatus
+          elementRelationship: atomic
+    - name: standbyReplicas
+      type:
+        scalar: numeric
+    - name: unavailableReplicas
+      type:
+        scalar: numeric
+    - name: updatedReplicas
+      type:
+        scalar: numeric
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZoneStatus
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentStrategy
+  map:
+    fields:
+    - name: blueGreen
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.BlueGreenMachineDeployment
+    - name: canary
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CanaryMachineDeployment
+    - name: rollingUpdate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.RollingUpdateMachineDeployment
+    - name: type
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZone
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+      default: {}
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: weight
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZoneSpread
+  map:
+    fields:
+    - name: maxSkew
+      type:
+        scalar: numeric
+    - name: policy
+      type:
+        scalar: string
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZone
+          elementRelationship: atomic
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineDeploymentZoneStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: machineDeployment
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+      default: 0
+    - name: updatedReplicas
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSet
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetStatus
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetCondition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetSpec
+  map:
+    fields:
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassFallback
+    - name: deletePolicy
+      type:
+        scalar: string
+    - name: frozen
+      type:
+        scalar: boolean
+    - name: machineClass
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+      default: {}
+    - name: minReadySeconds
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: template
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineTemplateSpec
+      default: {}
+    - name: warmPool
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineWarmPool
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetStatus
+  map:
+    fields:
+    - name: availableReplicas
+      type:
+        scalar: numeric
+    - name: classFallback
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassFallbackStatus
+    - name: failedMachines
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSummary
+          elementRelationship: atomic
+    - name: fullyLabeledReplicas
+      type:
+        scalar: numeric
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.LastOperation
+      default: {}
+    - name: machineSetCondition
+      type:
+        list:
+          elementType:
+            namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSetCondition
+          elementRelationship: atomic
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: readyReplicas
+      type:
+        scalar: numeric
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: standbyReplicas
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSpec
+  map:
+    fields:
+    - name: class
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ClassSpec
+      default: {}
+    - name: creationTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: deletionPriority
+      type:
+        scalar: numeric
+    - name: drainTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: healthTimeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxEvictRetries
+      type:
+        scalar: numeric
+    - name: nodeConditions
+      type:
+        scalar: string
+    - name: nodeTemplate
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.NodeTemplateSpec
+      default: {}
+    - name: providerID
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.NodeCondition
+          elementRelationship: atomic
+    - name: currentStatus
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.CurrentStatus
+      default: {}
+    - name: lastKnownState
+      type:
+        scalar: string
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.LastOperation
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSummary
+  map:
+    fields:
+    - name: lastOperation
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.LastOperation
+      default: {}
+    - name: name
+      type:
+        scalar: string
+    - name: ownerRef
+      type:
+        scalar: string
+    - name: providerID
+      type:
+        scalar: string
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineTemplateSpec
+  map:
+    fields:
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineSpec
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MachineWarmPool
+  map:
+    fields:
+    - name: size
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.MaintenanceWindow
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.NodeTemplate
+  map:
+    fields:
+    - name: architecture
+      type:
+        scalar: string
+    - name: capacity
+      type:
+        map:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
+    - name: instanceType
+      type:
+        scalar: string
+      default: ""
+    - name: region
+      type:
+        scalar: string
+      default: ""
+    - name: zone
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.NodeTemplateSpec
+  map:
+    fields:
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.api.core.v1.NodeSpec
+      default: {}
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.OrphanVM
+  map:
+    fields:
+    - name: machineName
+      type:
+        scalar: string
+      default: ""
+    - name: providerID
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.RollbackConfig
+  map:
+    fields:
+    - name: revision
+      type:
+        scalar: numeric
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.RollingUpdateMachineDeployment
+  map:
+    fields:
+    - name: maxSurge
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ScalingSchedule
+  map:
+    fields:
+    - name: maxReplicas
+      type:
+        scalar: numeric
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: replicas
+      type:
+        scalar: numeric
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+- name: com.github.gardener.machine-controller-manager.pkg.apis.machine.v1beta1.ScalingScheduleStatus
+  map:
+    fields:
+    - name: lastScheduleTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: nextScheduleTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.api.core.v1.ConfigMapNodeConfigSource
+  map:
+    fields:
+    - name: kubeletConfigKey
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: namespace
+      type:
+        scalar: string
+      default: ""
+    - name: resourceVersion
+      type:
+        scalar: string
+    - name: uid
+      type:
+        scalar: string
+- name: io.k8s.api.core.v1.NodeCondition
+  map:
+    fields:
+    - name: lastHeartbeatTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+    - name: reason
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.api.core.v1.NodeConfigSource
+  map:
+    fields:
+    - name: configMap
+      type:
+        namedType: io.k8s.api.core.v1.ConfigMapNodeConfigSource
+- name: io.k8s.api.core.v1.NodeSpec
+  map:
+    fields:
+    - name: configSource
+      type:
+        namedType: io.k8s.api.core.v1.NodeConfigSource
+    - name: externalID
+      type:
+        scalar: string
+    - name: podCIDR
+      type:
+        scalar: string
+    - name: podCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: providerID
+      type:
+        scalar: string
+    - name: taints
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.api.core.v1.Taint
+          elementRelationship: atomic
+    - name: unschedulable
+      type:
+        scalar: boolean
+- name: io.k8s.api.core.v1.SecretReference
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+    elementRelationship: atomic
+- name: io.k8s.api.core.v1.Taint
+  map:
+    fields:
+    - name: effect
+      type:
+        scalar: string
+      default: ""
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: timeAdded
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: value
+      type:
+        scalar: string
+- name: io.k8s.apimachinery.pkg.api.resource.Quantity
+  scalar: untyped
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+  scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+  map:
+    fields:
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+          elementRelationship: atomic
+    - name: matchLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: operator
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: fieldsType
+      type:
+        scalar: string
+    - name: fieldsV1
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1
+    - name: manager
+      type:
+        scalar: string
+    - name: operation
+      type:
+        scalar: string
+    - name: subresource
+      type:
+        scalar: string
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+  map:
+    fields:
+    - name: annotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: creationTimestamp
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: deletionGracePeriodSeconds
+      type:
+        scalar: numeric
+    - name: deletionTimestamp
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: finalizers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: generateName
+      type:
+        scalar: string
+    - name: generation
+      type:
+        scalar: numeric
+    - name: labels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: managedFields
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
+          elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+    - name: ownerReferences
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.OwnerReference
+          elementRelationship: associative
+          keys:
+          - uid
+    - name: resourceVersion
+      type:
+        scalar: string
+    - name: selfLink
+      type:
+        scalar: string
+    - name: uid
+      type:
+        scalar: string
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.OwnerReference
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+      default: ""
+    - name: blockOwnerDeletion
+      type:
+        scalar: boolean
+    - name: controller
+      type:
+        scalar: boolean
+    - name: kind
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: uid
+      type:
+        scalar: string
+      default: ""
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+  scalar: untyped
+- name: io.k8s.apimachinery.pkg.runtime.RawExtension
+  map:
+    elementType:
+      scalar: untyped
+      list:
+        elementType:
+          namedType: __untyped_atomic_
+        elementRelationship: atomic
+      map:
+        elementType:
+          namedType: __untyped_deduced_
+        elementRelationship: separable
+- name: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+  scalar: untyped
+- name: __untyped_atomic_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+- name: __untyped_deduced_
+  scalar: untyped
+  list:
+    elementType:
+      namedType: __untyped_atomic_
+    elementRelationship: atomic
+  map:
+    elementType:
+      namedType: __untyped_deduced_
+    elementRelationship: separable
+`)
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AutoRollbackPolicyApplyConfiguration represents a declarative configuration of the AutoRollbackPolicy type for use
+// with apply.
+type AutoRollbackPolicyApplyConfiguration struct {
+	MaxFailedMachines *int32 `json:"maxFailedMachines,omitempty"`
+}
+
+// AutoRollbackPolicyApplyConfiguration constructs a declarative configuration of the AutoRollbackPolicy type for use with
+// apply.
+func AutoRollbackPolicy() *AutoRollbackPolicyApplyConfiguration {
+	return &AutoRollbackPolicyApplyConfiguration{}
+}
+
+// WithMaxFailedMachines sets the MaxFailedMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxFailedMachines field is set to the value of the last call.
+func (b *AutoRollbackPolicyApplyConfiguration) WithMaxFailedMachines(value int32) *AutoRollbackPolicyApplyConfiguration {
+	b.MaxFailedMachines = &value
+	return b
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlueGreenMachineDeploymentApplyConfiguration represents a declarative configuration of the BlueGreenMachineDeployment type for use
+// with apply.
+type BlueGreenMachineDeploymentApplyConfiguration struct {
+	Hooks              []string     `json:"hooks,omitempty"`
+	ConfirmationWindow *v1.Duration `json:"confirmationWindow,omitempty"`
+}
+
+// BlueGreenMachineDeploymentApplyConfiguration constructs a declarative configuration of the BlueGreenMachineDeployment type for use with
+// apply.
+func BlueGreenMachineDeployment() *BlueGreenMachineDeploymentApplyConfiguration {
+	return &BlueGreenMachineDeploymentApplyConfiguration{}
+}
+
+// WithHooks adds the given value to the Hooks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hooks field.
+func (b *BlueGreenMachineDeploymentApplyConfiguration) WithHooks(values ...string) *BlueGreenMachineDeploymentApplyConfiguration {
+	for i := range values {
+		b.Hooks = append(b.Hooks, values[i])
+	}
+	return b
+}
+
+// WithConfirmationWindow sets the ConfirmationWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfirmationWindow field is set to the value of the last call.
+func (b *BlueGreenMachineDeploymentApplyConfiguration) WithConfirmationWindow(value v1.Duration) *BlueGreenMachineDeploymentApplyConfiguration {
+	b.ConfirmationWindow = &value
+	return b
+}
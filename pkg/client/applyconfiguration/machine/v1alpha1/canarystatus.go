@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CanaryStatusApplyConfiguration represents a declarative configuration of the CanaryStatus type for use
+// with apply.
+type CanaryStatusApplyConfiguration struct {
+	MachineTemplateHash *string  `json:"machineTemplateHash,omitempty"`
+	CurrentStep         *int32   `json:"currentStep,omitempty"`
+	PauseStartTime      *v1.Time `json:"pauseStartTime,omitempty"`
+	Aborted             *bool    `json:"aborted,omitempty"`
+}
+
+// CanaryStatusApplyConfiguration constructs a declarative configuration of the CanaryStatus type for use with
+// apply.
+func CanaryStatus() *CanaryStatusApplyConfiguration {
+	return &CanaryStatusApplyConfiguration{}
+}
+
+// WithMachineTemplateHash sets the MachineTemplateHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineTemplateHash field is set to the value of the last call.
+func (b *CanaryStatusApplyConfiguration) WithMachineTemplateHash(value string) *CanaryStatusApplyConfiguration {
+	b.MachineTemplateHash = &value
+	return b
+}
+
+// WithCurrentStep sets the CurrentStep field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentStep field is set to the value of the last call.
+func (b *CanaryStatusApplyConfiguration) WithCurrentStep(value int32) *CanaryStatusApplyConfiguration {
+	b.CurrentStep = &value
+	return b
+}
+
+// WithPauseStartTime sets the PauseStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PauseStartTime field is set to the value of the last call.
+func (b *CanaryStatusApplyConfiguration) WithPauseStartTime(value v1.Time) *CanaryStatusApplyConfiguration {
+	b.PauseStartTime = &value
+	return b
+}
+
+// WithAborted sets the Aborted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Aborted field is set to the value of the last call.
+func (b *CanaryStatusApplyConfiguration) WithAborted(value bool) *CanaryStatusApplyConfiguration {
+	b.Aborted = &value
+	return b
+}
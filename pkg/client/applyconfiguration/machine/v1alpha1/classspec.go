@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ClassSpecApplyConfiguration represents a declarative configuration of the ClassSpec type for use
+// with apply.
+type ClassSpecApplyConfiguration struct {
+	APIGroup *string `json:"apiGroup,omitempty"`
+	Kind     *string `json:"kind,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+// ClassSpecApplyConfiguration constructs a declarative configuration of the ClassSpec type for use with
+// apply.
+func ClassSpec() *ClassSpecApplyConfiguration {
+	return &ClassSpecApplyConfiguration{}
+}
+
+// WithAPIGroup sets the APIGroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIGroup field is set to the value of the last call.
+func (b *ClassSpecApplyConfiguration) WithAPIGroup(value string) *ClassSpecApplyConfiguration {
+	b.APIGroup = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ClassSpecApplyConfiguration) WithKind(value string) *ClassSpecApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ClassSpecApplyConfiguration) WithName(value string) *ClassSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CurrentStatusApplyConfiguration represents a declarative configuration of the CurrentStatus type for use
+// with apply.
+type CurrentStatusApplyConfiguration struct {
+	Phase          *v1alpha1.MachinePhase `json:"phase,omitempty"`
+	TimeoutActive  *bool                  `json:"timeoutActive,omitempty"`
+	LastUpdateTime *v1.Time               `json:"lastUpdateTime,omitempty"`
+}
+
+// CurrentStatusApplyConfiguration constructs a declarative configuration of the CurrentStatus type for use with
+// apply.
+func CurrentStatus() *CurrentStatusApplyConfiguration {
+	return &CurrentStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *CurrentStatusApplyConfiguration) WithPhase(value v1alpha1.MachinePhase) *CurrentStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithTimeoutActive sets the TimeoutActive field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutActive field is set to the value of the last call.
+func (b *CurrentStatusApplyConfiguration) WithTimeoutActive(value bool) *CurrentStatusApplyConfiguration {
+	b.TimeoutActive = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *CurrentStatusApplyConfiguration) WithLastUpdateTime(value v1.Time) *CurrentStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
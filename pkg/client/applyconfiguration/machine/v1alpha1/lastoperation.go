@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LastOperationApplyConfiguration represents a declarative configuration of the LastOperation type for use
+// with apply.
+type LastOperationApplyConfiguration struct {
+	Description    *string                        `json:"description,omitempty"`
+	ErrorCode      *string                        `json:"errorCode,omitempty"`
+	LastUpdateTime *v1.Time                       `json:"lastUpdateTime,omitempty"`
+	State          *v1alpha1.MachineState         `json:"state,omitempty"`
+	Type           *v1alpha1.MachineOperationType `json:"type,omitempty"`
+}
+
+// LastOperationApplyConfiguration constructs a declarative configuration of the LastOperation type for use with
+// apply.
+func LastOperation() *LastOperationApplyConfiguration {
+	return &LastOperationApplyConfiguration{}
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *LastOperationApplyConfiguration) WithDescription(value string) *LastOperationApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithErrorCode sets the ErrorCode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ErrorCode field is set to the value of the last call.
+func (b *LastOperationApplyConfiguration) WithErrorCode(value string) *LastOperationApplyConfiguration {
+	b.ErrorCode = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *LastOperationApplyConfiguration) WithLastUpdateTime(value v1.Time) *LastOperationApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *LastOperationApplyConfiguration) WithState(value v1alpha1.MachineState) *LastOperationApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *LastOperationApplyConfiguration) WithType(value v1alpha1.MachineOperationType) *LastOperationApplyConfiguration {
+	b.Type = &value
+	return b
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineClassStatusApplyConfiguration represents a declarative configuration of the MachineClassStatus type for use
+// with apply.
+type MachineClassStatusApplyConfiguration struct {
+	MachineCount                 *int32                                    `json:"machineCount,omitempty"`
+	LastValidation               *MachineClassValidationApplyConfiguration `json:"lastValidation,omitempty"`
+	LastSuccessfulDriverCallTime *v1.Time                                  `json:"lastSuccessfulDriverCallTime,omitempty"`
+	OrphanVMs                    []OrphanVMApplyConfiguration              `json:"orphanVMs,omitempty"`
+}
+
+// MachineClassStatusApplyConfiguration constructs a declarative configuration of the MachineClassStatus type for use with
+// apply.
+func MachineClassStatus() *MachineClassStatusApplyConfiguration {
+	return &MachineClassStatusApplyConfiguration{}
+}
+
+// WithMachineCount sets the MachineCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineCount field is set to the value of the last call.
+func (b *MachineClassStatusApplyConfiguration) WithMachineCount(value int32) *MachineClassStatusApplyConfiguration {
+	b.MachineCount = &value
+	return b
+}
+
+// WithLastValidation sets the LastValidation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastValidation field is set to the value of the last call.
+func (b *MachineClassStatusApplyConfiguration) WithLastValidation(value *MachineClassValidationApplyConfiguration) *MachineClassStatusApplyConfiguration {
+	b.LastValidation = value
+	return b
+}
+
+// WithLastSuccessfulDriverCallTime sets the LastSuccessfulDriverCallTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSuccessfulDriverCallTime field is set to the value of the last call.
+func (b *MachineClassStatusApplyConfiguration) WithLastSuccessfulDriverCallTime(value v1.Time) *MachineClassStatusApplyConfiguration {
+	b.LastSuccessfulDriverCallTime = &value
+	return b
+}
+
+// WithOrphanVMs adds the given value to the OrphanVMs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OrphanVMs field.
+func (b *MachineClassStatusApplyConfiguration) WithOrphanVMs(values ...*OrphanVMApplyConfiguration) *MachineClassStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOrphanVMs")
+		}
+		b.OrphanVMs = append(b.OrphanVMs, *values[i])
+	}
+	return b
+}
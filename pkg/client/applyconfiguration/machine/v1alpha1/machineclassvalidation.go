@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineClassValidationApplyConfiguration represents a declarative configuration of the MachineClassValidation type for use
+// with apply.
+type MachineClassValidationApplyConfiguration struct {
+	Valid          *bool    `json:"valid,omitempty"`
+	Message        *string  `json:"message,omitempty"`
+	LastUpdateTime *v1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// MachineClassValidationApplyConfiguration constructs a declarative configuration of the MachineClassValidation type for use with
+// apply.
+func MachineClassValidation() *MachineClassValidationApplyConfiguration {
+	return &MachineClassValidationApplyConfiguration{}
+}
+
+// WithValid sets the Valid field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Valid field is set to the value of the last call.
+func (b *MachineClassValidationApplyConfiguration) WithValid(value bool) *MachineClassValidationApplyConfiguration {
+	b.Valid = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *MachineClassValidationApplyConfiguration) WithMessage(value string) *MachineClassValidationApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *MachineClassValidationApplyConfiguration) WithLastUpdateTime(value v1.Time) *MachineClassValidationApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
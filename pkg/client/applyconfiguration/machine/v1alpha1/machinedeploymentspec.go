@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineDeploymentSpecApplyConfiguration represents a declarative configuration of the MachineDeploymentSpec type for use
+// with apply.
+type MachineDeploymentSpecApplyConfiguration struct {
+	Replicas                *int32                                                `json:"replicas,omitempty"`
+	Selector                *v1.LabelSelectorApplyConfiguration                   `json:"selector,omitempty"`
+	Template                *MachineTemplateSpecApplyConfiguration                `json:"template,omitempty"`
+	Strategy                *MachineDeploymentStrategyApplyConfiguration          `json:"strategy,omitempty"`
+	MinReadySeconds         *int32                                                `json:"minReadySeconds,omitempty"`
+	RevisionHistoryLimit    *int32                                                `json:"revisionHistoryLimit,omitempty"`
+	Paused                  *bool                                                 `json:"paused,omitempty"`
+	RollbackTo              *RollbackConfigApplyConfiguration                     `json:"rollbackTo,omitempty"`
+	ProgressDeadlineSeconds *int32                                                `json:"progressDeadlineSeconds,omitempty"`
+	MaintenanceWindow       *MachineDeploymentMaintenanceWindowApplyConfiguration `json:"maintenanceWindow,omitempty"`
+	AutoRollback            *AutoRollbackPolicyApplyConfiguration                 `json:"autoRollback,omitempty"`
+	DeletePolicy            *machinev1alpha1.MachineSetDeletePolicy               `json:"deletePolicy,omitempty"`
+	ZoneSpread              *MachineDeploymentZoneSpreadApplyConfiguration        `json:"zoneSpread,omitempty"`
+	ClassFallback           *ClassFallbackApplyConfiguration                      `json:"classFallback,omitempty"`
+	WarmPool                *MachineWarmPoolApplyConfiguration                    `json:"warmPool,omitempty"`
+	ScheduledScaling        *MachineDeploymentScheduledScalingApplyConfiguration  `json:"scheduledScaling,omitempty"`
+	Frozen                  *bool                                                 `json:"frozen,omitempty"`
+}
+
+// MachineDeploymentSpecApplyConfiguration constructs a declarative configuration of the MachineDeploymentSpec type for use with
+// apply.
+func MachineDeploymentSpec() *MachineDeploymentSpecApplyConfiguration {
+	return &MachineDeploymentSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithReplicas(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithTemplate(value *MachineTemplateSpecApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithStrategy(value *MachineDeploymentStrategyApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Strategy = value
+	return b
+}
+
+// WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReadySeconds field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithMinReadySeconds(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.MinReadySeconds = &value
+	return b
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithRevisionHistoryLimit(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithPaused(value bool) *MachineDeploymentSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithRollbackTo sets the RollbackTo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollbackTo field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithRollbackTo(value *RollbackConfigApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.RollbackTo = value
+	return b
+}
+
+// WithProgressDeadlineSeconds sets the ProgressDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProgressDeadlineSeconds field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithProgressDeadlineSeconds(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.ProgressDeadlineSeconds = &value
+	return b
+}
+
+// WithMaintenanceWindow sets the MaintenanceWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaintenanceWindow field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithMaintenanceWindow(value *MachineDeploymentMaintenanceWindowApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.MaintenanceWindow = value
+	return b
+}
+
+// WithAutoRollback sets the AutoRollback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRollback field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithAutoRollback(value *AutoRollbackPolicyApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.AutoRollback = value
+	return b
+}
+
+// WithDeletePolicy sets the DeletePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletePolicy field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithDeletePolicy(value machinev1alpha1.MachineSetDeletePolicy) *MachineDeploymentSpecApplyConfiguration {
+	b.DeletePolicy = &value
+	return b
+}
+
+// WithZoneSpread sets the ZoneSpread field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ZoneSpread field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithZoneSpread(value *MachineDeploymentZoneSpreadApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.ZoneSpread = value
+	return b
+}
+
+// WithClassFallback sets the ClassFallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClassFallback field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithClassFallback(value *ClassFallbackApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.ClassFallback = value
+	return b
+}
+
+// WithWarmPool sets the WarmPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarmPool field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithWarmPool(value *MachineWarmPoolApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.WarmPool = value
+	return b
+}
+
+// WithScheduledScaling sets the ScheduledScaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScheduledScaling field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithScheduledScaling(value *MachineDeploymentScheduledScalingApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.ScheduledScaling = value
+	return b
+}
+
+// WithFrozen sets the Frozen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Frozen field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithFrozen(value bool) *MachineDeploymentSpecApplyConfiguration {
+	b.Frozen = &value
+	return b
+}
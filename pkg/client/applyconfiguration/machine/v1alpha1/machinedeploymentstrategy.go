@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+)
+
+// MachineDeploymentStrategyApplyConfiguration represents a declarative configuration of the MachineDeploymentStrategy type for use
+// with apply.
+type MachineDeploymentStrategyApplyConfiguration struct {
+	Type          *v1alpha1.MachineDeploymentStrategyType           `json:"type,omitempty"`
+	RollingUpdate *RollingUpdateMachineDeploymentApplyConfiguration `json:"rollingUpdate,omitempty"`
+	Canary        *CanaryMachineDeploymentApplyConfiguration        `json:"canary,omitempty"`
+	BlueGreen     *BlueGreenMachineDeploymentApplyConfiguration     `json:"blueGreen,omitempty"`
+}
+
+// MachineDeploymentStrategyApplyConfiguration constructs a declarative configuration of the MachineDeploymentStrategy type for use with
+// apply.
+func MachineDeploymentStrategy() *MachineDeploymentStrategyApplyConfiguration {
+	return &MachineDeploymentStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithType(value v1alpha1.MachineDeploymentStrategyType) *MachineDeploymentStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithRollingUpdate sets the RollingUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollingUpdate field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithRollingUpdate(value *RollingUpdateMachineDeploymentApplyConfiguration) *MachineDeploymentStrategyApplyConfiguration {
+	b.RollingUpdate = value
+	return b
+}
+
+// WithCanary sets the Canary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Canary field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithCanary(value *CanaryMachineDeploymentApplyConfiguration) *MachineDeploymentStrategyApplyConfiguration {
+	b.Canary = value
+	return b
+}
+
+// WithBlueGreen sets the BlueGreen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BlueGreen field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithBlueGreen(value *BlueGreenMachineDeploymentApplyConfiguration) *MachineDeploymentStrategyApplyConfiguration {
+	b.BlueGreen = value
+	return b
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+)
+
+// MachineDeploymentZoneSpreadApplyConfiguration represents a declarative configuration of the MachineDeploymentZoneSpread type for use
+// with apply.
+type MachineDeploymentZoneSpreadApplyConfiguration struct {
+	Zones   []MachineDeploymentZoneApplyConfiguration `json:"zones,omitempty"`
+	Policy  *machinev1alpha1.ZoneSpreadPolicy         `json:"policy,omitempty"`
+	MaxSkew *int32                                    `json:"maxSkew,omitempty"`
+}
+
+// MachineDeploymentZoneSpreadApplyConfiguration constructs a declarative configuration of the MachineDeploymentZoneSpread type for use with
+// apply.
+func MachineDeploymentZoneSpread() *MachineDeploymentZoneSpreadApplyConfiguration {
+	return &MachineDeploymentZoneSpreadApplyConfiguration{}
+}
+
+// WithZones adds the given value to the Zones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Zones field.
+func (b *MachineDeploymentZoneSpreadApplyConfiguration) WithZones(values ...*MachineDeploymentZoneApplyConfiguration) *MachineDeploymentZoneSpreadApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithZones")
+		}
+		b.Zones = append(b.Zones, *values[i])
+	}
+	return b
+}
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *MachineDeploymentZoneSpreadApplyConfiguration) WithPolicy(value machinev1alpha1.ZoneSpreadPolicy) *MachineDeploymentZoneSpreadApplyConfiguration {
+	b.Policy = &value
+	return b
+}
+
+// WithMaxSkew sets the MaxSkew field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSkew field is set to the value of the last call.
+func (b *MachineDeploymentZoneSpreadApplyConfiguration) WithMaxSkew(value int32) *MachineDeploymentZoneSpreadApplyConfiguration {
+	b.MaxSkew = &value
+	return b
+}
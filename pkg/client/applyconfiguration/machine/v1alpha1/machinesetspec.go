@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineSetSpecApplyConfiguration represents a declarative configuration of the MachineSetSpec type for use
+// with apply.
+type MachineSetSpecApplyConfiguration struct {
+	Replicas        *int32                                  `json:"replicas,omitempty"`
+	Selector        *v1.LabelSelectorApplyConfiguration     `json:"selector,omitempty"`
+	MachineClass    *ClassSpecApplyConfiguration            `json:"machineClass,omitempty"`
+	Template        *MachineTemplateSpecApplyConfiguration  `json:"template,omitempty"`
+	MinReadySeconds *int32                                  `json:"minReadySeconds,omitempty"`
+	DeletePolicy    *machinev1alpha1.MachineSetDeletePolicy `json:"deletePolicy,omitempty"`
+	ClassFallback   *ClassFallbackApplyConfiguration        `json:"classFallback,omitempty"`
+	WarmPool        *MachineWarmPoolApplyConfiguration      `json:"warmPool,omitempty"`
+	Frozen          *bool                                   `json:"frozen,omitempty"`
+}
+
+// MachineSetSpecApplyConfiguration constructs a declarative configuration of the MachineSetSpec type for use with
+// apply.
+func MachineSetSpec() *MachineSetSpecApplyConfiguration {
+	return &MachineSetSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithReplicas(value int32) *MachineSetSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithMachineClass sets the MachineClass field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineClass field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithMachineClass(value *ClassSpecApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.MachineClass = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithTemplate(value *MachineTemplateSpecApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReadySeconds field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithMinReadySeconds(value int32) *MachineSetSpecApplyConfiguration {
+	b.MinReadySeconds = &value
+	return b
+}
+
+// WithDeletePolicy sets the DeletePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletePolicy field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithDeletePolicy(value machinev1alpha1.MachineSetDeletePolicy) *MachineSetSpecApplyConfiguration {
+	b.DeletePolicy = &value
+	return b
+}
+
+// WithClassFallback sets the ClassFallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClassFallback field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithClassFallback(value *ClassFallbackApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.ClassFallback = value
+	return b
+}
+
+// WithWarmPool sets the WarmPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarmPool field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithWarmPool(value *MachineWarmPoolApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.WarmPool = value
+	return b
+}
+
+// WithFrozen sets the Frozen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Frozen field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithFrozen(value bool) *MachineSetSpecApplyConfiguration {
+	b.Frozen = &value
+	return b
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeTemplateApplyConfiguration represents a declarative configuration of the NodeTemplate type for use
+// with apply.
+type NodeTemplateApplyConfiguration struct {
+	Capacity     *v1.ResourceList `json:"capacity,omitempty"`
+	InstanceType *string          `json:"instanceType,omitempty"`
+	Region       *string          `json:"region,omitempty"`
+	Zone         *string          `json:"zone,omitempty"`
+	Architecture *string          `json:"architecture,omitempty"`
+}
+
+// NodeTemplateApplyConfiguration constructs a declarative configuration of the NodeTemplate type for use with
+// apply.
+func NodeTemplate() *NodeTemplateApplyConfiguration {
+	return &NodeTemplateApplyConfiguration{}
+}
+
+// WithCapacity sets the Capacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capacity field is set to the value of the last call.
+func (b *NodeTemplateApplyConfiguration) WithCapacity(value v1.ResourceList) *NodeTemplateApplyConfiguration {
+	b.Capacity = &value
+	return b
+}
+
+// WithInstanceType sets the InstanceType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstanceType field is set to the value of the last call.
+func (b *NodeTemplateApplyConfiguration) WithInstanceType(value string) *NodeTemplateApplyConfiguration {
+	b.InstanceType = &value
+	return b
+}
+
+// WithRegion sets the Region field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Region field is set to the value of the last call.
+func (b *NodeTemplateApplyConfiguration) WithRegion(value string) *NodeTemplateApplyConfiguration {
+	b.Region = &value
+	return b
+}
+
+// WithZone sets the Zone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zone field is set to the value of the last call.
+func (b *NodeTemplateApplyConfiguration) WithZone(value string) *NodeTemplateApplyConfiguration {
+	b.Zone = &value
+	return b
+}
+
+// WithArchitecture sets the Architecture field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Architecture field is set to the value of the last call.
+func (b *NodeTemplateApplyConfiguration) WithArchitecture(value string) *NodeTemplateApplyConfiguration {
+	b.Architecture = &value
+	return b
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RollbackConfigApplyConfiguration represents a declarative configuration of the RollbackConfig type for use
+// with apply.
+type RollbackConfigApplyConfiguration struct {
+	Revision *int64 `json:"revision,omitempty"`
+}
+
+// RollbackConfigApplyConfiguration constructs a declarative configuration of the RollbackConfig type for use with
+// apply.
+func RollbackConfig() *RollbackConfigApplyConfiguration {
+	return &RollbackConfigApplyConfiguration{}
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *RollbackConfigApplyConfiguration) WithRevision(value int64) *RollbackConfigApplyConfiguration {
+	b.Revision = &value
+	return b
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RollingUpdateMachineDeploymentApplyConfiguration represents a declarative configuration of the RollingUpdateMachineDeployment type for use
+// with apply.
+type RollingUpdateMachineDeploymentApplyConfiguration struct {
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	MaxSurge       *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// RollingUpdateMachineDeploymentApplyConfiguration constructs a declarative configuration of the RollingUpdateMachineDeployment type for use with
+// apply.
+func RollingUpdateMachineDeployment() *RollingUpdateMachineDeploymentApplyConfiguration {
+	return &RollingUpdateMachineDeploymentApplyConfiguration{}
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *RollingUpdateMachineDeploymentApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *RollingUpdateMachineDeploymentApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *RollingUpdateMachineDeploymentApplyConfiguration) WithMaxSurge(value intstr.IntOrString) *RollingUpdateMachineDeploymentApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ScalingScheduleApplyConfiguration represents a declarative configuration of the ScalingSchedule type for use
+// with apply.
+type ScalingScheduleApplyConfiguration struct {
+	Name        *string `json:"name,omitempty"`
+	Schedule    *string `json:"schedule,omitempty"`
+	Replicas    *int32  `json:"replicas,omitempty"`
+	MinReplicas *int32  `json:"minReplicas,omitempty"`
+	MaxReplicas *int32  `json:"maxReplicas,omitempty"`
+}
+
+// ScalingScheduleApplyConfiguration constructs a declarative configuration of the ScalingSchedule type for use with
+// apply.
+func ScalingSchedule() *ScalingScheduleApplyConfiguration {
+	return &ScalingScheduleApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithName(value string) *ScalingScheduleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithSchedule(value string) *ScalingScheduleApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithMinReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ScalingScheduleApplyConfiguration) WithMaxReplicas(value int32) *ScalingScheduleApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
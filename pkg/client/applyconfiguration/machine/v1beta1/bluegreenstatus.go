@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlueGreenStatusApplyConfiguration represents a declarative configuration of the BlueGreenStatus type for use
+// with apply.
+type BlueGreenStatusApplyConfiguration struct {
+	MachineTemplateHash *string                 `json:"machineTemplateHash,omitempty"`
+	Phase               *v1beta1.BlueGreenPhase `json:"phase,omitempty"`
+	SwitchTime          *v1.Time                `json:"switchTime,omitempty"`
+}
+
+// BlueGreenStatusApplyConfiguration constructs a declarative configuration of the BlueGreenStatus type for use with
+// apply.
+func BlueGreenStatus() *BlueGreenStatusApplyConfiguration {
+	return &BlueGreenStatusApplyConfiguration{}
+}
+
+// WithMachineTemplateHash sets the MachineTemplateHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineTemplateHash field is set to the value of the last call.
+func (b *BlueGreenStatusApplyConfiguration) WithMachineTemplateHash(value string) *BlueGreenStatusApplyConfiguration {
+	b.MachineTemplateHash = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *BlueGreenStatusApplyConfiguration) WithPhase(value v1beta1.BlueGreenPhase) *BlueGreenStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithSwitchTime sets the SwitchTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SwitchTime field is set to the value of the last call.
+func (b *BlueGreenStatusApplyConfiguration) WithSwitchTime(value v1.Time) *BlueGreenStatusApplyConfiguration {
+	b.SwitchTime = &value
+	return b
+}
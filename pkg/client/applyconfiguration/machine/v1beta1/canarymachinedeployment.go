@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// CanaryMachineDeploymentApplyConfiguration represents a declarative configuration of the CanaryMachineDeployment type for use
+// with apply.
+type CanaryMachineDeploymentApplyConfiguration struct {
+	Steps          []CanaryStepApplyConfiguration `json:"steps,omitempty"`
+	MaxUnavailable *intstr.IntOrString            `json:"maxUnavailable,omitempty"`
+	MaxSurge       *intstr.IntOrString            `json:"maxSurge,omitempty"`
+}
+
+// CanaryMachineDeploymentApplyConfiguration constructs a declarative configuration of the CanaryMachineDeployment type for use with
+// apply.
+func CanaryMachineDeployment() *CanaryMachineDeploymentApplyConfiguration {
+	return &CanaryMachineDeploymentApplyConfiguration{}
+}
+
+// WithSteps adds the given value to the Steps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Steps field.
+func (b *CanaryMachineDeploymentApplyConfiguration) WithSteps(values ...*CanaryStepApplyConfiguration) *CanaryMachineDeploymentApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSteps")
+		}
+		b.Steps = append(b.Steps, *values[i])
+	}
+	return b
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *CanaryMachineDeploymentApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *CanaryMachineDeploymentApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *CanaryMachineDeploymentApplyConfiguration) WithMaxSurge(value intstr.IntOrString) *CanaryMachineDeploymentApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
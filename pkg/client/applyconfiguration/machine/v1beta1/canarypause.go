@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CanaryPauseApplyConfiguration represents a declarative configuration of the CanaryPause type for use
+// with apply.
+type CanaryPauseApplyConfiguration struct {
+	Duration *v1.Duration `json:"duration,omitempty"`
+}
+
+// CanaryPauseApplyConfiguration constructs a declarative configuration of the CanaryPause type for use with
+// apply.
+func CanaryPause() *CanaryPauseApplyConfiguration {
+	return &CanaryPauseApplyConfiguration{}
+}
+
+// WithDuration sets the Duration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Duration field is set to the value of the last call.
+func (b *CanaryPauseApplyConfiguration) WithDuration(value v1.Duration) *CanaryPauseApplyConfiguration {
+	b.Duration = &value
+	return b
+}
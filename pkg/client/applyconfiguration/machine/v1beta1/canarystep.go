@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// CanaryStepApplyConfiguration represents a declarative configuration of the CanaryStep type for use
+// with apply.
+type CanaryStepApplyConfiguration struct {
+	Replicas *intstr.IntOrString            `json:"replicas,omitempty"`
+	Pause    *CanaryPauseApplyConfiguration `json:"pause,omitempty"`
+}
+
+// CanaryStepApplyConfiguration constructs a declarative configuration of the CanaryStep type for use with
+// apply.
+func CanaryStep() *CanaryStepApplyConfiguration {
+	return &CanaryStepApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *CanaryStepApplyConfiguration) WithReplicas(value intstr.IntOrString) *CanaryStepApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithPause sets the Pause field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pause field is set to the value of the last call.
+func (b *CanaryStepApplyConfiguration) WithPause(value *CanaryPauseApplyConfiguration) *CanaryStepApplyConfiguration {
+	b.Pause = value
+	return b
+}
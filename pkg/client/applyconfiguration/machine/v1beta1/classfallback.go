@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClassFallbackApplyConfiguration represents a declarative configuration of the ClassFallback type for use
+// with apply.
+type ClassFallbackApplyConfiguration struct {
+	Classes                   []ClassSpecApplyConfiguration `json:"classes,omitempty"`
+	CapacityErrorThreshold    *int32                        `json:"capacityErrorThreshold,omitempty"`
+	PreferredClassRetryPeriod *v1.Duration                  `json:"preferredClassRetryPeriod,omitempty"`
+}
+
+// ClassFallbackApplyConfiguration constructs a declarative configuration of the ClassFallback type for use with
+// apply.
+func ClassFallback() *ClassFallbackApplyConfiguration {
+	return &ClassFallbackApplyConfiguration{}
+}
+
+// WithClasses adds the given value to the Classes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Classes field.
+func (b *ClassFallbackApplyConfiguration) WithClasses(values ...*ClassSpecApplyConfiguration) *ClassFallbackApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClasses")
+		}
+		b.Classes = append(b.Classes, *values[i])
+	}
+	return b
+}
+
+// WithCapacityErrorThreshold sets the CapacityErrorThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CapacityErrorThreshold field is set to the value of the last call.
+func (b *ClassFallbackApplyConfiguration) WithCapacityErrorThreshold(value int32) *ClassFallbackApplyConfiguration {
+	b.CapacityErrorThreshold = &value
+	return b
+}
+
+// WithPreferredClassRetryPeriod sets the PreferredClassRetryPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreferredClassRetryPeriod field is set to the value of the last call.
+func (b *ClassFallbackApplyConfiguration) WithPreferredClassRetryPeriod(value v1.Duration) *ClassFallbackApplyConfiguration {
+	b.PreferredClassRetryPeriod = &value
+	return b
+}
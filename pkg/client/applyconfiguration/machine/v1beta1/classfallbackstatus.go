@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClassFallbackStatusApplyConfiguration represents a declarative configuration of the ClassFallbackStatus type for use
+// with apply.
+type ClassFallbackStatusApplyConfiguration struct {
+	Class              *ClassSpecApplyConfiguration `json:"class,omitempty"`
+	LastTransitionTime *v1.Time                     `json:"lastTransitionTime,omitempty"`
+}
+
+// ClassFallbackStatusApplyConfiguration constructs a declarative configuration of the ClassFallbackStatus type for use with
+// apply.
+func ClassFallbackStatus() *ClassFallbackStatusApplyConfiguration {
+	return &ClassFallbackStatusApplyConfiguration{}
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *ClassFallbackStatusApplyConfiguration) WithClass(value *ClassSpecApplyConfiguration) *ClassFallbackStatusApplyConfiguration {
+	b.Class = value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *ClassFallbackStatusApplyConfiguration) WithLastTransitionTime(value v1.Time) *ClassFallbackStatusApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// MachineClassProviderApplyConfiguration represents a declarative configuration of the MachineClassProvider type for use
+// with apply.
+type MachineClassProviderApplyConfiguration struct {
+	Name *string               `json:"name,omitempty"`
+	Spec *runtime.RawExtension `json:"spec,omitempty"`
+}
+
+// MachineClassProviderApplyConfiguration constructs a declarative configuration of the MachineClassProvider type for use with
+// apply.
+func MachineClassProvider() *MachineClassProviderApplyConfiguration {
+	return &MachineClassProviderApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MachineClassProviderApplyConfiguration) WithName(value string) *MachineClassProviderApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *MachineClassProviderApplyConfiguration) WithSpec(value runtime.RawExtension) *MachineClassProviderApplyConfiguration {
+	b.Spec = &value
+	return b
+}
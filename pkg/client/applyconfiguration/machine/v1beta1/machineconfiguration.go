@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineConfigurationApplyConfiguration represents a declarative configuration of the MachineConfiguration type for use
+// with apply.
+type MachineConfigurationApplyConfiguration struct {
+	MachineDrainTimeout    *v1.Duration `json:"drainTimeout,omitempty"`
+	MachineHealthTimeout   *v1.Duration `json:"healthTimeout,omitempty"`
+	MachineCreationTimeout *v1.Duration `json:"creationTimeout,omitempty"`
+	MaxEvictRetries        *int32       `json:"maxEvictRetries,omitempty"`
+	NodeConditions         *string      `json:"nodeConditions,omitempty"`
+}
+
+// MachineConfigurationApplyConfiguration constructs a declarative configuration of the MachineConfiguration type for use with
+// apply.
+func MachineConfiguration() *MachineConfigurationApplyConfiguration {
+	return &MachineConfigurationApplyConfiguration{}
+}
+
+// WithMachineDrainTimeout sets the MachineDrainTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineDrainTimeout field is set to the value of the last call.
+func (b *MachineConfigurationApplyConfiguration) WithMachineDrainTimeout(value v1.Duration) *MachineConfigurationApplyConfiguration {
+	b.MachineDrainTimeout = &value
+	return b
+}
+
+// WithMachineHealthTimeout sets the MachineHealthTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineHealthTimeout field is set to the value of the last call.
+func (b *MachineConfigurationApplyConfiguration) WithMachineHealthTimeout(value v1.Duration) *MachineConfigurationApplyConfiguration {
+	b.MachineHealthTimeout = &value
+	return b
+}
+
+// WithMachineCreationTimeout sets the MachineCreationTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineCreationTimeout field is set to the value of the last call.
+func (b *MachineConfigurationApplyConfiguration) WithMachineCreationTimeout(value v1.Duration) *MachineConfigurationApplyConfiguration {
+	b.MachineCreationTimeout = &value
+	return b
+}
+
+// WithMaxEvictRetries sets the MaxEvictRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxEvictRetries field is set to the value of the last call.
+func (b *MachineConfigurationApplyConfiguration) WithMaxEvictRetries(value int32) *MachineConfigurationApplyConfiguration {
+	b.MaxEvictRetries = &value
+	return b
+}
+
+// WithNodeConditions sets the NodeConditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeConditions field is set to the value of the last call.
+func (b *MachineConfigurationApplyConfiguration) WithNodeConditions(value string) *MachineConfigurationApplyConfiguration {
+	b.NodeConditions = &value
+	return b
+}
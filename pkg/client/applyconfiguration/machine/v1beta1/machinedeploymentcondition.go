@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineDeploymentConditionApplyConfiguration represents a declarative configuration of the MachineDeploymentCondition type for use
+// with apply.
+type MachineDeploymentConditionApplyConfiguration struct {
+	Type               *v1beta1.MachineDeploymentConditionType `json:"type,omitempty"`
+	Status             *v1beta1.ConditionStatus                `json:"status,omitempty"`
+	LastUpdateTime     *v1.Time                                `json:"lastUpdateTime,omitempty"`
+	LastTransitionTime *v1.Time                                `json:"lastTransitionTime,omitempty"`
+	Reason             *string                                 `json:"reason,omitempty"`
+	Message            *string                                 `json:"message,omitempty"`
+}
+
+// MachineDeploymentConditionApplyConfiguration constructs a declarative configuration of the MachineDeploymentCondition type for use with
+// apply.
+func MachineDeploymentCondition() *MachineDeploymentConditionApplyConfiguration {
+	return &MachineDeploymentConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithType(value v1beta1.MachineDeploymentConditionType) *MachineDeploymentConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithStatus(value v1beta1.ConditionStatus) *MachineDeploymentConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithLastUpdateTime(value v1.Time) *MachineDeploymentConditionApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithLastTransitionTime(value v1.Time) *MachineDeploymentConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithReason(value string) *MachineDeploymentConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *MachineDeploymentConditionApplyConfiguration) WithMessage(value string) *MachineDeploymentConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineDeploymentMaintenanceWindowApplyConfiguration represents a declarative configuration of the MachineDeploymentMaintenanceWindow type for use
+// with apply.
+type MachineDeploymentMaintenanceWindowApplyConfiguration struct {
+	Windows  []MaintenanceWindowApplyConfiguration `json:"windows,omitempty"`
+	TimeZone *string                               `json:"timeZone,omitempty"`
+}
+
+// MachineDeploymentMaintenanceWindowApplyConfiguration constructs a declarative configuration of the MachineDeploymentMaintenanceWindow type for use with
+// apply.
+func MachineDeploymentMaintenanceWindow() *MachineDeploymentMaintenanceWindowApplyConfiguration {
+	return &MachineDeploymentMaintenanceWindowApplyConfiguration{}
+}
+
+// WithWindows adds the given value to the Windows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Windows field.
+func (b *MachineDeploymentMaintenanceWindowApplyConfiguration) WithWindows(values ...*MaintenanceWindowApplyConfiguration) *MachineDeploymentMaintenanceWindowApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWindows")
+		}
+		b.Windows = append(b.Windows, *values[i])
+	}
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *MachineDeploymentMaintenanceWindowApplyConfiguration) WithTimeZone(value string) *MachineDeploymentMaintenanceWindowApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
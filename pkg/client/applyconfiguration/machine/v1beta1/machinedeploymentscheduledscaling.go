@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineDeploymentScheduledScalingApplyConfiguration represents a declarative configuration of the MachineDeploymentScheduledScaling type for use
+// with apply.
+type MachineDeploymentScheduledScalingApplyConfiguration struct {
+	Schedules []ScalingScheduleApplyConfiguration `json:"schedules,omitempty"`
+	TimeZone  *string                             `json:"timeZone,omitempty"`
+}
+
+// MachineDeploymentScheduledScalingApplyConfiguration constructs a declarative configuration of the MachineDeploymentScheduledScaling type for use with
+// apply.
+func MachineDeploymentScheduledScaling() *MachineDeploymentScheduledScalingApplyConfiguration {
+	return &MachineDeploymentScheduledScalingApplyConfiguration{}
+}
+
+// WithSchedules adds the given value to the Schedules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Schedules field.
+func (b *MachineDeploymentScheduledScalingApplyConfiguration) WithSchedules(values ...*ScalingScheduleApplyConfiguration) *MachineDeploymentScheduledScalingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSchedules")
+		}
+		b.Schedules = append(b.Schedules, *values[i])
+	}
+	return b
+}
+
+// WithTimeZone sets the TimeZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeZone field is set to the value of the last call.
+func (b *MachineDeploymentScheduledScalingApplyConfiguration) WithTimeZone(value string) *MachineDeploymentScheduledScalingApplyConfiguration {
+	b.TimeZone = &value
+	return b
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+)
+
+// MachineDeploymentStatusApplyConfiguration represents a declarative configuration of the MachineDeploymentStatus type for use
+// with apply.
+type MachineDeploymentStatusApplyConfiguration struct {
+	ObservedGeneration  *int64                                          `json:"observedGeneration,omitempty"`
+	Replicas            *int32                                          `json:"replicas,omitempty"`
+	UpdatedReplicas     *int32                                          `json:"updatedReplicas,omitempty"`
+	ReadyReplicas       *int32                                          `json:"readyReplicas,omitempty"`
+	AvailableReplicas   *int32                                          `json:"availableReplicas,omitempty"`
+	UnavailableReplicas *int32                                          `json:"unavailableReplicas,omitempty"`
+	Conditions          []MachineDeploymentConditionApplyConfiguration  `json:"conditions,omitempty"`
+	CollisionCount      *int32                                          `json:"collisionCount,omitempty"`
+	FailedMachines      []*machinev1beta1.MachineSummary                `json:"failedMachines,omitempty"`
+	Canary              *CanaryStatusApplyConfiguration                 `json:"canary,omitempty"`
+	BlueGreen           *BlueGreenStatusApplyConfiguration              `json:"blueGreen,omitempty"`
+	Zones               []MachineDeploymentZoneStatusApplyConfiguration `json:"zones,omitempty"`
+	StandbyReplicas     *int32                                          `json:"standbyReplicas,omitempty"`
+	ScheduledScaling    []ScalingScheduleStatusApplyConfiguration       `json:"scheduledScaling,omitempty"`
+}
+
+// MachineDeploymentStatusApplyConfiguration constructs a declarative configuration of the MachineDeploymentStatus type for use with
+// apply.
+func MachineDeploymentStatus() *MachineDeploymentStatusApplyConfiguration {
+	return &MachineDeploymentStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineDeploymentStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithUpdatedReplicas sets the UpdatedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithUpdatedReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.UpdatedReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithReadyReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithAvailableReplicas sets the AvailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithAvailableReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.AvailableReplicas = &value
+	return b
+}
+
+// WithUnavailableReplicas sets the UnavailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnavailableReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithUnavailableReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.UnavailableReplicas = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MachineDeploymentStatusApplyConfiguration) WithConditions(values ...*MachineDeploymentConditionApplyConfiguration) *MachineDeploymentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithCollisionCount sets the CollisionCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CollisionCount field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithCollisionCount(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.CollisionCount = &value
+	return b
+}
+
+// WithFailedMachines adds the given value to the FailedMachines field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FailedMachines field.
+func (b *MachineDeploymentStatusApplyConfiguration) WithFailedMachines(values ...**machinev1beta1.MachineSummary) *MachineDeploymentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFailedMachines")
+		}
+		b.FailedMachines = append(b.FailedMachines, *values[i])
+	}
+	return b
+}
+
+// WithCanary sets the Canary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Canary field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithCanary(value *CanaryStatusApplyConfiguration) *MachineDeploymentStatusApplyConfiguration {
+	b.Canary = value
+	return b
+}
+
+// WithBlueGreen sets the BlueGreen field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BlueGreen field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithBlueGreen(value *BlueGreenStatusApplyConfiguration) *MachineDeploymentStatusApplyConfiguration {
+	b.BlueGreen = value
+	return b
+}
+
+// WithZones adds the given value to the Zones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Zones field.
+func (b *MachineDeploymentStatusApplyConfiguration) WithZones(values ...*MachineDeploymentZoneStatusApplyConfiguration) *MachineDeploymentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithZones")
+		}
+		b.Zones = append(b.Zones, *values[i])
+	}
+	return b
+}
+
+// WithStandbyReplicas sets the StandbyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StandbyReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithStandbyReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.StandbyReplicas = &value
+	return b
+}
+
+// WithScheduledScaling adds the given value to the ScheduledScaling field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ScheduledScaling field.
+func (b *MachineDeploymentStatusApplyConfiguration) WithScheduledScaling(values ...*ScalingScheduleStatusApplyConfiguration) *MachineDeploymentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithScheduledScaling")
+		}
+		b.ScheduledScaling = append(b.ScheduledScaling, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineDeploymentZoneApplyConfiguration represents a declarative configuration of the MachineDeploymentZone type for use
+// with apply.
+type MachineDeploymentZoneApplyConfiguration struct {
+	Name   *string                      `json:"name,omitempty"`
+	Class  *ClassSpecApplyConfiguration `json:"class,omitempty"`
+	Weight *int32                       `json:"weight,omitempty"`
+}
+
+// MachineDeploymentZoneApplyConfiguration constructs a declarative configuration of the MachineDeploymentZone type for use with
+// apply.
+func MachineDeploymentZone() *MachineDeploymentZoneApplyConfiguration {
+	return &MachineDeploymentZoneApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MachineDeploymentZoneApplyConfiguration) WithName(value string) *MachineDeploymentZoneApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *MachineDeploymentZoneApplyConfiguration) WithClass(value *ClassSpecApplyConfiguration) *MachineDeploymentZoneApplyConfiguration {
+	b.Class = value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *MachineDeploymentZoneApplyConfiguration) WithWeight(value int32) *MachineDeploymentZoneApplyConfiguration {
+	b.Weight = &value
+	return b
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineDeploymentZoneStatusApplyConfiguration represents a declarative configuration of the MachineDeploymentZoneStatus type for use
+// with apply.
+type MachineDeploymentZoneStatusApplyConfiguration struct {
+	Name              *string `json:"name,omitempty"`
+	MachineDeployment *string `json:"machineDeployment,omitempty"`
+	Replicas          *int32  `json:"replicas,omitempty"`
+	UpdatedReplicas   *int32  `json:"updatedReplicas,omitempty"`
+	ReadyReplicas     *int32  `json:"readyReplicas,omitempty"`
+	AvailableReplicas *int32  `json:"availableReplicas,omitempty"`
+}
+
+// MachineDeploymentZoneStatusApplyConfiguration constructs a declarative configuration of the MachineDeploymentZoneStatus type for use with
+// apply.
+func MachineDeploymentZoneStatus() *MachineDeploymentZoneStatusApplyConfiguration {
+	return &MachineDeploymentZoneStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithName(value string) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMachineDeployment sets the MachineDeployment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineDeployment field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithMachineDeployment(value string) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.MachineDeployment = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithReplicas(value int32) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithUpdatedReplicas sets the UpdatedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedReplicas field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithUpdatedReplicas(value int32) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.UpdatedReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithReadyReplicas(value int32) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithAvailableReplicas sets the AvailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableReplicas field is set to the value of the last call.
+func (b *MachineDeploymentZoneStatusApplyConfiguration) WithAvailableReplicas(value int32) *MachineDeploymentZoneStatusApplyConfiguration {
+	b.AvailableReplicas = &value
+	return b
+}
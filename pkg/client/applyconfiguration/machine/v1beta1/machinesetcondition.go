@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineSetConditionApplyConfiguration represents a declarative configuration of the MachineSetCondition type for use
+// with apply.
+type MachineSetConditionApplyConfiguration struct {
+	Type               *v1beta1.MachineSetConditionType `json:"type,omitempty"`
+	Status             *v1beta1.ConditionStatus         `json:"status,omitempty"`
+	LastTransitionTime *v1.Time                         `json:"lastTransitionTime,omitempty"`
+	Reason             *string                          `json:"reason,omitempty"`
+	Message            *string                          `json:"message,omitempty"`
+}
+
+// MachineSetConditionApplyConfiguration constructs a declarative configuration of the MachineSetCondition type for use with
+// apply.
+func MachineSetCondition() *MachineSetConditionApplyConfiguration {
+	return &MachineSetConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MachineSetConditionApplyConfiguration) WithType(value v1beta1.MachineSetConditionType) *MachineSetConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *MachineSetConditionApplyConfiguration) WithStatus(value v1beta1.ConditionStatus) *MachineSetConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *MachineSetConditionApplyConfiguration) WithLastTransitionTime(value v1.Time) *MachineSetConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *MachineSetConditionApplyConfiguration) WithReason(value string) *MachineSetConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *MachineSetConditionApplyConfiguration) WithMessage(value string) *MachineSetConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineSetStatusApplyConfiguration represents a declarative configuration of the MachineSetStatus type for use
+// with apply.
+type MachineSetStatusApplyConfiguration struct {
+	Replicas             *int32                                  `json:"replicas,omitempty"`
+	FullyLabeledReplicas *int32                                  `json:"fullyLabeledReplicas,omitempty"`
+	ReadyReplicas        *int32                                  `json:"readyReplicas,omitempty"`
+	AvailableReplicas    *int32                                  `json:"availableReplicas,omitempty"`
+	ObservedGeneration   *int64                                  `json:"observedGeneration,omitempty"`
+	Conditions           []MachineSetConditionApplyConfiguration `json:"machineSetCondition,omitempty"`
+	LastOperation        *LastOperationApplyConfiguration        `json:"lastOperation,omitempty"`
+	FailedMachines       *[]MachineSummaryApplyConfiguration     `json:"failedMachines,omitempty"`
+	ClassFallback        *ClassFallbackStatusApplyConfiguration  `json:"classFallback,omitempty"`
+	StandbyReplicas      *int32                                  `json:"standbyReplicas,omitempty"`
+}
+
+// MachineSetStatusApplyConfiguration constructs a declarative configuration of the MachineSetStatus type for use with
+// apply.
+func MachineSetStatus() *MachineSetStatusApplyConfiguration {
+	return &MachineSetStatusApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithFullyLabeledReplicas sets the FullyLabeledReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FullyLabeledReplicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithFullyLabeledReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.FullyLabeledReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithReadyReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithAvailableReplicas sets the AvailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailableReplicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithAvailableReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.AvailableReplicas = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineSetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MachineSetStatusApplyConfiguration) WithConditions(values ...*MachineSetConditionApplyConfiguration) *MachineSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithLastOperation sets the LastOperation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastOperation field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithLastOperation(value *LastOperationApplyConfiguration) *MachineSetStatusApplyConfiguration {
+	b.LastOperation = value
+	return b
+}
+
+func (b *MachineSetStatusApplyConfiguration) ensureMachineSummaryApplyConfigurationExists() {
+	if b.FailedMachines == nil {
+		b.FailedMachines = &[]MachineSummaryApplyConfiguration{}
+	}
+}
+
+// WithFailedMachines adds the given value to the FailedMachines field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FailedMachines field.
+func (b *MachineSetStatusApplyConfiguration) WithFailedMachines(values ...*MachineSummaryApplyConfiguration) *MachineSetStatusApplyConfiguration {
+	b.ensureMachineSummaryApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFailedMachines")
+		}
+		*b.FailedMachines = append(*b.FailedMachines, *values[i])
+	}
+	return b
+}
+
+// WithClassFallback sets the ClassFallback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClassFallback field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithClassFallback(value *ClassFallbackStatusApplyConfiguration) *MachineSetStatusApplyConfiguration {
+	b.ClassFallback = value
+	return b
+}
+
+// WithStandbyReplicas sets the StandbyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StandbyReplicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithStandbyReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.StandbyReplicas = &value
+	return b
+}
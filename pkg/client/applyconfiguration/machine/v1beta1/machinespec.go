@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineSpecApplyConfiguration represents a declarative configuration of the MachineSpec type for use
+// with apply.
+type MachineSpecApplyConfiguration struct {
+	Class                                  *ClassSpecApplyConfiguration        `json:"class,omitempty"`
+	ProviderID                             *string                             `json:"providerID,omitempty"`
+	NodeTemplateSpec                       *NodeTemplateSpecApplyConfiguration `json:"nodeTemplate,omitempty"`
+	MachineConfigurationApplyConfiguration `json:",inline"`
+	DeletionPriority                       *int32 `json:"deletionPriority,omitempty"`
+}
+
+// MachineSpecApplyConfiguration constructs a declarative configuration of the MachineSpec type for use with
+// apply.
+func MachineSpec() *MachineSpecApplyConfiguration {
+	return &MachineSpecApplyConfiguration{}
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithClass(value *ClassSpecApplyConfiguration) *MachineSpecApplyConfiguration {
+	b.Class = value
+	return b
+}
+
+// WithProviderID sets the ProviderID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderID field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithProviderID(value string) *MachineSpecApplyConfiguration {
+	b.ProviderID = &value
+	return b
+}
+
+// WithNodeTemplateSpec sets the NodeTemplateSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeTemplateSpec field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithNodeTemplateSpec(value *NodeTemplateSpecApplyConfiguration) *MachineSpecApplyConfiguration {
+	b.NodeTemplateSpec = value
+	return b
+}
+
+// WithDeletionPriority sets the DeletionPriority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPriority field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithDeletionPriority(value int32) *MachineSpecApplyConfiguration {
+	b.DeletionPriority = &value
+	return b
+}
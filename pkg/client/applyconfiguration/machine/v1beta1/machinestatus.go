@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// MachineStatusApplyConfiguration represents a declarative configuration of the MachineStatus type for use
+// with apply.
+type MachineStatusApplyConfiguration struct {
+	Conditions     []v1.NodeConditionApplyConfiguration `json:"conditions,omitempty"`
+	LastOperation  *LastOperationApplyConfiguration     `json:"lastOperation,omitempty"`
+	CurrentStatus  *CurrentStatusApplyConfiguration     `json:"currentStatus,omitempty"`
+	LastKnownState *string                              `json:"lastKnownState,omitempty"`
+}
+
+// MachineStatusApplyConfiguration constructs a declarative configuration of the MachineStatus type for use with
+// apply.
+func MachineStatus() *MachineStatusApplyConfiguration {
+	return &MachineStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MachineStatusApplyConfiguration) WithConditions(values ...*v1.NodeConditionApplyConfiguration) *MachineStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithLastOperation sets the LastOperation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastOperation field is set to the value of the last call.
+func (b *MachineStatusApplyConfiguration) WithLastOperation(value *LastOperationApplyConfiguration) *MachineStatusApplyConfiguration {
+	b.LastOperation = value
+	return b
+}
+
+// WithCurrentStatus sets the CurrentStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentStatus field is set to the value of the last call.
+func (b *MachineStatusApplyConfiguration) WithCurrentStatus(value *CurrentStatusApplyConfiguration) *MachineStatusApplyConfiguration {
+	b.CurrentStatus = value
+	return b
+}
+
+// WithLastKnownState sets the LastKnownState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastKnownState field is set to the value of the last call.
+func (b *MachineStatusApplyConfiguration) WithLastKnownState(value string) *MachineStatusApplyConfiguration {
+	b.LastKnownState = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineSummaryApplyConfiguration represents a declarative configuration of the MachineSummary type for use
+// with apply.
+type MachineSummaryApplyConfiguration struct {
+	Name          *string                          `json:"name,omitempty"`
+	ProviderID    *string                          `json:"providerID,omitempty"`
+	LastOperation *LastOperationApplyConfiguration `json:"lastOperation,omitempty"`
+	OwnerRef      *string                          `json:"ownerRef,omitempty"`
+}
+
+// MachineSummaryApplyConfiguration constructs a declarative configuration of the MachineSummary type for use with
+// apply.
+func MachineSummary() *MachineSummaryApplyConfiguration {
+	return &MachineSummaryApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MachineSummaryApplyConfiguration) WithName(value string) *MachineSummaryApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithProviderID sets the ProviderID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderID field is set to the value of the last call.
+func (b *MachineSummaryApplyConfiguration) WithProviderID(value string) *MachineSummaryApplyConfiguration {
+	b.ProviderID = &value
+	return b
+}
+
+// WithLastOperation sets the LastOperation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastOperation field is set to the value of the last call.
+func (b *MachineSummaryApplyConfiguration) WithLastOperation(value *LastOperationApplyConfiguration) *MachineSummaryApplyConfiguration {
+	b.LastOperation = value
+	return b
+}
+
+// WithOwnerRef sets the OwnerRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OwnerRef field is set to the value of the last call.
+func (b *MachineSummaryApplyConfiguration) WithOwnerRef(value string) *MachineSummaryApplyConfiguration {
+	b.OwnerRef = &value
+	return b
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineWarmPoolApplyConfiguration represents a declarative configuration of the MachineWarmPool type for use
+// with apply.
+type MachineWarmPoolApplyConfiguration struct {
+	Size *int32 `json:"size,omitempty"`
+}
+
+// MachineWarmPoolApplyConfiguration constructs a declarative configuration of the MachineWarmPool type for use with
+// apply.
+func MachineWarmPool() *MachineWarmPoolApplyConfiguration {
+	return &MachineWarmPoolApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *MachineWarmPoolApplyConfiguration) WithSize(value int32) *MachineWarmPoolApplyConfiguration {
+	b.Size = &value
+	return b
+}
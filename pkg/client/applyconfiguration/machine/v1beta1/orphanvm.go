@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// OrphanVMApplyConfiguration represents a declarative configuration of the OrphanVM type for use
+// with apply.
+type OrphanVMApplyConfiguration struct {
+	ProviderID  *string `json:"providerID,omitempty"`
+	MachineName *string `json:"machineName,omitempty"`
+}
+
+// OrphanVMApplyConfiguration constructs a declarative configuration of the OrphanVM type for use with
+// apply.
+func OrphanVM() *OrphanVMApplyConfiguration {
+	return &OrphanVMApplyConfiguration{}
+}
+
+// WithProviderID sets the ProviderID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderID field is set to the value of the last call.
+func (b *OrphanVMApplyConfiguration) WithProviderID(value string) *OrphanVMApplyConfiguration {
+	b.ProviderID = &value
+	return b
+}
+
+// WithMachineName sets the MachineName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineName field is set to the value of the last call.
+func (b *OrphanVMApplyConfiguration) WithMachineName(value string) *OrphanVMApplyConfiguration {
+	b.MachineName = &value
+	return b
+}
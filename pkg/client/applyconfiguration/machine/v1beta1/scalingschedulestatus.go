@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalingScheduleStatusApplyConfiguration represents a declarative configuration of the ScalingScheduleStatus type for use
+// with apply.
+type ScalingScheduleStatusApplyConfiguration struct {
+	Name             *string  `json:"name,omitempty"`
+	LastScheduleTime *v1.Time `json:"lastScheduleTime,omitempty"`
+	NextScheduleTime *v1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// ScalingScheduleStatusApplyConfiguration constructs a declarative configuration of the ScalingScheduleStatus type for use with
+// apply.
+func ScalingScheduleStatus() *ScalingScheduleStatusApplyConfiguration {
+	return &ScalingScheduleStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ScalingScheduleStatusApplyConfiguration) WithName(value string) *ScalingScheduleStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *ScalingScheduleStatusApplyConfiguration) WithLastScheduleTime(value v1.Time) *ScalingScheduleStatusApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
+
+// WithNextScheduleTime sets the NextScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextScheduleTime field is set to the value of the last call.
+func (b *ScalingScheduleStatusApplyConfiguration) WithNextScheduleTime(value v1.Time) *ScalingScheduleStatusApplyConfiguration {
+	b.NextScheduleTime = &value
+	return b
+}
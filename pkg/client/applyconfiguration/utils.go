@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	internal "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/internal"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1beta1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=machine.sapcloud.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AutoRollbackPolicy"):
+		return &machinev1alpha1.AutoRollbackPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BlueGreenMachineDeployment"):
+		return &machinev1alpha1.BlueGreenMachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BlueGreenStatus"):
+		return &machinev1alpha1.BlueGreenStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CanaryMachineDeployment"):
+		return &machinev1alpha1.CanaryMachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CanaryPause"):
+		return &machinev1alpha1.CanaryPauseApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CanaryStatus"):
+		return &machinev1alpha1.CanaryStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CanaryStep"):
+		return &machinev1alpha1.CanaryStepApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClassFallback"):
+		return &machinev1alpha1.ClassFallbackApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClassFallbackStatus"):
+		return &machinev1alpha1.ClassFallbackStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClassSpec"):
+		return &machinev1alpha1.ClassSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CurrentStatus"):
+		return &machinev1alpha1.CurrentStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LastOperation"):
+		return &machinev1alpha1.LastOperationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Machine"):
+		return &machinev1alpha1.MachineApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineClass"):
+		return &machinev1alpha1.MachineClassApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineClassStatus"):
+		return &machinev1alpha1.MachineClassStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineClassValidation"):
+		return &machinev1alpha1.MachineClassValidationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineConfiguration"):
+		return &machinev1alpha1.MachineConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeployment"):
+		return &machinev1alpha1.MachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentCondition"):
+		return &machinev1alpha1.MachineDeploymentConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentMaintenanceWindow"):
+		return &machinev1alpha1.MachineDeploymentMaintenanceWindowApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentScheduledScaling"):
+		return &machinev1alpha1.MachineDeploymentScheduledScalingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentSpec"):
+		return &machinev1alpha1.MachineDeploymentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentStatus"):
+		return &machinev1alpha1.MachineDeploymentStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentStrategy"):
+		return &machinev1alpha1.MachineDeploymentStrategyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentZone"):
+		return &machinev1alpha1.MachineDeploymentZoneApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentZoneSpread"):
+		return &machinev1alpha1.MachineDeploymentZoneSpreadApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentZoneStatus"):
+		return &machinev1alpha1.MachineDeploymentZoneStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSet"):
+		return &machinev1alpha1.MachineSetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSetCondition"):
+		return &machinev1alpha1.MachineSetConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSetSpec"):
+		return &machinev1alpha1.MachineSetSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSetStatus"):
+		return &machinev1alpha1.MachineSetStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSpec"):
+		return &machinev1alpha1.MachineSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineStatus"):
+		return &machinev1alpha1.MachineStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSummary"):
+		return &machinev1alpha1.MachineSummaryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineTemplateSpec"):
+		return &machinev1alpha1.MachineTemplateSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineWarmPool"):
+		return &machinev1alpha1.MachineWarmPoolApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &machinev1alpha1.MaintenanceWindowApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeTemplate"):
+		return &machinev1alpha1.NodeTemplateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NodeTemplateSpec"):
+		return &machinev1alpha1.NodeTemplateSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OrphanVM"):
+		return &machinev1alpha1.OrphanVMApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RollbackConfig"):
+		return &machinev1alpha1.RollbackConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RollingUpdateMachineDeployment"):
+		return &machinev1alpha1.RollingUpdateMachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ScalingSchedule"):
+		return &machinev1alpha1.ScalingScheduleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ScalingScheduleStatus"):
+		return &machinev1alpha1.ScalingScheduleStatusApplyConfiguration{}
+
+		// Group=machine.sapcloud.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AutoRollbackPolicy"):
+		return &machinev1beta1.AutoRollbackPolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BlueGreenMachineDeployment"):
+		return &machinev1beta1.BlueGreenMachineDeploymentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BlueGreenStatus"):
+		return &machinev1beta1.BlueGreenStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CanaryMachineDeployment"):
+		return &machinev1beta1.CanaryMachineDeploymentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CanaryPause"):
+		return &machinev1beta1.CanaryPauseApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CanaryStatus"):
+		return &machinev1beta1.CanaryStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CanaryStep"):
+		return &machinev1beta1.CanaryStepApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClassFallback"):
+		return &machinev1beta1.ClassFallbackApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClassFallbackStatus"):
+		return &machinev1beta1.ClassFallbackStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClassSpec"):
+		return &machinev1beta1.ClassSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CurrentStatus"):
+		return &machinev1beta1.CurrentStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LastOperation"):
+		return &machinev1beta1.LastOperationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Machine"):
+		return &machinev1beta1.MachineApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineClass"):
+		return &machinev1beta1.MachineClassApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineClassProvider"):
+		return &machinev1beta1.MachineClassProviderApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineClassStatus"):
+		return &machinev1beta1.MachineClassStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineClassValidation"):
+		return &machinev1beta1.MachineClassValidationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineConfiguration"):
+		return &machinev1beta1.MachineConfigurationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeployment"):
+		return &machinev1beta1.MachineDeploymentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentCondition"):
+		return &machinev1beta1.MachineDeploymentConditionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentMaintenanceWindow"):
+		return &machinev1beta1.MachineDeploymentMaintenanceWindowApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentScheduledScaling"):
+		return &machinev1beta1.MachineDeploymentScheduledScalingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentSpec"):
+		return &machinev1beta1.MachineDeploymentSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentStatus"):
+		return &machinev1beta1.MachineDeploymentStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentStrategy"):
+		return &machinev1beta1.MachineDeploymentStrategyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentZone"):
+		return &machinev1beta1.MachineDeploymentZoneApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentZoneSpread"):
+		return &machinev1beta1.MachineDeploymentZoneSpreadApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineDeploymentZoneStatus"):
+		return &machinev1beta1.MachineDeploymentZoneStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSet"):
+		return &machinev1beta1.MachineSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSetCondition"):
+		return &machinev1beta1.MachineSetConditionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSetSpec"):
+		return &machinev1beta1.MachineSetSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSetStatus"):
+		return &machinev1beta1.MachineSetStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSpec"):
+		return &machinev1beta1.MachineSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineStatus"):
+		return &machinev1beta1.MachineStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineSummary"):
+		return &machinev1beta1.MachineSummaryApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineTemplateSpec"):
+		return &machinev1beta1.MachineTemplateSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MachineWarmPool"):
+		return &machinev1beta1.MachineWarmPoolApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &machinev1beta1.MaintenanceWindowApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeTemplate"):
+		return &machinev1beta1.NodeTemplateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeTemplateSpec"):
+		return &machinev1beta1.NodeTemplateSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("OrphanVM"):
+		return &machinev1beta1.OrphanVMApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RollbackConfig"):
+		return &machinev1beta1.RollbackConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RollingUpdateMachineDeployment"):
+		return &machinev1beta1.RollingUpdateMachineDeploymentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScalingSchedule"):
+		return &machinev1beta1.ScalingScheduleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScalingScheduleStatus"):
+		return &machinev1beta1.ScalingScheduleStatusApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) *testing.TypeConverter {
+	return &testing.TypeConverter{Scheme: scheme, TypeResolver: internal.Parser()}
+}
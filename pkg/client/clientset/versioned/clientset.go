@@ -11,6 +11,7 @@ import (
 	"net/http"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1alpha1"
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -19,12 +20,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	MachineV1alpha1() machinev1alpha1.MachineV1alpha1Interface
+	MachineV1beta1() machinev1beta1.MachineV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	machineV1alpha1 *machinev1alpha1.MachineV1alpha1Client
+	machineV1beta1  *machinev1beta1.MachineV1beta1Client
 }
 
 // MachineV1alpha1 retrieves the MachineV1alpha1Client
@@ -32,6 +35,11 @@ func (c *Clientset) MachineV1alpha1() machinev1alpha1.MachineV1alpha1Interface {
 	return c.machineV1alpha1
 }
 
+// MachineV1beta1 retrieves the MachineV1beta1Client
+func (c *Clientset) MachineV1beta1() machinev1beta1.MachineV1beta1Interface {
+	return c.machineV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -80,6 +88,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.machineV1beta1, err = machinev1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -102,6 +114,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.machineV1alpha1 = machinev1alpha1.New(c)
+	cs.machineV1beta1 = machinev1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
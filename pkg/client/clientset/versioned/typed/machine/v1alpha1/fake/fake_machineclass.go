@@ -8,8 +8,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
@@ -93,6 +96,19 @@ func (c *FakeMachineClasses) Update(ctx context.Context, machineClass *v1alpha1.
 	return obj.(*v1alpha1.MachineClass), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMachineClasses) UpdateStatus(ctx context.Context, machineClass *v1alpha1.MachineClass, opts v1.UpdateOptions) (result *v1alpha1.MachineClass, err error) {
+	emptyResult := &v1alpha1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(machineclassesResource, "status", c.ns, machineClass, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineClass), err
+}
+
 // Delete takes name of the machineClass and deletes it. Returns an error if one occurs.
 func (c *FakeMachineClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -120,3 +136,50 @@ func (c *FakeMachineClasses) Patch(ctx context.Context, name string, pt types.Pa
 	}
 	return obj.(*v1alpha1.MachineClass), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied machineClass.
+func (c *FakeMachineClasses) Apply(ctx context.Context, machineClass *machinev1alpha1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineClass, err error) {
+	if machineClass == nil {
+		return nil, fmt.Errorf("machineClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineClass)
+	if err != nil {
+		return nil, err
+	}
+	name := machineClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineClass.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machineclassesResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineClass), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMachineClasses) ApplyStatus(ctx context.Context, machineClass *machinev1alpha1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineClass, err error) {
+	if machineClass == nil {
+		return nil, fmt.Errorf("machineClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineClass)
+	if err != nil {
+		return nil, err
+	}
+	name := machineClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineClass.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machineclassesResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineClass), err
+}
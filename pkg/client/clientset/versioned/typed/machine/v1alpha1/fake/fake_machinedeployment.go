@@ -8,8 +8,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -135,6 +138,53 @@ func (c *FakeMachineDeployments) Patch(ctx context.Context, name string, pt type
 	return obj.(*v1alpha1.MachineDeployment), err
 }
 
+// Apply takes the given apply declarative configuration, applies it and returns the applied machineDeployment.
+func (c *FakeMachineDeployments) Apply(ctx context.Context, machineDeployment *machinev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineDeployment, err error) {
+	if machineDeployment == nil {
+		return nil, fmt.Errorf("machineDeployment provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineDeployment)
+	if err != nil {
+		return nil, err
+	}
+	name := machineDeployment.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineDeployment.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineDeployment{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machinedeploymentsResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineDeployment), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMachineDeployments) ApplyStatus(ctx context.Context, machineDeployment *machinev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineDeployment, err error) {
+	if machineDeployment == nil {
+		return nil, fmt.Errorf("machineDeployment provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineDeployment)
+	if err != nil {
+		return nil, err
+	}
+	name := machineDeployment.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineDeployment.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineDeployment{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machinedeploymentsResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineDeployment), err
+}
+
 // UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
 func (c *FakeMachineDeployments) UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
 	emptyResult := &autoscalingv1.Scale{}
@@ -8,8 +8,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -135,6 +138,53 @@ func (c *FakeMachineSets) Patch(ctx context.Context, name string, pt types.Patch
 	return obj.(*v1alpha1.MachineSet), err
 }
 
+// Apply takes the given apply declarative configuration, applies it and returns the applied machineSet.
+func (c *FakeMachineSets) Apply(ctx context.Context, machineSet *machinev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineSet, err error) {
+	if machineSet == nil {
+		return nil, fmt.Errorf("machineSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineSet)
+	if err != nil {
+		return nil, err
+	}
+	name := machineSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineSet.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineSet{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machinesetsResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMachineSets) ApplyStatus(ctx context.Context, machineSet *machinev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineSet, err error) {
+	if machineSet == nil {
+		return nil, fmt.Errorf("machineSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineSet)
+	if err != nil {
+		return nil, err
+	}
+	name := machineSet.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineSet.Name must be provided to Apply")
+	}
+	emptyResult := &v1alpha1.MachineSet{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machinesetsResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.MachineSet), err
+}
+
 // UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
 func (c *FakeMachineSets) UpdateScale(ctx context.Context, machineSetName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
 	emptyResult := &autoscalingv1.Scale{}
@@ -10,6 +10,7 @@ import (
 	"context"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -35,18 +36,21 @@ type MachineInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.MachineList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Machine, err error)
+	Apply(ctx context.Context, machine *machinev1alpha1.MachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Machine, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machine *machinev1alpha1.MachineApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.Machine, err error)
 	MachineExpansion
 }
 
 // machines implements MachineInterface
 type machines struct {
-	*gentype.ClientWithList[*v1alpha1.Machine, *v1alpha1.MachineList]
+	*gentype.ClientWithListAndApply[*v1alpha1.Machine, *v1alpha1.MachineList, *machinev1alpha1.MachineApplyConfiguration]
 }
 
 // newMachines returns a Machines
 func newMachines(c *MachineV1alpha1Client, namespace string) *machines {
 	return &machines{
-		gentype.NewClientWithList[*v1alpha1.Machine, *v1alpha1.MachineList](
+		gentype.NewClientWithListAndApply[*v1alpha1.Machine, *v1alpha1.MachineList, *machinev1alpha1.MachineApplyConfiguration](
 			"machines",
 			c.RESTClient(),
 			scheme.ParameterCodec,
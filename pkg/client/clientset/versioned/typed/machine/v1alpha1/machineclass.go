@@ -10,6 +10,7 @@ import (
 	"context"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -27,24 +28,29 @@ type MachineClassesGetter interface {
 type MachineClassInterface interface {
 	Create(ctx context.Context, machineClass *v1alpha1.MachineClass, opts v1.CreateOptions) (*v1alpha1.MachineClass, error)
 	Update(ctx context.Context, machineClass *v1alpha1.MachineClass, opts v1.UpdateOptions) (*v1alpha1.MachineClass, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineClass *v1alpha1.MachineClass, opts v1.UpdateOptions) (*v1alpha1.MachineClass, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.MachineClass, error)
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.MachineClassList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.MachineClass, err error)
+	Apply(ctx context.Context, machineClass *machinev1alpha1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineClass, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineClass *machinev1alpha1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineClass, err error)
 	MachineClassExpansion
 }
 
 // machineClasses implements MachineClassInterface
 type machineClasses struct {
-	*gentype.ClientWithList[*v1alpha1.MachineClass, *v1alpha1.MachineClassList]
+	*gentype.ClientWithListAndApply[*v1alpha1.MachineClass, *v1alpha1.MachineClassList, *machinev1alpha1.MachineClassApplyConfiguration]
 }
 
 // newMachineClasses returns a MachineClasses
 func newMachineClasses(c *MachineV1alpha1Client, namespace string) *machineClasses {
 	return &machineClasses{
-		gentype.NewClientWithList[*v1alpha1.MachineClass, *v1alpha1.MachineClassList](
+		gentype.NewClientWithListAndApply[*v1alpha1.MachineClass, *v1alpha1.MachineClassList, *machinev1alpha1.MachineClassApplyConfiguration](
 			"machineclasses",
 			c.RESTClient(),
 			scheme.ParameterCodec,
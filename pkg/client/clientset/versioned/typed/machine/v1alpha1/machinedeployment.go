@@ -10,6 +10,7 @@ import (
 	"context"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,9 @@ type MachineDeploymentInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.MachineDeploymentList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.MachineDeployment, err error)
+	Apply(ctx context.Context, machineDeployment *machinev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineDeployment, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineDeployment *machinev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineDeployment, err error)
 	UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
 
 	MachineDeploymentExpansion
@@ -43,13 +47,13 @@ type MachineDeploymentInterface interface {
 
 // machineDeployments implements MachineDeploymentInterface
 type machineDeployments struct {
-	*gentype.ClientWithList[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList]
+	*gentype.ClientWithListAndApply[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList, *machinev1alpha1.MachineDeploymentApplyConfiguration]
 }
 
 // newMachineDeployments returns a MachineDeployments
 func newMachineDeployments(c *MachineV1alpha1Client, namespace string) *machineDeployments {
 	return &machineDeployments{
-		gentype.NewClientWithList[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList](
+		gentype.NewClientWithListAndApply[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList, *machinev1alpha1.MachineDeploymentApplyConfiguration](
 			"machinedeployments",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -10,6 +10,7 @@ import (
 	"context"
 
 	v1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1alpha1"
 	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,9 @@ type MachineSetInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.MachineSetList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.MachineSet, err error)
+	Apply(ctx context.Context, machineSet *machinev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineSet, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineSet *machinev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.MachineSet, err error)
 	UpdateScale(ctx context.Context, machineSetName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
 
 	MachineSetExpansion
@@ -43,13 +47,13 @@ type MachineSetInterface interface {
 
 // machineSets implements MachineSetInterface
 type machineSets struct {
-	*gentype.ClientWithList[*v1alpha1.MachineSet, *v1alpha1.MachineSetList]
+	*gentype.ClientWithListAndApply[*v1alpha1.MachineSet, *v1alpha1.MachineSetList, *machinev1alpha1.MachineSetApplyConfiguration]
 }
 
 // newMachineSets returns a MachineSets
 func newMachineSets(c *MachineV1alpha1Client, namespace string) *machineSets {
 	return &machineSets{
-		gentype.NewClientWithList[*v1alpha1.MachineSet, *v1alpha1.MachineSetList](
+		gentype.NewClientWithListAndApply[*v1alpha1.MachineSet, *v1alpha1.MachineSetList, *machinev1alpha1.MachineSetApplyConfiguration](
 			"machinesets",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeMachineV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeMachineV1beta1) Machines(namespace string) v1beta1.MachineInterface {
+	return &FakeMachines{c, namespace}
+}
+
+func (c *FakeMachineV1beta1) MachineClasses(namespace string) v1beta1.MachineClassInterface {
+	return &FakeMachineClasses{c, namespace}
+}
+
+func (c *FakeMachineV1beta1) MachineDeployments(namespace string) v1beta1.MachineDeploymentInterface {
+	return &FakeMachineDeployments{c, namespace}
+}
+
+func (c *FakeMachineV1beta1) MachineSets(namespace string) v1beta1.MachineSetInterface {
+	return &FakeMachineSets{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeMachineV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMachineClasses implements MachineClassInterface
+type FakeMachineClasses struct {
+	Fake *FakeMachineV1beta1
+	ns   string
+}
+
+var machineclassesResource = v1beta1.SchemeGroupVersion.WithResource("machineclasses")
+
+var machineclassesKind = v1beta1.SchemeGroupVersion.WithKind("MachineClass")
+
+// Get takes name of the machineClass, and returns the corresponding machineClass object, and an error if there is any.
+func (c *FakeMachineClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.MachineClass, err error) {
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(machineclassesResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// List takes label and field selectors, and returns the list of MachineClasses that match those selectors.
+func (c *FakeMachineClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.MachineClassList, err error) {
+	emptyResult := &v1beta1.MachineClassList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(machineclassesResource, machineclassesKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.MachineClassList{ListMeta: obj.(*v1beta1.MachineClassList).ListMeta}
+	for _, item := range obj.(*v1beta1.MachineClassList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested machineClasses.
+func (c *FakeMachineClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(machineclassesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a machineClass and creates it.  Returns the server's representation of the machineClass, and an error, if there is any.
+func (c *FakeMachineClasses) Create(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.CreateOptions) (result *v1beta1.MachineClass, err error) {
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(machineclassesResource, c.ns, machineClass, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// Update takes the representation of a machineClass and updates it. Returns the server's representation of the machineClass, and an error, if there is any.
+func (c *FakeMachineClasses) Update(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.UpdateOptions) (result *v1beta1.MachineClass, err error) {
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(machineclassesResource, c.ns, machineClass, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMachineClasses) UpdateStatus(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.UpdateOptions) (result *v1beta1.MachineClass, err error) {
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(machineclassesResource, "status", c.ns, machineClass, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// Delete takes name of the machineClass and deletes it. Returns an error if one occurs.
+func (c *FakeMachineClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(machineclassesResource, c.ns, name, opts), &v1beta1.MachineClass{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMachineClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(machineclassesResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.MachineClassList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched machineClass.
+func (c *FakeMachineClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MachineClass, err error) {
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machineclassesResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied machineClass.
+func (c *FakeMachineClasses) Apply(ctx context.Context, machineClass *machinev1beta1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineClass, err error) {
+	if machineClass == nil {
+		return nil, fmt.Errorf("machineClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineClass)
+	if err != nil {
+		return nil, err
+	}
+	name := machineClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineClass.Name must be provided to Apply")
+	}
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machineclassesResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions()), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeMachineClasses) ApplyStatus(ctx context.Context, machineClass *machinev1beta1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineClass, err error) {
+	if machineClass == nil {
+		return nil, fmt.Errorf("machineClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(machineClass)
+	if err != nil {
+		return nil, err
+	}
+	name := machineClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("machineClass.Name must be provided to Apply")
+	}
+	emptyResult := &v1beta1.MachineClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(machineclassesResource, c.ns, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.MachineClass), err
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type MachineExpansion interface{}
+
+type MachineClassExpansion interface{}
+
+type MachineDeploymentExpansion interface{}
+
+type MachineSetExpansion interface{}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1beta1"
+	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineClassesGetter has a method to return a MachineClassInterface.
+// A group's client should implement this interface.
+type MachineClassesGetter interface {
+	MachineClasses(namespace string) MachineClassInterface
+}
+
+// MachineClassInterface has methods to work with MachineClass resources.
+type MachineClassInterface interface {
+	Create(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.CreateOptions) (*v1beta1.MachineClass, error)
+	Update(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.UpdateOptions) (*v1beta1.MachineClass, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineClass *v1beta1.MachineClass, opts v1.UpdateOptions) (*v1beta1.MachineClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.MachineClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.MachineClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MachineClass, err error)
+	Apply(ctx context.Context, machineClass *machinev1beta1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineClass, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineClass *machinev1beta1.MachineClassApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineClass, err error)
+	MachineClassExpansion
+}
+
+// machineClasses implements MachineClassInterface
+type machineClasses struct {
+	*gentype.ClientWithListAndApply[*v1beta1.MachineClass, *v1beta1.MachineClassList, *machinev1beta1.MachineClassApplyConfiguration]
+}
+
+// newMachineClasses returns a MachineClasses
+func newMachineClasses(c *MachineV1beta1Client, namespace string) *machineClasses {
+	return &machineClasses{
+		gentype.NewClientWithListAndApply[*v1beta1.MachineClass, *v1beta1.MachineClassList, *machinev1beta1.MachineClassApplyConfiguration](
+			"machineclasses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1beta1.MachineClass { return &v1beta1.MachineClass{} },
+			func() *v1beta1.MachineClassList { return &v1beta1.MachineClassList{} }),
+	}
+}
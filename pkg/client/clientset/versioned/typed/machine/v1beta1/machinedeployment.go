@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	machinev1beta1 "github.com/gardener/machine-controller-manager/pkg/client/applyconfiguration/machine/v1beta1"
+	scheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineDeploymentsGetter has a method to return a MachineDeploymentInterface.
+// A group's client should implement this interface.
+type MachineDeploymentsGetter interface {
+	MachineDeployments(namespace string) MachineDeploymentInterface
+}
+
+// MachineDeploymentInterface has methods to work with MachineDeployment resources.
+type MachineDeploymentInterface interface {
+	Create(ctx context.Context, machineDeployment *v1beta1.MachineDeployment, opts v1.CreateOptions) (*v1beta1.MachineDeployment, error)
+	Update(ctx context.Context, machineDeployment *v1beta1.MachineDeployment, opts v1.UpdateOptions) (*v1beta1.MachineDeployment, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineDeployment *v1beta1.MachineDeployment, opts v1.UpdateOptions) (*v1beta1.MachineDeployment, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.MachineDeployment, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.MachineDeploymentList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MachineDeployment, err error)
+	Apply(ctx context.Context, machineDeployment *machinev1beta1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineDeployment, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineDeployment *machinev1beta1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.MachineDeployment, err error)
+	UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
+	MachineDeploymentExpansion
+}
+
+// machineDeployments implements MachineDeploymentInterface
+type machineDeployments struct {
+	*gentype.ClientWithListAndApply[*v1beta1.MachineDeployment, *v1beta1.MachineDeploymentList, *machinev1beta1.MachineDeploymentApplyConfiguration]
+}
+
+// newMachineDeployments returns a MachineDeployments
+func newMachineDeployments(c *MachineV1beta1Client, namespace string) *machineDeployments {
+	return &machineDeployments{
+		gentype.NewClientWithListAndApply[*v1beta1.MachineDeployment, *v1beta1.MachineDeploymentList, *machinev1beta1.MachineDeploymentApplyConfiguration](
+			"machinedeployments",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1beta1.MachineDeployment { return &v1beta1.MachineDeployment{} },
+			func() *v1beta1.MachineDeploymentList { return &v1beta1.MachineDeploymentList{} }),
+	}
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *machineDeployments) UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Put().
+		Namespace(c.GetNamespace()).
+		Resource("machinedeployments").
+		Name(machineDeploymentName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// MachineListerExpansion allows custom methods to be added to
+// MachineLister.
+type MachineListerExpansion interface{}
+
+// MachineNamespaceListerExpansion allows custom methods to be added to
+// MachineNamespaceLister.
+type MachineNamespaceListerExpansion interface{}
+
+// MachineClassListerExpansion allows custom methods to be added to
+// MachineClassLister.
+type MachineClassListerExpansion interface{}
+
+// MachineClassNamespaceListerExpansion allows custom methods to be added to
+// MachineClassNamespaceLister.
+type MachineClassNamespaceListerExpansion interface{}
+
+// MachineDeploymentListerExpansion allows custom methods to be added to
+// MachineDeploymentLister.
+type MachineDeploymentListerExpansion interface{}
+
+// MachineDeploymentNamespaceListerExpansion allows custom methods to be added to
+// MachineDeploymentNamespaceLister.
+type MachineDeploymentNamespaceListerExpansion interface{}
+
+// MachineSetListerExpansion allows custom methods to be added to
+// MachineSetLister.
+type MachineSetListerExpansion interface{}
+
+// MachineSetNamespaceListerExpansion allows custom methods to be added to
+// MachineSetNamespaceLister.
+type MachineSetNamespaceListerExpansion interface{}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MachineLister helps list Machines.
+// All objects returned here must be treated as read-only.
+type MachineLister interface {
+	// List lists all Machines in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Machine, err error)
+	// Machines returns an object that can list and get Machines.
+	Machines(namespace string) MachineNamespaceLister
+	MachineListerExpansion
+}
+
+// machineLister implements the MachineLister interface.
+type machineLister struct {
+	listers.ResourceIndexer[*v1beta1.Machine]
+}
+
+// NewMachineLister returns a new MachineLister.
+func NewMachineLister(indexer cache.Indexer) MachineLister {
+	return &machineLister{listers.New[*v1beta1.Machine](indexer, v1beta1.Resource("machine"))}
+}
+
+// Machines returns an object that can list and get Machines.
+func (s *machineLister) Machines(namespace string) MachineNamespaceLister {
+	return machineNamespaceLister{listers.NewNamespaced[*v1beta1.Machine](s.ResourceIndexer, namespace)}
+}
+
+// MachineNamespaceLister helps list and get Machines.
+// All objects returned here must be treated as read-only.
+type MachineNamespaceLister interface {
+	// List lists all Machines in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Machine, err error)
+	// Get retrieves the Machine from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.Machine, error)
+	MachineNamespaceListerExpansion
+}
+
+// machineNamespaceLister implements the MachineNamespaceLister
+// interface.
+type machineNamespaceLister struct {
+	listers.ResourceIndexer[*v1beta1.Machine]
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MachineClassLister helps list MachineClasses.
+// All objects returned here must be treated as read-only.
+type MachineClassLister interface {
+	// List lists all MachineClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MachineClass, err error)
+	// MachineClasses returns an object that can list and get MachineClasses.
+	MachineClasses(namespace string) MachineClassNamespaceLister
+	MachineClassListerExpansion
+}
+
+// machineClassLister implements the MachineClassLister interface.
+type machineClassLister struct {
+	listers.ResourceIndexer[*v1beta1.MachineClass]
+}
+
+// NewMachineClassLister returns a new MachineClassLister.
+func NewMachineClassLister(indexer cache.Indexer) MachineClassLister {
+	return &machineClassLister{listers.New[*v1beta1.MachineClass](indexer, v1beta1.Resource("machineclass"))}
+}
+
+// MachineClasses returns an object that can list and get MachineClasses.
+func (s *machineClassLister) MachineClasses(namespace string) MachineClassNamespaceLister {
+	return machineClassNamespaceLister{listers.NewNamespaced[*v1beta1.MachineClass](s.ResourceIndexer, namespace)}
+}
+
+// MachineClassNamespaceLister helps list and get MachineClasses.
+// All objects returned here must be treated as read-only.
+type MachineClassNamespaceLister interface {
+	// List lists all MachineClasses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MachineClass, err error)
+	// Get retrieves the MachineClass from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.MachineClass, error)
+	MachineClassNamespaceListerExpansion
+}
+
+// machineClassNamespaceLister implements the MachineClassNamespaceLister
+// interface.
+type machineClassNamespaceLister struct {
+	listers.ResourceIndexer[*v1beta1.MachineClass]
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MachineDeploymentLister helps list MachineDeployments.
+// All objects returned here must be treated as read-only.
+type MachineDeploymentLister interface {
+	// List lists all MachineDeployments in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MachineDeployment, err error)
+	// MachineDeployments returns an object that can list and get MachineDeployments.
+	MachineDeployments(namespace string) MachineDeploymentNamespaceLister
+	MachineDeploymentListerExpansion
+}
+
+// machineDeploymentLister implements the MachineDeploymentLister interface.
+type machineDeploymentLister struct {
+	listers.ResourceIndexer[*v1beta1.MachineDeployment]
+}
+
+// NewMachineDeploymentLister returns a new MachineDeploymentLister.
+func NewMachineDeploymentLister(indexer cache.Indexer) MachineDeploymentLister {
+	return &machineDeploymentLister{listers.New[*v1beta1.MachineDeployment](indexer, v1beta1.Resource("machinedeployment"))}
+}
+
+// MachineDeployments returns an object that can list and get MachineDeployments.
+func (s *machineDeploymentLister) MachineDeployments(namespace string) MachineDeploymentNamespaceLister {
+	return machineDeploymentNamespaceLister{listers.NewNamespaced[*v1beta1.MachineDeployment](s.ResourceIndexer, namespace)}
+}
+
+// MachineDeploymentNamespaceLister helps list and get MachineDeployments.
+// All objects returned here must be treated as read-only.
+type MachineDeploymentNamespaceLister interface {
+	// List lists all MachineDeployments in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MachineDeployment, err error)
+	// Get retrieves the MachineDeployment from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.MachineDeployment, error)
+	MachineDeploymentNamespaceListerExpansion
+}
+
+// machineDeploymentNamespaceLister implements the MachineDeploymentNamespaceLister
+// interface.
+type machineDeploymentNamespaceLister struct {
+	listers.ResourceIndexer[*v1beta1.MachineDeployment]
+}
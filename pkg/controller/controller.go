@@ -17,6 +17,7 @@ import (
 	machinelisters "github.com/gardener/machine-controller-manager/pkg/client/listers/machine/v1alpha1"
 	"github.com/gardener/machine-controller-manager/pkg/handlers"
 	"github.com/gardener/machine-controller-manager/pkg/options"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	"github.com/gardener/machine-controller-manager/pkg/util/worker"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -31,11 +32,19 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 )
 
 const (
 	// DeleteFinalizerName is the finalizer used to identify the controller acting on an object
 	DeleteFinalizerName = "machine.sapcloud.io/machine-controller-manager"
+
+	// MachineDeploymentFieldManager is the field manager of the status writes of the machine deployment controller
+	MachineDeploymentFieldManager = "machinedeployment-controller"
+	// MachineSetFieldManager is the field manager of the status writes of the machine set controller
+	MachineSetFieldManager = "machineset-controller"
+	// MachineSafetyFieldManager is the field manager of the status writes of the safety controller
+	MachineSafetyFieldManager = "machine-safety-controller"
 )
 
 // NewController returns a new Node controller.
@@ -51,6 +60,7 @@ func NewController(
 	recorder record.EventRecorder,
 	safetyOptions options.SafetyOptions,
 	autoscalerScaleDownAnnotationDuringRollout bool,
+	maxConcurrentRollouts int32,
 ) (Controller, error) {
 	controller := &controller{
 		namespace:                      namespace,
@@ -59,13 +69,16 @@ func NewController(
 		targetCoreClient:               targetCoreClient,
 		recorder:                       recorder,
 		expectations:                   NewUIDTrackingContExpectations(NewContExpectations()),
-		nodeQueue:                      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "node"),
-		machineQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machine"),
-		machineSetQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineset"),
-		machineDeploymentQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinedeployment"),
-		machineSafetyOvershootingQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyovershooting"),
+		nodeQueue:                      debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "node"),
+		machineQueue:                   debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machine"),
+		machineSetQueue:                debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineset"),
+		machineDeploymentQueue:         debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinedeployment"),
+		machineSafetyOvershootingQueue: debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyovershooting"),
 		safetyOptions:                  safetyOptions,
 		autoscalerScaleDownAnnotationDuringRollout: autoscalerScaleDownAnnotationDuringRollout,
+		maxConcurrentRollouts:                      maxConcurrentRollouts,
+		rolloutSlots:                               make(map[string]bool),
+		clock:                                      clock.RealClock{},
 	}
 
 	controller.internalExternalScheme = runtime.NewScheme()
@@ -161,6 +174,15 @@ type Controller interface {
 type controller struct {
 	namespace                                  string
 	autoscalerScaleDownAnnotationDuringRollout bool
+	maxConcurrentRollouts                      int32
+
+	// rolloutSlots are the keys of the machine deployments which have been granted a slot to roll out.
+	rolloutSlots     map[string]bool
+	rolloutSlotsLock sync.Mutex
+
+	// clock is the clock of maintenance windows, canary pauses, scaling schedules and the other time based decisions
+	// of the controllers, which the rollout simulation replaces with its simulated clock.
+	clock clock.PassiveClock
 
 	controlMachineClient machineapi.MachineV1alpha1Interface
 	controlCoreClient    kubernetes.Interface
@@ -204,6 +226,10 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	defer c.machineDeploymentQueue.ShutDown()
 	defer c.machineSafetyOvershootingQueue.ShutDown()
 
+	// The state is registered before the caches are synced, so that a controller waiting for them can be inspected.
+	debug.Register(debugName, c.debugState)
+	defer debug.Unregister(debugName)
+
 	if !cache.WaitForCacheSync(stopCh, c.nodeSynced, c.machineSynced, c.machineSetSynced, c.machineDeploymentSynced) {
 		runtimeutil.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
 		return
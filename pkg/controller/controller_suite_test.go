@@ -24,6 +24,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 
 	machine_internal "github.com/gardener/machine-controller-manager/pkg/apis/machine"
@@ -426,6 +427,7 @@ func createController(
 
 	controller := &controller{
 		namespace:                      namespace,
+		clock:                          clock.RealClock{},
 		safetyOptions:                  safetyOptions,
 		targetCoreClient:               fakeTargetCoreClient,
 		controlCoreClient:              fakeControlCoreClient,
@@ -446,6 +448,7 @@ func createController(
 		machineSafetyOvershootingQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyovershooting"),
 		expectations:                   NewUIDTrackingContExpectations(NewContExpectations()),
 		recorder:                       record.NewBroadcaster().NewRecorder(nil, corev1.EventSource{Component: ""}),
+		rolloutSlots:                   make(map[string]bool),
 	}
 
 	// controller.internalExternalScheme = runtime.NewScheme()
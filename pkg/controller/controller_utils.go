@@ -735,40 +735,9 @@ func (s ActiveMachines) Len() int      { return len(s) }
 func (s ActiveMachines) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
 func (s ActiveMachines) Less(i, j int) bool {
-	// Default priority for machine objects
-	machineIPriority := 3
-	machineJPriority := 3
-
-	if s[i].Annotations != nil && s[i].Annotations[machineutils.MachinePriority] != "" {
-		num, err := strconv.Atoi(s[i].Annotations[machineutils.MachinePriority])
-		if err == nil {
-			machineIPriority = num
-		} else {
-			klog.Errorf("Machine priority is taken to be the default value (3). Couldn't convert machine priority to integer for machine:%s. Error message - %s", s[i].Name, err)
-		}
-	}
-
-	if s[j].Annotations != nil && s[j].Annotations[machineutils.MachinePriority] != "" {
-		num, err := strconv.Atoi(s[j].Annotations[machineutils.MachinePriority])
-		if err == nil {
-			machineJPriority = num
-		} else {
-			klog.Errorf("Machine priority is taken to be the default value (3). Couldn't convert machine priority to integer for machine:%s. Error message - %s", s[j].Name, err)
-		}
-	}
-
-	// Map containing machinePhase priority
-	// the lower the priority, the more likely
-	// it is to be deleted
-	m := map[v1alpha1.MachinePhase]int{
-		v1alpha1.MachineTerminating:      0,
-		v1alpha1.MachineFailed:           1,
-		v1alpha1.MachineCrashLoopBackOff: 2,
-		v1alpha1.MachineUnknown:          3,
-		v1alpha1.MachinePending:          4,
-		v1alpha1.MachineAvailable:        5,
-		v1alpha1.MachineRunning:          6,
-	}
+	machineIPriority := machineDeletionPriority(s[i])
+	machineJPriority := machineDeletionPriority(s[j])
+	m := machinePhaseDeletionPriority
 
 	// Case-1: Initially we try to prioritize machine deletion based on
 	// machinePriority annotation.
@@ -786,6 +755,45 @@ func (s ActiveMachines) Less(i, j int) bool {
 	return false
 }
 
+// Map containing machinePhase priority
+// the lower the priority, the more likely
+// it is to be deleted. Machines are only in Standby phase
+// outside of the warm pool if they have been taken into service
+// while their VM is being started, which is faster than the
+// creation of a Pending machine.
+var machinePhaseDeletionPriority = map[v1alpha1.MachinePhase]int{
+	v1alpha1.MachineTerminating:      0,
+	v1alpha1.MachineFailed:           1,
+	v1alpha1.MachineCrashLoopBackOff: 2,
+	v1alpha1.MachineUnknown:          3,
+	v1alpha1.MachinePending:          4,
+	v1alpha1.MachineStandby:          5,
+	v1alpha1.MachineAvailable:        6,
+	v1alpha1.MachineRunning:          7,
+	v1alpha1.MachineMaintenance:      8,
+}
+
+// machineDeletionPriority returns the priority of the machine from its spec and its legacy priority annotation,
+// the lower the priority, the more likely it is to be deleted. The lower priority of both is taken, as the
+// cluster-autoscaler still marks machines to be deleted with the annotation.
+func machineDeletionPriority(machine *v1alpha1.Machine) int {
+	// Default priority for machine objects
+	priority := 3
+	if machine.Spec.DeletionPriority != nil {
+		priority = int(*machine.Spec.DeletionPriority)
+	}
+
+	if machine.Annotations != nil && machine.Annotations[machineutils.MachinePriority] != "" {
+		num, err := strconv.Atoi(machine.Annotations[machineutils.MachinePriority])
+		if err != nil {
+			klog.Errorf("Machine priority annotation is ignored, taking the priority %d. Couldn't convert machine priority to integer for machine:%s. Error message - %s", priority, machine.Name, err)
+		} else if machine.Spec.DeletionPriority == nil || num < priority {
+			priority = num
+		}
+	}
+	return priority
+}
+
 // IsMachineActive checks if machine was active
 func IsMachineActive(p *v1alpha1.Machine) bool {
 	if p.Status.CurrentStatus.Phase == v1alpha1.MachineFailed {
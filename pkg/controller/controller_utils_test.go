@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 const testNamespace = "test"
@@ -154,6 +155,19 @@ var _ = Describe("#controllerUtils", func() {
 					Phase: machinev1.MachinePending,
 				},
 			}, nil, nil, nil),
+			newMachine(&machinev1.MachineTemplateSpec{
+				ObjectMeta: *newObjectMeta(objMeta, 0),
+				Spec: machinev1.MachineSpec{
+					Class: machinev1.ClassSpec{
+						Kind: AWSMachineClass,
+						Name: TestMachineClass,
+					},
+				},
+			}, &machinev1.MachineStatus{
+				CurrentStatus: machinev1.CurrentStatus{
+					Phase: machinev1.MachineStandby,
+				},
+			}, nil, nil, nil),
 			newMachine(&machinev1.MachineTemplateSpec{
 				ObjectMeta: *newObjectMeta(objMeta, 0),
 				Spec: machinev1.MachineSpec{
@@ -180,10 +194,25 @@ var _ = Describe("#controllerUtils", func() {
 					Phase: machinev1.MachineRunning,
 				},
 			}, nil, nil, nil),
+			newMachine(&machinev1.MachineTemplateSpec{
+				ObjectMeta: *newObjectMeta(objMeta, 0),
+				Spec: machinev1.MachineSpec{
+					Class: machinev1.ClassSpec{
+						Kind: AWSMachineClass,
+						Name: TestMachineClass,
+					},
+				},
+			}, &machinev1.MachineStatus{
+				CurrentStatus: machinev1.CurrentStatus{
+					Phase: machinev1.MachineMaintenance,
+				},
+			}, nil, nil, nil),
 		}
 
 		unsortedMachinesInOrderOfPhase := []*machinev1.Machine{
+			sortedMachinesInOrderOfPhase[7].DeepCopy(),
 			sortedMachinesInOrderOfPhase[5].DeepCopy(),
+			sortedMachinesInOrderOfPhase[6].DeepCopy(),
 			sortedMachinesInOrderOfPhase[4].DeepCopy(),
 			sortedMachinesInOrderOfPhase[1].DeepCopy(),
 			sortedMachinesInOrderOfPhase[3].DeepCopy(),
@@ -227,6 +256,40 @@ var _ = Describe("#controllerUtils", func() {
 		)
 	})
 
+	Describe("##machineDeletionPriority", func() {
+		DescribeTable("###table",
+			func(deletionPriority *int32, annotation string, expectedPriority int) {
+				machine := &machinev1.Machine{Spec: machinev1.MachineSpec{DeletionPriority: deletionPriority}}
+				if annotation != "" {
+					machine.Annotations = map[string]string{machineutils.MachinePriority: annotation}
+				}
+				Expect(machineDeletionPriority(machine)).To(Equal(expectedPriority))
+			},
+			Entry("should default to 3", nil, "", 3),
+			Entry("should take the annotation", nil, "5", 5),
+			Entry("should ignore an invalid annotation", nil, "high", 3),
+			Entry("should take the spec", ptr.To[int32](2), "", 2),
+			Entry("should take the annotation if it is lower than the spec", ptr.To[int32](2), "1", 1),
+			Entry("should take the spec if it is lower than the annotation", ptr.To[int32](1), "3", 1),
+			Entry("should take the spec if the annotation is invalid", ptr.To[int32](4), "high", 4),
+		)
+	})
+
+	Describe("##IsMachineSetFrozen", func() {
+		DescribeTable("###table",
+			func(frozen bool, labels map[string]string, expectFrozen bool) {
+				machineSet := &machinev1.MachineSet{ObjectMeta: metav1.ObjectMeta{Labels: labels}, Spec: machinev1.MachineSetSpec{Frozen: frozen}}
+				Expect(IsMachineSetFrozen(machineSet)).To(Equal(expectFrozen))
+				machineDeployment := &machinev1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Labels: labels}, Spec: machinev1.MachineDeploymentSpec{Frozen: frozen}}
+				Expect(IsMachineDeploymentFrozen(machineDeployment)).To(Equal(expectFrozen))
+			},
+			Entry("should not be frozen by default", false, nil, false),
+			Entry("should be frozen by the spec", true, nil, true),
+			Entry("should be frozen by the legacy label", false, map[string]string{machineutils.FreezeLabel: "True"}, true),
+			Entry("should not be frozen by a legacy label with another value", false, map[string]string{machineutils.FreezeLabel: "true"}, false),
+		)
+	})
+
 	Describe("##AddOrUpdateAnnotationOnNode", func() {
 		type setup struct {
 			node *corev1.Node
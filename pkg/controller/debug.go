@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+)
+
+// debugName is the name under which the controller shows its state on the debug endpoint.
+const debugName = "machine-controller-manager"
+
+// debugState is the state of the controller shown on the debug endpoint.
+type debugState struct {
+	// Queues are the states of the work queues.
+	Queues []debug.QueueState `json:"queues"`
+	// InformersSynced tells for each informer whether its cache is synced.
+	InformersSynced map[string]bool `json:"informersSynced"`
+	// Expectations are the creations and deletions of machines the machine sets wait to observe.
+	Expectations []machineSetExpectations `json:"expectations"`
+}
+
+// machineSetExpectations are the expectations of a machine set.
+type machineSetExpectations struct {
+	// MachineSet is the key of the machine set.
+	MachineSet string `json:"machineSet"`
+	// Add is the number of machine creations still expected.
+	Add int64 `json:"add"`
+	// Del is the number of machine deletions still expected.
+	Del int64 `json:"del"`
+	// Deletions are the keys of the machines whose deletions are still expected.
+	Deletions []string `json:"deletions,omitempty"`
+	// Age is the time since the expectations were set.
+	Age metav1.Duration `json:"age"`
+	// Fulfilled is true if all expected creations and deletions were observed.
+	Fulfilled bool `json:"fulfilled"`
+	// Expired is true if the expectations are older than ExpectationsTimeout and hence ignored.
+	Expired bool `json:"expired"`
+}
+
+func (c *controller) debugState() interface{} {
+	return debugState{
+		Queues: []debug.QueueState{
+			debug.QueueStateOf(c.nodeQueue, "node"),
+			debug.QueueStateOf(c.machineQueue, "machine"),
+			debug.QueueStateOf(c.machineSetQueue, "machineset"),
+			debug.QueueStateOf(c.machineDeploymentQueue, "machinedeployment"),
+			debug.QueueStateOf(c.machineSafetyOvershootingQueue, "machinesafetyovershooting"),
+		},
+		InformersSynced: map[string]bool{
+			"nodes":              c.nodeSynced(),
+			"machines":           c.machineSynced(),
+			"machineSets":        c.machineSetSynced(),
+			"machineDeployments": c.machineDeploymentSynced(),
+		},
+		Expectations: c.machineSetExpectations(),
+	}
+}
+
+func (c *controller) machineSetExpectations() []machineSetExpectations {
+	store, ok := c.expectations.ExpectationsInterface.(*ContExpectations)
+	if !ok {
+		return nil
+	}
+	c.expectations.uidStoreLock.Lock()
+	defer c.expectations.uidStoreLock.Unlock()
+
+	expectations := []machineSetExpectations{}
+	for _, obj := range store.List() {
+		exp := obj.(*ControlleeExpectations)
+		add, del := exp.GetExpectations()
+		expectations = append(expectations, machineSetExpectations{
+			MachineSet: exp.key,
+			Add:        add,
+			Del:        del,
+			Deletions:  c.expectations.GetUIDs(exp.key).List(),
+			Age:        metav1.Duration{Duration: clock.RealClock{}.Since(exp.timestamp)},
+			Fulfilled:  exp.Fulfilled(),
+			Expired:    exp.isExpired(),
+		})
+	}
+	sort.Slice(expectations, func(i, j int) bool { return expectations[i].MachineSet < expectations[j].MachineSet })
+	return expectations
+}
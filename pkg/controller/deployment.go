@@ -55,6 +55,7 @@ func (dc *controller) addMachineDeployment(obj interface{}) {
 	d := obj.(*v1alpha1.MachineDeployment)
 	klog.V(4).Infof("Adding machine deployment %s", d.Name)
 	dc.enqueueMachineDeployment(d)
+	dc.enqueueZoneSpreadDeployment(d)
 }
 
 func (dc *controller) updateMachineDeployment(old, cur interface{}) {
@@ -62,6 +63,7 @@ func (dc *controller) updateMachineDeployment(old, cur interface{}) {
 	curD := cur.(*v1alpha1.MachineDeployment)
 	klog.V(4).Infof("Updating machine deployment %s", oldD.Name)
 	dc.enqueueMachineDeployment(curD)
+	dc.enqueueZoneSpreadDeployment(curD)
 }
 
 func (dc *controller) deleteMachineDeployment(obj interface{}) {
@@ -80,6 +82,7 @@ func (dc *controller) deleteMachineDeployment(obj interface{}) {
 	}
 	klog.V(4).Infof("Deleting machine deployment %s", d.Name)
 	dc.enqueueMachineDeployment(d)
+	dc.enqueueZoneSpreadDeployment(d)
 }
 
 // addMachineSet enqueues the deployment that manages a MachineSet when the MachineSet is created.
@@ -433,7 +436,7 @@ func (dc *controller) reconcileClusterMachineDeployment(key string) error {
 	klog.V(3).Infof("Processing the machinedeployment %q (with replicas %d)", deployment.Name, deployment.Spec.Replicas)
 
 	// If MachineDeployment is frozen and no deletion timestamp, don't process it
-	if deployment.Labels["freeze"] == "True" && deployment.DeletionTimestamp == nil {
+	if IsMachineDeploymentFrozen(deployment) && deployment.DeletionTimestamp == nil {
 		klog.V(3).Infof("MachineDeployment %q is frozen. However, it will still be processed if it there is an scale down event.", deployment.Name)
 	}
 
@@ -468,7 +471,7 @@ func (dc *controller) reconcileClusterMachineDeployment(key string) error {
 		dc.recorder.Eventf(d, v1.EventTypeWarning, "SelectingAll", "This deployment is selecting all machines. A non-empty selector is required.")
 		if d.Status.ObservedGeneration < d.Generation {
 			d.Status.ObservedGeneration = d.Generation
-			if _, err := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{}); err != nil {
+			if _, err := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager}); err != nil {
 				return fmt.Errorf("failed to update status for machine deployment %s: %w", deployment.Name, err)
 
 			}
@@ -476,6 +479,18 @@ func (dc *controller) reconcileClusterMachineDeployment(key string) error {
 		return nil
 	}
 
+	// Deployments with scheduled scaling are scaled by their schedules which are due.
+	if d.DeletionTimestamp == nil {
+		if d, err = dc.syncScheduledScaling(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	// Deployments with zone spread manage their machines through one deployment per zone.
+	if IsZoneSpread(d) {
+		return dc.syncZoneSpread(ctx, d)
+	}
+
 	// List MachineSets owned by this Deployment, while reconciling ControllerRef
 	// through adoption/orphaning.
 	machineSets, err := dc.getMachineSetsForMachineDeployment(ctx, d)
@@ -524,6 +539,10 @@ func (dc *controller) reconcileClusterMachineDeployment(key string) error {
 		return dc.rollback(ctx, d, machineSets, machineMap)
 	}
 
+	if rolledBack, err := dc.syncAutoRollback(ctx, d, machineSets, machineMap); err != nil || rolledBack {
+		return err
+	}
+
 	scalingEvent, err := dc.isScalingEvent(ctx, d, machineSets, machineMap)
 
 	if err != nil {
@@ -534,11 +553,25 @@ func (dc *controller) reconcileClusterMachineDeployment(key string) error {
 		return dc.sync(ctx, d, machineSets, machineMap)
 	}
 
+	// A new machine set is only rolled out once a rollout slot is free, if the number of concurrent
+	// rollouts is limited.
+	d, waiting, err := dc.syncRolloutSlot(ctx, d, machineSets)
+	if err != nil {
+		return err
+	}
+	if waiting {
+		return dc.sync(ctx, d, machineSets, machineMap)
+	}
+
 	switch d.Spec.Strategy.Type {
 	case v1alpha1.RecreateMachineDeploymentStrategyType:
 		return dc.rolloutRecreate(ctx, d, machineSets, machineMap)
 	case v1alpha1.RollingUpdateMachineDeploymentStrategyType:
 		return dc.rolloutRolling(ctx, d, machineSets, machineMap)
+	case v1alpha1.CanaryMachineDeploymentStrategyType:
+		return dc.rolloutCanary(ctx, d, machineSets, machineMap)
+	case v1alpha1.BlueGreenMachineDeploymentStrategyType:
+		return dc.rolloutBlueGreen(ctx, d, machineSets, machineMap)
 	}
 	return fmt.Errorf("unexpected deployment strategy type: %s", d.Spec.Strategy.Type)
 }
@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller/autoscaler"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// BlueGreenActionAnnotation is used to manually confirm, switch back or retry the blue/green update of a
+	// machine deployment. It is removed by the controller once the action is performed.
+	BlueGreenActionAnnotation = "deployment.machine.sapcloud.io/blue-green-action"
+	// BlueGreenActionConfirm scales down the old machine sets of a switched blue/green update before its
+	// confirmation window has passed.
+	BlueGreenActionConfirm = "confirm"
+	// BlueGreenActionSwitchBack ends the maintenance of the machines of the old machine sets of a blue/green
+	// update, upon which their nodes are uncordoned, and scales down the new machine set.
+	BlueGreenActionSwitchBack = "switchback"
+	// BlueGreenActionRetry restarts a switched back blue/green update.
+	BlueGreenActionRetry = "retry"
+
+	// BlueGreenHookAnnotationPrefix is the prefix of the annotations by which the hooks of a blue/green update
+	// pass. The annotation has to be set to the machine-template-hash of the new machine set.
+	BlueGreenHookAnnotationPrefix = "bluegreen-hook.deployment.machine.sapcloud.io/"
+
+	// BlueGreenSwitchedReason is added in a deployment when its blue/green update is switched. Lack of progress
+	// shouldn't be estimated while a blue/green update waits for its confirmation.
+	BlueGreenSwitchedReason = "BlueGreenSwitched"
+	// BlueGreenSwitchedBackReason is added in a deployment when its blue/green update is switched back. Lack of
+	// progress shouldn't be estimated once a blue/green update is switched back.
+	BlueGreenSwitchedBackReason = "BlueGreenSwitchedBack"
+	// BlueGreenSwitched is the switched blue/green update event reason
+	BlueGreenSwitched = "BlueGreenSwitched"
+	// BlueGreenConfirmed is the confirmed blue/green update event reason
+	BlueGreenConfirmed = "BlueGreenConfirmed"
+	// BlueGreenSwitchedBack is the switched back blue/green update event reason
+	BlueGreenSwitchedBack = "BlueGreenSwitchedBack"
+	// BlueGreenActionInvalid is the invalid blue/green action event reason
+	BlueGreenActionInvalid = "BlueGreenActionInvalid"
+)
+
+// IsBlueGreen returns true if the strategy type is a blue/green update.
+func IsBlueGreen(deployment *v1alpha1.MachineDeployment) bool {
+	return deployment.Spec.Strategy.Type == v1alpha1.BlueGreenMachineDeploymentStrategyType
+}
+
+// rolloutBlueGreen implements the logic for switching all machines to a new machine set at once.
+func (dc *controller) rolloutBlueGreen(ctx context.Context, d *v1alpha1.MachineDeployment, isList []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList) error {
+	clusterAutoscalerScaleDownAnnotations := make(map[string]string)
+	clusterAutoscalerScaleDownAnnotations[autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationKey] = autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationValue
+
+	// We do this to avoid accidentally deleting the user provided annotations.
+	clusterAutoscalerScaleDownAnnotations[autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMKey] = autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMValue
+
+	newIS, oldISs, err := dc.getAllMachineSetsAndSyncRevision(ctx, d, isList, machineMap, true)
+	if err != nil {
+		return err
+	}
+	allISs := append(oldISs, newIS)
+
+	if dc.autoscalerScaleDownAnnotationDuringRollout {
+		// Add the annotation on the all machinesets if there are any old-machinesets and not scaled-to-zero.
+		if len(oldISs) > 0 && !dc.machineSetsScaledToZero(oldISs) {
+			// Annotate all the nodes under this machine-deployment, as roll-out is on-going.
+			err := dc.annotateNodesBackingMachineSets(ctx, allISs, clusterAutoscalerScaleDownAnnotations)
+			if err != nil {
+				klog.Errorf("Failed to add %s on all nodes. Error: %s", clusterAutoscalerScaleDownAnnotations, err)
+				return err
+			}
+		}
+	}
+
+	d, err = dc.syncBlueGreenStatus(ctx, d, newIS, oldISs, machineMap)
+	if err != nil {
+		return err
+	}
+	phase := d.Status.BlueGreen.Phase
+
+	target := d.Spec.Replicas
+	if phase == v1alpha1.BlueGreenSwitchedBack {
+		// Restore the old machines before the new ones are removed.
+		if err := dc.setMachinesMaintenance(ctx, oldISs, machineMap, false); err != nil {
+			return err
+		}
+		if _, err := dc.scaleUpOldMachineSetsForCanary(ctx, oldISs, d.Spec.Replicas-GetReplicaCountForMachineSets(oldISs), d); err != nil {
+			return err
+		}
+		target = 0
+	}
+
+	if newIS.Spec.Replicas != target {
+		scaled, updatedIS, err := dc.scaleMachineSetAndRecordEvent(ctx, newIS, target, d)
+		if err != nil {
+			return err
+		}
+		if scaled {
+			newIS = updatedIS
+			allISs = append(oldISs, newIS)
+		}
+	}
+
+	switch phase {
+	case v1alpha1.BlueGreenSwitched:
+		// Machines replaced in the meantime have to be drained as well.
+		if inWindow, untilOpen := IsInMaintenanceWindow(d, dc.clock.Now()); !inWindow {
+			klog.V(3).Infof("MachineDeployment %q is outside of its maintenance window, postponing the drain of the old machine sets", d.Name)
+			if untilOpen > 0 {
+				dc.enqueueMachineDeploymentAfter(d, untilOpen)
+			}
+			break
+		}
+		if err := dc.setMachinesMaintenance(ctx, oldISs, machineMap, true); err != nil {
+			return err
+		}
+	case v1alpha1.BlueGreenCompleted:
+		if _, err := dc.scaleDownOldMachineSetsForCanary(ctx, oldISs, 0, d); err != nil {
+			return err
+		}
+	}
+
+	if MachineDeploymentComplete(d, &d.Status) {
+		if dc.autoscalerScaleDownAnnotationDuringRollout {
+			// Check if any of the machine under this MachineDeployment contains the by-mcm annotation, and
+			// remove the original autoscaler annotation only after.
+			err := dc.removeAutoscalerAnnotationsIfRequired(ctx, allISs, clusterAutoscalerScaleDownAnnotations)
+			if err != nil {
+				return err
+			}
+		}
+		if err := dc.cleanupMachineDeployment(ctx, oldISs, d); err != nil {
+			return err
+		}
+	}
+
+	// Lack of progress is not estimated while the blue/green update waits for its confirmation or was switched back.
+	if phase == v1alpha1.BlueGreenSwitched || phase == v1alpha1.BlueGreenSwitchedBack {
+		return dc.syncMachineDeploymentStatus(ctx, allISs, newIS, d)
+	}
+	return dc.syncRolloutStatus(ctx, allISs, newIS, d)
+}
+
+// syncBlueGreenStatus performs the requested blue/green action, advances the blue/green update to its next
+// phase and persists the resulting blue/green status along with the Progressing condition.
+func (dc *controller) syncBlueGreenStatus(ctx context.Context, d *v1alpha1.MachineDeployment, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList) (*v1alpha1.MachineDeployment, error) {
+	hash := newIS.Labels[v1alpha1.DefaultMachineDeploymentUniqueLabelKey]
+
+	blueGreenStatus := &v1alpha1.BlueGreenStatus{MachineTemplateHash: hash, Phase: v1alpha1.BlueGreenProvisioning}
+	if d.Status.BlueGreen != nil && d.Status.BlueGreen.MachineTemplateHash == hash {
+		blueGreenStatus = d.Status.BlueGreen.DeepCopy()
+	}
+
+	if action, ok := d.Annotations[BlueGreenActionAnnotation]; ok {
+		// The annotation is removed first, so that an action is never performed twice.
+		updatedDeployment, err := dc.removeActionAnnotation(ctx, d, BlueGreenActionAnnotation)
+		if err != nil {
+			return d, err
+		}
+		d = updatedDeployment
+
+		switch {
+		case action == BlueGreenActionConfirm && blueGreenStatus.Phase == v1alpha1.BlueGreenSwitched:
+			blueGreenStatus.Phase = v1alpha1.BlueGreenCompleted
+			dc.recorder.Eventf(d, v1.EventTypeNormal, BlueGreenConfirmed, "Confirmed switch to machine set %q", newIS.Name)
+		case action == BlueGreenActionSwitchBack && (blueGreenStatus.Phase == v1alpha1.BlueGreenProvisioning || blueGreenStatus.Phase == v1alpha1.BlueGreenSwitched):
+			blueGreenStatus.Phase = v1alpha1.BlueGreenSwitchedBack
+			blueGreenStatus.SwitchTime = nil
+			dc.recorder.Eventf(d, v1.EventTypeNormal, BlueGreenSwitchedBack, "Switched back from machine set %q", newIS.Name)
+		case action == BlueGreenActionRetry && blueGreenStatus.Phase == v1alpha1.BlueGreenSwitchedBack:
+			blueGreenStatus.Phase = v1alpha1.BlueGreenProvisioning
+		default:
+			dc.recorder.Eventf(d, v1.EventTypeWarning, BlueGreenActionInvalid, "Ignoring blue/green action %q in phase %s", action, blueGreenStatus.Phase)
+		}
+	}
+
+	if err := dc.advanceBlueGreen(ctx, d, blueGreenStatus, newIS, oldISs, machineMap); err != nil {
+		return d, err
+	}
+
+	newStatus := d.Status.DeepCopy()
+	newStatus.BlueGreen = blueGreenStatus
+	cond := GetMachineDeploymentCondition(*newStatus, v1alpha1.MachineDeploymentProgressing)
+	switch {
+	case blueGreenStatus.Phase == v1alpha1.BlueGreenSwitchedBack:
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, BlueGreenSwitchedBackReason, "Blue/green update is switched back")
+		SetMachineDeploymentCondition(newStatus, *condition)
+	case blueGreenStatus.Phase == v1alpha1.BlueGreenSwitched:
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, BlueGreenSwitchedReason, fmt.Sprintf("Blue/green update is switched to machine set %q and waits for confirmation", newIS.Name))
+		SetMachineDeploymentCondition(newStatus, *condition)
+	case cond != nil && (cond.Reason == BlueGreenSwitchedReason || cond.Reason == BlueGreenSwitchedBackReason):
+		// Progress is estimated again from now on.
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, ResumedMachineDeployReason, "Blue/green update is resumed")
+		SetMachineDeploymentCondition(newStatus, *condition)
+	}
+
+	if apiequality.Semantic.DeepEqual(&d.Status, newStatus) {
+		return d, nil
+	}
+
+	d.Status = *newStatus
+	return dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+}
+
+// advanceBlueGreen moves the blue/green status to its next phase. The machines of the old machine sets are
+// put under maintenance at once, once the new machine set is ready and all hooks have passed. The switch is completed
+// once the confirmation window has passed.
+func (dc *controller) advanceBlueGreen(ctx context.Context, d *v1alpha1.MachineDeployment, blueGreenStatus *v1alpha1.BlueGreenStatus, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList) error {
+	now := dc.clock.Now()
+	switch blueGreenStatus.Phase {
+	case v1alpha1.BlueGreenProvisioning:
+		if GetReplicaCountForMachineSets(oldISs) == 0 && GetActualReplicaCountForMachineSets(oldISs) == 0 {
+			// Without old machines there is nothing to switch from.
+			blueGreenStatus.Phase = v1alpha1.BlueGreenCompleted
+			return nil
+		}
+		if ready, wait := BlueGreenMachinesReady(d, machineMap[newIS.UID], now); !ready {
+			if wait > 0 {
+				dc.enqueueMachineDeploymentAfter(d, wait)
+			}
+			return nil
+		}
+		if pending := PendingBlueGreenHooks(d, blueGreenStatus.MachineTemplateHash); len(pending) > 0 {
+			klog.V(3).Infof("MachineDeployment %q waits for the blue/green hooks %v to pass", d.Name, pending)
+			return nil
+		}
+		// The switch drains the old machines, so it only happens within the maintenance window.
+		if inWindow, untilOpen := IsInMaintenanceWindow(d, now); !inWindow {
+			klog.V(3).Infof("MachineDeployment %q is outside of its maintenance window, postponing the blue/green switch", d.Name)
+			if untilOpen > 0 {
+				dc.enqueueMachineDeploymentAfter(d, untilOpen)
+			}
+			return nil
+		}
+		if err := dc.setMachinesMaintenance(ctx, oldISs, machineMap, true); err != nil {
+			return err
+		}
+		blueGreenStatus.Phase = v1alpha1.BlueGreenSwitched
+		blueGreenStatus.SwitchTime = &metav1.Time{Time: now}
+		dc.recorder.Eventf(d, v1.EventTypeNormal, BlueGreenSwitched, "Switched to machine set %q", newIS.Name)
+		fallthrough
+	case v1alpha1.BlueGreenSwitched:
+		if d.Spec.Strategy.BlueGreen == nil || d.Spec.Strategy.BlueGreen.ConfirmationWindow == nil {
+			return nil
+		}
+		if remaining := blueGreenStatus.SwitchTime.Add(d.Spec.Strategy.BlueGreen.ConfirmationWindow.Duration).Sub(now); remaining > 0 {
+			dc.enqueueMachineDeploymentAfter(d, remaining)
+			return nil
+		}
+		blueGreenStatus.Phase = v1alpha1.BlueGreenCompleted
+	}
+	return nil
+}
+
+// scaleBlueGreen scales the machine sets of a blue/green update to changed replicas of the deployment. The new
+// machine set has all replicas unless the update is switched back. The old machine sets are scaled while they
+// serve the workload, i.e. before the switch and after a switch back; after the switch they are only removed.
+// The replicas annotations of all machine sets are updated, so that the scaling is not detected again.
+func (dc *controller) scaleBlueGreen(ctx context.Context, d *v1alpha1.MachineDeployment, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet) error {
+	phase := v1alpha1.BlueGreenProvisioning
+	if newIS != nil && d.Status.BlueGreen != nil && d.Status.BlueGreen.MachineTemplateHash == newIS.Labels[v1alpha1.DefaultMachineDeploymentUniqueLabelKey] {
+		phase = d.Status.BlueGreen.Phase
+	}
+
+	nameToSize := make(map[string]int32)
+	for _, is := range oldISs {
+		nameToSize[is.Name] = is.Spec.Replicas
+	}
+	if newIS != nil {
+		nameToSize[newIS.Name] = d.Spec.Replicas
+		if phase == v1alpha1.BlueGreenSwitchedBack {
+			nameToSize[newIS.Name] = 0
+		}
+	}
+
+	if activeISs := FilterActiveMachineSets(oldISs); len(activeISs) > 0 && (phase == v1alpha1.BlueGreenProvisioning || phase == v1alpha1.BlueGreenSwitchedBack) {
+		// Machines are added to the newest old machine set and removed from the oldest ones first.
+		sort.Sort(MachineSetsByCreationTimestamp(activeISs))
+		replicasToAdd := d.Spec.Replicas - GetReplicaCountForMachineSets(activeISs)
+		if replicasToAdd > 0 {
+			nameToSize[activeISs[len(activeISs)-1].Name] += replicasToAdd
+		}
+		for _, is := range activeISs {
+			if replicasToAdd >= 0 {
+				break
+			}
+			scaleDownCount := min(nameToSize[is.Name], -replicasToAdd)
+			nameToSize[is.Name] -= scaleDownCount
+			replicasToAdd += scaleDownCount
+		}
+	}
+
+	allISs := append([]*v1alpha1.MachineSet{}, oldISs...)
+	if newIS != nil {
+		allISs = append(allISs, newIS)
+	}
+	for _, is := range allISs {
+		scalingOperation := "no-op"
+		if nameToSize[is.Name] > is.Spec.Replicas {
+			scalingOperation = "up"
+		} else if nameToSize[is.Name] < is.Spec.Replicas {
+			scalingOperation = "down"
+		}
+		if _, _, err := dc.scaleMachineSet(ctx, is, nameToSize[is.Name], d, scalingOperation); err != nil {
+			klog.Warningf("updating machineSet %s failed while scaling. This could lead to desired replicas annotation not being updated. err: %v", is.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// BlueGreenMachinesReady returns true if the desired number of the given machines of the new machine set have
+// been running for minReadySeconds. Otherwise, it returns the time after which this might be the case, if known.
+func BlueGreenMachinesReady(d *v1alpha1.MachineDeployment, machines *v1alpha1.MachineList, now time.Time) (bool, time.Duration) {
+	if machines == nil {
+		return d.Spec.Replicas == 0, 0
+	}
+	minReady := time.Duration(d.Spec.MinReadySeconds) * time.Second
+
+	var (
+		ready int32
+		wait  time.Duration
+	)
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.DeletionTimestamp != nil || isStandbyMachine(machine) || machine.Status.CurrentStatus.Phase != v1alpha1.MachineRunning {
+			continue
+		}
+		if remaining := machine.Status.CurrentStatus.LastUpdateTime.Add(minReady).Sub(now); remaining > 0 {
+			if wait == 0 || remaining < wait {
+				wait = remaining
+			}
+			continue
+		}
+		ready++
+	}
+	return ready >= d.Spec.Replicas, wait
+}
+
+// PendingBlueGreenHooks returns the hooks of the blue/green update of the deployment which have not passed
+// for the machine set with the given machine-template-hash.
+func PendingBlueGreenHooks(d *v1alpha1.MachineDeployment, hash string) []string {
+	if d.Spec.Strategy.BlueGreen == nil {
+		return nil
+	}
+	var pending []string
+	for _, hook := range d.Spec.Strategy.BlueGreen.Hooks {
+		if d.Annotations[BlueGreenHookAnnotationPrefix+hook] != hash {
+			pending = append(pending, hook)
+		}
+	}
+	return pending
+}
+
+// setMachinesMaintenance annotates the machines of the given machine sets for maintenance or removes the annotation.
+// The machine controller cordons and drains the nodes of machines under maintenance, and uncordons them once the
+// annotation is removed.
+func (dc *controller) setMachinesMaintenance(ctx context.Context, machineSets []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList, maintenance bool) error {
+	var value interface{}
+	if maintenance {
+		value = "true"
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{machineutils.MachineMaintenance: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, is := range machineSets {
+		machines := machineMap[is.UID]
+		if machines == nil {
+			continue
+		}
+		for i := range machines.Items {
+			machine := &machines.Items[i]
+			if machine.DeletionTimestamp != nil || (machine.Annotations[machineutils.MachineMaintenance] == "true") == maintenance {
+				continue
+			}
+			if err := dc.machineControl.PatchMachine(ctx, machine.Namespace, machine.Name, patch); err != nil {
+				return fmt.Errorf("failed to set maintenance=%t of machine %q: %v", maintenance, machine.Name, err)
+			}
+			klog.V(3).Infof("Set maintenance=%t of machine %q", maintenance, machine.Name)
+		}
+	}
+	return nil
+}
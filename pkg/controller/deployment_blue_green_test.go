@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("deployment_blue_green", func() {
+	const templateHash = "new-hash"
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	newBlueGreenMachines := func(count int, runningSince time.Time) *machinev1.MachineList {
+		machines := &machinev1.MachineList{}
+		for i := 0; i < count; i++ {
+			machines.Items = append(machines.Items, machinev1.Machine{
+				Status: machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{
+						Phase:          machinev1.MachineRunning,
+						LastUpdateTime: metav1.Time{Time: runningSince},
+					},
+				},
+			})
+		}
+		return machines
+	}
+
+	Describe("#syncBlueGreenStatus", func() {
+		type setup struct {
+			blueGreenStatus    *machinev1.BlueGreenStatus
+			annotations        map[string]string
+			hooks              []string
+			confirmationWindow *metav1.Duration
+			maintenanceWindow  *machinev1.MachineDeploymentMaintenanceWindow
+			runningMachines    int
+			runningSince       time.Duration
+			oldReplicas        int32
+		}
+		type expect struct {
+			phase           machinev1.BlueGreenPhase
+			switchTime      *metav1.Time
+			conditionReason string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d := &machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "md",
+						Namespace:   testNamespace,
+						Annotations: data.setup.annotations,
+					},
+					Spec: machinev1.MachineDeploymentSpec{
+						Replicas:        2,
+						MinReadySeconds: 300,
+						Strategy: machinev1.MachineDeploymentStrategy{
+							Type: machinev1.BlueGreenMachineDeploymentStrategyType,
+							BlueGreen: &machinev1.BlueGreenMachineDeployment{
+								Hooks:              data.setup.hooks,
+								ConfirmationWindow: data.setup.confirmationWindow,
+							},
+						},
+						MaintenanceWindow: data.setup.maintenanceWindow,
+					},
+					Status: machinev1.MachineDeploymentStatus{BlueGreen: data.setup.blueGreenStatus},
+				}
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d}, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				c.recorder = record.NewFakeRecorder(10)
+
+				newIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "new",
+						UID:    "new",
+						Labels: map[string]string{machinev1.DefaultMachineDeploymentUniqueLabelKey: templateHash},
+					},
+					Spec: machinev1.MachineSetSpec{Replicas: 2},
+				}
+				oldIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "old", UID: "old"},
+					Spec:       machinev1.MachineSetSpec{Replicas: data.setup.oldReplicas},
+					Status:     machinev1.MachineSetStatus{Replicas: data.setup.oldReplicas},
+				}
+				machineMap := map[types.UID]*machinev1.MachineList{
+					newIS.UID: newBlueGreenMachines(data.setup.runningMachines, now.Add(-data.setup.runningSince)),
+				}
+
+				updated, err := c.syncBlueGreenStatus(context.TODO(), d, newIS, []*machinev1.MachineSet{oldIS}, machineMap)
+				Expect(err).ToNot(HaveOccurred())
+
+				actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual.Annotations).ToNot(HaveKey(BlueGreenActionAnnotation))
+				for _, status := range []machinev1.MachineDeploymentStatus{updated.Status, actual.Status} {
+					Expect(status.BlueGreen).ToNot(BeNil())
+					Expect(status.BlueGreen.MachineTemplateHash).To(Equal(templateHash))
+					Expect(status.BlueGreen.Phase).To(Equal(data.expect.phase))
+					Expect(status.BlueGreen.SwitchTime.Equal(data.expect.switchTime)).To(BeTrue())
+					cond := GetMachineDeploymentCondition(status, machinev1.MachineDeploymentProgressing)
+					if data.expect.conditionReason == "" {
+						Expect(cond).To(BeNil())
+					} else {
+						Expect(cond).ToNot(BeNil())
+						Expect(cond.Reason).To(Equal(data.expect.conditionReason))
+					}
+				}
+			},
+			Entry("should complete at once without old machines", &data{
+				setup:  setup{},
+				expect: expect{phase: machinev1.BlueGreenCompleted},
+			}),
+			Entry("should provision while the new machines have not been running for minReadySeconds", &data{
+				setup:  setup{runningMachines: 2, runningSince: time.Minute, oldReplicas: 2},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+			Entry("should provision while not all new machines are running", &data{
+				setup:  setup{runningMachines: 1, runningSince: time.Hour, oldReplicas: 2},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+			Entry("should provision while a hook has not passed for the new machine set", &data{
+				setup: setup{
+					annotations:     map[string]string{BlueGreenHookAnnotationPrefix + "smoke-test": "old-hash"},
+					hooks:           []string{"smoke-test"},
+					runningMachines: 2,
+					runningSince:    time.Hour,
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+			Entry("should switch once the new machines are ready and the hooks have passed", &data{
+				setup: setup{
+					annotations:     map[string]string{BlueGreenHookAnnotationPrefix + "smoke-test": templateHash},
+					hooks:           []string{"smoke-test"},
+					runningMachines: 2,
+					runningSince:    time.Hour,
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitched, switchTime: &metav1.Time{Time: now}, conditionReason: BlueGreenSwitchedReason},
+			}),
+			Entry("should not switch outside of the maintenance window", &data{
+				setup: setup{
+					maintenanceWindow: &machinev1.MachineDeploymentMaintenanceWindow{
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}}},
+					},
+					runningMachines: 2,
+					runningSince:    time.Hour,
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+			Entry("should switch within the maintenance window", &data{
+				setup: setup{
+					maintenanceWindow: &machinev1.MachineDeploymentMaintenanceWindow{
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 11 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}}},
+					},
+					runningMachines: 2,
+					runningSince:    time.Hour,
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitched, switchTime: &metav1.Time{Time: now}, conditionReason: BlueGreenSwitchedReason},
+			}),
+			Entry("should wait for the confirmation window to pass", &data{
+				setup: setup{
+					blueGreenStatus:    &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitched, SwitchTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+					confirmationWindow: &metav1.Duration{Duration: 10 * time.Minute},
+					oldReplicas:        2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitched, switchTime: &metav1.Time{Time: now.Add(-time.Minute)}, conditionReason: BlueGreenSwitchedReason},
+			}),
+			Entry("should complete once the confirmation window has passed", &data{
+				setup: setup{
+					blueGreenStatus:    &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitched, SwitchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+					confirmationWindow: &metav1.Duration{Duration: 10 * time.Minute},
+					oldReplicas:        2,
+				},
+				expect: expect{phase: machinev1.BlueGreenCompleted, switchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+			}),
+			Entry("should wait for the confirmation without a confirmation window", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitched, SwitchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitched, switchTime: &metav1.Time{Time: now.Add(-time.Hour)}, conditionReason: BlueGreenSwitchedReason},
+			}),
+			Entry("should complete when confirmed", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitched, SwitchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+					annotations:     map[string]string{BlueGreenActionAnnotation: BlueGreenActionConfirm},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenCompleted, switchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+			}),
+			Entry("should switch back when requested", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitched, SwitchTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+					annotations:     map[string]string{BlueGreenActionAnnotation: BlueGreenActionSwitchBack},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitchedBack, conditionReason: BlueGreenSwitchedBackReason},
+			}),
+			Entry("should ignore a confirmation of a switched back update", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitchedBack},
+					annotations:     map[string]string{BlueGreenActionAnnotation: BlueGreenActionConfirm},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenSwitchedBack, conditionReason: BlueGreenSwitchedBackReason},
+			}),
+			Entry("should provision again when retried", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: machinev1.BlueGreenSwitchedBack},
+					annotations:     map[string]string{BlueGreenActionAnnotation: BlueGreenActionRetry},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+			Entry("should restart the update for a changed machine template", &data{
+				setup: setup{
+					blueGreenStatus: &machinev1.BlueGreenStatus{MachineTemplateHash: "old-hash", Phase: machinev1.BlueGreenSwitchedBack},
+					oldReplicas:     2,
+				},
+				expect: expect{phase: machinev1.BlueGreenProvisioning},
+			}),
+		)
+	})
+
+	Describe("#scaleBlueGreen", func() {
+		type expect struct {
+			newReplicas int32
+			oldReplicas int32
+		}
+
+		DescribeTable("##table",
+			func(phase machinev1.BlueGreenPhase, expect expect) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d := &machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace},
+					Spec: machinev1.MachineDeploymentSpec{
+						Replicas: 3,
+						Strategy: machinev1.MachineDeploymentStrategy{Type: machinev1.BlueGreenMachineDeploymentStrategyType},
+					},
+					Status: machinev1.MachineDeploymentStatus{
+						BlueGreen: &machinev1.BlueGreenStatus{MachineTemplateHash: templateHash, Phase: phase},
+					},
+				}
+				newISReplicas := int32(2)
+				if phase == machinev1.BlueGreenSwitchedBack {
+					newISReplicas = 0
+				}
+				annotations := map[string]string{DesiredReplicasAnnotation: "2", MaxReplicasAnnotation: "3"}
+				newIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "new",
+						Namespace:   testNamespace,
+						Labels:      map[string]string{machinev1.DefaultMachineDeploymentUniqueLabelKey: templateHash},
+						Annotations: annotations,
+					},
+					Spec: machinev1.MachineSetSpec{Replicas: newISReplicas},
+				}
+				oldIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: testNamespace, Annotations: annotations},
+					Spec:       machinev1.MachineSetSpec{Replicas: 2},
+				}
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d, newIS, oldIS}, nil, nil)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+				c.recorder = record.NewFakeRecorder(10)
+
+				Expect(c.scale(context.TODO(), d, newIS, []*machinev1.MachineSet{oldIS})).To(Succeed())
+
+				for name, replicas := range map[string]int32{newIS.Name: expect.newReplicas, oldIS.Name: expect.oldReplicas} {
+					actual, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(actual.Spec.Replicas).To(Equal(replicas), name)
+					desired, ok := GetDesiredReplicasAnnotation(actual)
+					Expect(ok).To(BeTrue())
+					Expect(desired).To(Equal(d.Spec.Replicas), name)
+				}
+			},
+			Entry("should scale the new and the old machine sets while provisioning", machinev1.BlueGreenProvisioning, expect{newReplicas: 3, oldReplicas: 3}),
+			Entry("should only scale the new machine set once switched", machinev1.BlueGreenSwitched, expect{newReplicas: 3, oldReplicas: 2}),
+			Entry("should only scale the old machine sets once switched back", machinev1.BlueGreenSwitchedBack, expect{newReplicas: 0, oldReplicas: 3}),
+		)
+	})
+
+	Describe("#setMachinesMaintenance", func() {
+		It("should put the machines of the old machine sets under maintenance and end it again", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machine := &machinev1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-old", Namespace: testNamespace}}
+			deletedMachine := &machinev1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-deleted", Namespace: testNamespace, DeletionTimestamp: &metav1.Time{Time: now}}}
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machine, deletedMachine}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			oldIS := &machinev1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "old", UID: "old"}}
+			machineMap := map[types.UID]*machinev1.MachineList{
+				oldIS.UID: {Items: []machinev1.Machine{*machine, *deletedMachine}},
+			}
+
+			Expect(c.setMachinesMaintenance(context.TODO(), []*machinev1.MachineSet{oldIS}, machineMap, true)).To(Succeed())
+			actual, err := c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Annotations).To(HaveKeyWithValue(machineutils.MachineMaintenance, "true"))
+			actual, err = c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), deletedMachine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Annotations).ToNot(HaveKey(machineutils.MachineMaintenance))
+
+			machineMap[oldIS.UID].Items[0].Annotations = map[string]string{machineutils.MachineMaintenance: "true"}
+			Expect(c.setMachinesMaintenance(context.TODO(), []*machinev1.MachineSet{oldIS}, machineMap, false)).To(Succeed())
+			actual, err = c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Annotations).ToNot(HaveKey(machineutils.MachineMaintenance))
+		})
+	})
+})
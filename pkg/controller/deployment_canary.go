@@ -0,0 +1,408 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package controller is used to provide the core functionalities of machine-controller-manager
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller/autoscaler"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CanaryActionAnnotation is used to manually promote or abort the canary update of a machine deployment.
+	// It is removed by the controller once the action is performed.
+	CanaryActionAnnotation = "deployment.machine.sapcloud.io/canary-action"
+	// CanaryActionPromote completes the current step of a canary update, or restarts an aborted canary update.
+	CanaryActionPromote = "promote"
+	// CanaryActionAbort moves all machines of a canary update back to the old machine template.
+	CanaryActionAbort = "abort"
+
+	// CanaryPausedReason is added in a deployment when its canary update is paused. Lack of progress shouldn't be
+	// estimated while a canary update is paused.
+	CanaryPausedReason = "CanaryPaused"
+	// CanaryAbortedReason is added in a deployment when its canary update is aborted. Lack of progress shouldn't be
+	// estimated once a canary update is aborted.
+	CanaryAbortedReason = "CanaryAborted"
+	// CanaryPromoted is the promoted canary update event reason
+	CanaryPromoted = "CanaryPromoted"
+	// CanaryAborted is the aborted canary update event reason
+	CanaryAborted = "CanaryAborted"
+	// CanaryActionInvalid is the invalid canary action event reason
+	CanaryActionInvalid = "CanaryActionInvalid"
+)
+
+// IsCanary returns true if the strategy type is a canary update.
+func IsCanary(deployment *v1alpha1.MachineDeployment) bool {
+	return deployment.Spec.Strategy.Type == v1alpha1.CanaryMachineDeploymentStrategyType
+}
+
+// rolloutCanary implements the logic for rolling a new machine set in explicit steps.
+func (dc *controller) rolloutCanary(ctx context.Context, d *v1alpha1.MachineDeployment, isList []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList) error {
+	clusterAutoscalerScaleDownAnnotations := make(map[string]string)
+	clusterAutoscalerScaleDownAnnotations[autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationKey] = autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationValue
+
+	// We do this to avoid accidentally deleting the user provided annotations.
+	clusterAutoscalerScaleDownAnnotations[autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMKey] = autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMValue
+
+	newIS, oldISs, err := dc.getAllMachineSetsAndSyncRevision(ctx, d, isList, machineMap, true)
+	if err != nil {
+		return err
+	}
+	allISs := append(oldISs, newIS)
+
+	if dc.autoscalerScaleDownAnnotationDuringRollout {
+		// Add the annotation on the all machinesets if there are any old-machinesets and not scaled-to-zero.
+		if len(oldISs) > 0 && !dc.machineSetsScaledToZero(oldISs) {
+			// Annotate all the nodes under this machine-deployment, as roll-out is on-going.
+			err := dc.annotateNodesBackingMachineSets(ctx, allISs, clusterAutoscalerScaleDownAnnotations)
+			if err != nil {
+				klog.Errorf("Failed to add %s on all nodes. Error: %s", clusterAutoscalerScaleDownAnnotations, err)
+				return err
+			}
+		}
+	}
+
+	d, paused, err := dc.syncCanaryStatus(ctx, d, newIS, oldISs)
+	if err != nil {
+		return err
+	}
+
+	// Without old machines there is nothing to protect, so the new machine set is scaled up completely.
+	oldMachinesExist := GetReplicaCountForMachineSets(oldISs) > 0 || GetActualReplicaCountForMachineSets(oldISs) > 0
+	target := d.Spec.Replicas
+	if oldMachinesExist {
+		target = CanaryNewISReplicas(d)
+	}
+
+	newIS, err = dc.scaleCanaryMachineSets(ctx, d, newIS, oldISs, target)
+	if err != nil {
+		return err
+	}
+	allISs = append(oldISs, newIS)
+
+	if MachineDeploymentComplete(d, &d.Status) {
+		if dc.autoscalerScaleDownAnnotationDuringRollout {
+			// Check if any of the machine under this MachineDeployment contains the by-mcm annotation, and
+			// remove the original autoscaler annotation only after.
+			err := dc.removeAutoscalerAnnotationsIfRequired(ctx, allISs, clusterAutoscalerScaleDownAnnotations)
+			if err != nil {
+				return err
+			}
+		}
+		if err := dc.cleanupMachineDeployment(ctx, oldISs, d); err != nil {
+			return err
+		}
+	}
+
+	// Lack of progress is not estimated while the canary update waits for a promotion or was aborted.
+	if paused || d.Status.Canary.Aborted {
+		return dc.syncMachineDeploymentStatus(ctx, allISs, newIS, d)
+	}
+	return dc.syncRolloutStatus(ctx, allISs, newIS, d)
+}
+
+// syncCanaryStatus performs the requested canary action, advances the canary update past all completed
+// steps and persists the resulting canary status along with the Progressing condition. It returns true
+// if the canary update is held by a pause step.
+func (dc *controller) syncCanaryStatus(ctx context.Context, d *v1alpha1.MachineDeployment, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet) (*v1alpha1.MachineDeployment, bool, error) {
+	hash := newIS.Labels[v1alpha1.DefaultMachineDeploymentUniqueLabelKey]
+
+	canaryStatus := &v1alpha1.CanaryStatus{MachineTemplateHash: hash}
+	if d.Status.Canary != nil && d.Status.Canary.MachineTemplateHash == hash {
+		canaryStatus = d.Status.Canary.DeepCopy()
+	}
+
+	if action, ok := d.Annotations[CanaryActionAnnotation]; ok {
+		// The annotation is removed first, so that an action is never performed twice.
+		updatedDeployment, err := dc.removeActionAnnotation(ctx, d, CanaryActionAnnotation)
+		if err != nil {
+			return d, false, err
+		}
+		d = updatedDeployment
+
+		switch action {
+		case CanaryActionPromote:
+			if canaryStatus.Aborted {
+				canaryStatus = &v1alpha1.CanaryStatus{MachineTemplateHash: hash}
+			} else if int(canaryStatus.CurrentStep) < len(d.Spec.Strategy.Canary.Steps) {
+				canaryStatus.CurrentStep++
+				canaryStatus.PauseStartTime = nil
+			}
+			dc.recorder.Eventf(d, v1.EventTypeNormal, CanaryPromoted, "Promoted canary update of machine set %q to step %d", newIS.Name, canaryStatus.CurrentStep)
+		case CanaryActionAbort:
+			canaryStatus.Aborted = true
+			canaryStatus.PauseStartTime = nil
+			dc.recorder.Eventf(d, v1.EventTypeNormal, CanaryAborted, "Aborted canary update of machine set %q at step %d", newIS.Name, canaryStatus.CurrentStep)
+		default:
+			dc.recorder.Eventf(d, v1.EventTypeWarning, CanaryActionInvalid, "Ignoring unknown canary action %q, expected %q or %q", action, CanaryActionPromote, CanaryActionAbort)
+		}
+	}
+
+	paused := false
+	if !canaryStatus.Aborted {
+		paused = dc.advanceCanarySteps(d, canaryStatus, newIS, oldISs)
+	}
+
+	newStatus := d.Status.DeepCopy()
+	newStatus.Canary = canaryStatus
+	cond := GetMachineDeploymentCondition(*newStatus, v1alpha1.MachineDeploymentProgressing)
+	switch {
+	case canaryStatus.Aborted:
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, CanaryAbortedReason, "Canary update is aborted")
+		SetMachineDeploymentCondition(newStatus, *condition)
+	case paused:
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, CanaryPausedReason, fmt.Sprintf("Canary update is paused at step %d", canaryStatus.CurrentStep))
+		SetMachineDeploymentCondition(newStatus, *condition)
+	case cond != nil && (cond.Reason == CanaryPausedReason || cond.Reason == CanaryAbortedReason):
+		// Progress is estimated again from now on.
+		condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, ResumedMachineDeployReason, "Canary update is resumed")
+		SetMachineDeploymentCondition(newStatus, *condition)
+	}
+
+	if apiequality.Semantic.DeepEqual(&d.Status, newStatus) {
+		return d, paused, nil
+	}
+
+	d.Status = *newStatus
+	updatedDeployment, err := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+	if err != nil {
+		return d, false, err
+	}
+	return updatedDeployment, paused, nil
+}
+
+// advanceCanarySteps moves the canary status past all completed steps. It returns true if the canary
+// update is held by a pause step.
+func (dc *controller) advanceCanarySteps(d *v1alpha1.MachineDeployment, canaryStatus *v1alpha1.CanaryStatus, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet) bool {
+	steps := d.Spec.Strategy.Canary.Steps
+	for int(canaryStatus.CurrentStep) < len(steps) {
+		step := steps[canaryStatus.CurrentStep]
+
+		if step.Pause == nil {
+			target := canaryStepReplicas(d, step.Replicas)
+			if newIS.Spec.Replicas < target || newIS.Status.AvailableReplicas < target || GetReplicaCountForMachineSets(oldISs) > d.Spec.Replicas-target {
+				return false
+			}
+			canaryStatus.CurrentStep++
+			continue
+		}
+
+		if newIS.Status.AvailableReplicas < newIS.Spec.Replicas {
+			// The new machines have to be available for the whole pause.
+			canaryStatus.PauseStartTime = nil
+			return true
+		}
+		now := dc.clock.Now()
+		if canaryStatus.PauseStartTime == nil {
+			canaryStatus.PauseStartTime = &metav1.Time{Time: now}
+		}
+		if step.Pause.Duration == nil {
+			return true
+		}
+		if remaining := canaryStatus.PauseStartTime.Add(step.Pause.Duration.Duration).Sub(now); remaining > 0 {
+			dc.enqueueMachineDeploymentAfter(d, remaining)
+			return true
+		}
+		canaryStatus.CurrentStep++
+		canaryStatus.PauseStartTime = nil
+	}
+	return false
+}
+
+// removeActionAnnotation removes the given action annotation from the given deployment.
+func (dc *controller) removeActionAnnotation(ctx context.Context, d *v1alpha1.MachineDeployment, annotation string) (*v1alpha1.MachineDeployment, error) {
+	dCopy := d.DeepCopy()
+	delete(dCopy.Annotations, annotation)
+	updatedDeployment, err := dc.controlMachineClient.MachineDeployments(dCopy.Namespace).Update(ctx, dCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// Keep the status which might not be persisted yet.
+	updatedDeployment.Status = d.Status
+	return updatedDeployment, nil
+}
+
+// CanaryNewISReplicas returns the number of replicas the new machine set of a canary update should have.
+func CanaryNewISReplicas(d *v1alpha1.MachineDeployment) int32 {
+	canaryStatus := d.Status.Canary
+	if canaryStatus == nil {
+		return 0
+	}
+	if canaryStatus.Aborted {
+		return 0
+	}
+
+	steps := d.Spec.Strategy.Canary.Steps
+	if int(canaryStatus.CurrentStep) >= len(steps) {
+		return d.Spec.Replicas
+	}
+	// The replicas of the latest replicas step up to and including the current one apply.
+	for i := canaryStatus.CurrentStep; i >= 0; i-- {
+		if steps[i].Replicas != nil {
+			return canaryStepReplicas(d, steps[i].Replicas)
+		}
+	}
+	return 0
+}
+
+// canaryStepReplicas resolves the replicas of a canary step against the desired replicas of the deployment.
+func canaryStepReplicas(d *v1alpha1.MachineDeployment, replicas *intstrutil.IntOrString) int32 {
+	// Error caught by validation
+	value, _ := intstrutil.GetScaledValueFromIntOrPercent(replicas, int(d.Spec.Replicas), true)
+	switch {
+	case value < 0:
+		return 0
+	case value > int(d.Spec.Replicas):
+		return d.Spec.Replicas
+	}
+	return int32(value) // #nosec G115 (CWE-190) -- value is bounded by the replicas of the deployment
+}
+
+// scaleCanaryMachineSets moves the machine sets of a canary update towards the given number of new machines, with
+// the remaining desired machines on the old machine sets. Like in a rolling update, machines are only added within
+// maxSurge and only removed as far as at most maxUnavailable machines are unavailable. This applies both when the
+// canary update moves forward and when an aborted canary update moves the machines back to the old machine sets, and
+// so does the maintenance window, which both scale-downs wait for.
+func (dc *controller) scaleCanaryMachineSets(ctx context.Context, d *v1alpha1.MachineDeployment, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet, target int32) (*v1alpha1.MachineSet, error) {
+	maxSurge, maxUnavailable := canaryFenceposts(d)
+	oldTarget := d.Spec.Replicas - target
+	newReplicas := newIS.Spec.Replicas
+	oldReplicas := GetReplicaCountForMachineSets(oldISs)
+
+	// Machines are added first, within maxSurge.
+	room := d.Spec.Replicas + maxSurge - newReplicas - oldReplicas
+	if newReplicas < target && room > 0 {
+		scaleUpCount := min(target-newReplicas, room)
+		newReplicas += scaleUpCount
+		room -= scaleUpCount
+	}
+	if oldReplicas < oldTarget && room > 0 {
+		scaleUpCount := min(oldTarget-oldReplicas, room)
+		if _, err := dc.scaleUpOldMachineSetsForCanary(ctx, oldISs, scaleUpCount, d); err != nil {
+			return newIS, err
+		}
+		oldReplicas += scaleUpCount
+	}
+
+	// Machines are removed as far as at most maxUnavailable machines are unavailable. Machines which are not yet
+	// available on the other side don't count as available.
+	minAvailable := d.Spec.Replicas - maxUnavailable
+	newUnavailable := max(0, newReplicas-newIS.Status.AvailableReplicas)
+	oldUnavailable := max(0, oldReplicas-GetAvailableReplicaCountForMachineSets(oldISs))
+	if newReplicas > target {
+		maxScaledDown := newReplicas + oldReplicas - minAvailable - oldUnavailable
+		if scaleDownCount := min(newReplicas-target, maxScaledDown); scaleDownCount > 0 && dc.isInMaintenanceWindowForCanary(d, "the new machine set") {
+			newReplicas -= scaleDownCount
+		}
+	}
+	if oldReplicas > oldTarget {
+		maxScaledDown := newReplicas + oldReplicas - minAvailable - newUnavailable
+		if scaleDownCount := min(oldReplicas-oldTarget, maxScaledDown); scaleDownCount > 0 {
+			if _, err := dc.scaleDownOldMachineSetsForCanary(ctx, oldISs, oldReplicas-scaleDownCount, d); err != nil {
+				return newIS, err
+			}
+		}
+	}
+
+	if newReplicas == newIS.Spec.Replicas {
+		return newIS, nil
+	}
+	scaled, updatedIS, err := dc.scaleMachineSetAndRecordEvent(ctx, newIS, newReplicas, d)
+	if err != nil || !scaled {
+		return newIS, err
+	}
+	return updatedIS, nil
+}
+
+// canaryFenceposts returns maxSurge and maxUnavailable of a canary update, which default to 1 and 0.
+func canaryFenceposts(d *v1alpha1.MachineDeployment) (int32, int32) {
+	maxSurge := intstrutil.FromInt32(1)
+	maxUnavailable := intstrutil.FromInt32(0)
+	if canary := d.Spec.Strategy.Canary; canary != nil {
+		if canary.MaxSurge != nil {
+			maxSurge = *canary.MaxSurge
+		}
+		if canary.MaxUnavailable != nil {
+			maxUnavailable = *canary.MaxUnavailable
+		}
+	}
+	// Error caught by validation
+	surge, unavailable, _ := ResolveFenceposts(&maxSurge, &maxUnavailable, d.Spec.Replicas)
+	return surge, min(unavailable, d.Spec.Replicas)
+}
+
+// isInMaintenanceWindowForCanary returns true if the canary update may scale down the given machine sets, which drains
+// their nodes. This applies to the old machine sets while the canary update moves forward as well as to the new machine
+// set when an aborted canary update moves the machines back. Outside of the maintenance window, the deployment is
+// requeued for when it opens.
+func (dc *controller) isInMaintenanceWindowForCanary(d *v1alpha1.MachineDeployment, machineSets string) bool {
+	inWindow, untilOpen := IsInMaintenanceWindow(d, dc.clock.Now())
+	if !inWindow {
+		klog.V(3).Infof("MachineDeployment %q is outside of its maintenance window, postponing scale down of %s", d.Name, machineSets)
+		if untilOpen > 0 {
+			dc.enqueueMachineDeploymentAfter(d, untilOpen)
+		}
+	}
+	return inWindow
+}
+
+// scaleDownOldMachineSetsForCanary scales down the old machine sets, oldest first, until they have
+// at most the given number of replicas in total.
+func (dc *controller) scaleDownOldMachineSetsForCanary(ctx context.Context, oldISs []*v1alpha1.MachineSet, oldReplicas int32, d *v1alpha1.MachineDeployment) (bool, error) {
+	excess := GetReplicaCountForMachineSets(oldISs) - oldReplicas
+	if excess <= 0 {
+		return false, nil
+	}
+
+	if !dc.isInMaintenanceWindowForCanary(d, "old machine sets") {
+		return false, nil
+	}
+
+	sortedISs := append([]*v1alpha1.MachineSet{}, oldISs...)
+	sort.Sort(MachineSetsByCreationTimestamp(sortedISs))
+
+	scaled := false
+	for _, is := range sortedISs {
+		if excess <= 0 {
+			break
+		}
+		if is.Spec.Replicas == 0 {
+			continue
+		}
+		scaleDownCount := min(is.Spec.Replicas, excess)
+		scaledIS, _, err := dc.scaleMachineSetAndRecordEvent(ctx, is, is.Spec.Replicas-scaleDownCount, d)
+		if err != nil {
+			return scaled, err
+		}
+		scaled = scaled || scaledIS
+		excess -= scaleDownCount
+	}
+	return scaled, nil
+}
+
+// scaleUpOldMachineSetsForCanary scales up the newest old machine set by the given number of replicas,
+// so that machines move back to the old machine template.
+func (dc *controller) scaleUpOldMachineSetsForCanary(ctx context.Context, oldISs []*v1alpha1.MachineSet, scaleUpCount int32, d *v1alpha1.MachineDeployment) (bool, error) {
+	if scaleUpCount <= 0 || len(oldISs) == 0 {
+		return false, nil
+	}
+
+	sortedISs := append([]*v1alpha1.MachineSet{}, oldISs...)
+	sort.Sort(MachineSetsByCreationTimestamp(sortedISs))
+	newestIS := sortedISs[len(sortedISs)-1]
+
+	scaled, _, err := dc.scaleMachineSetAndRecordEvent(ctx, newestIS, newestIS.Spec.Replicas+scaleUpCount, d)
+	return scaled, err
+}
@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("deployment_canary", func() {
+	const templateHash = "new-hash"
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	one := intstr.FromInt(1)
+	quarter := intstr.FromString("25%")
+	canarySteps := []machinev1.CanaryStep{
+		{Replicas: &one},
+		{Pause: &machinev1.CanaryPause{Duration: &metav1.Duration{Duration: 10 * time.Minute}}},
+		{Replicas: &quarter},
+		{Pause: &machinev1.CanaryPause{}},
+	}
+
+	newCanaryDeployment := func(canaryStatus *machinev1.CanaryStatus, annotations map[string]string) *machinev1.MachineDeployment {
+		return &machinev1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "md",
+				Namespace:   testNamespace,
+				Annotations: annotations,
+			},
+			Spec: machinev1.MachineDeploymentSpec{
+				Replicas: 8,
+				Strategy: machinev1.MachineDeploymentStrategy{
+					Type:   machinev1.CanaryMachineDeploymentStrategyType,
+					Canary: &machinev1.CanaryMachineDeployment{Steps: canarySteps},
+				},
+			},
+			Status: machinev1.MachineDeploymentStatus{Canary: canaryStatus},
+		}
+	}
+
+	Describe("#syncCanaryStatus", func() {
+		type setup struct {
+			canaryStatus    *machinev1.CanaryStatus
+			annotations     map[string]string
+			newReplicas     int32
+			newAvailable    int32
+			oldReplicas     int32
+			templateChanged bool
+		}
+		type expect struct {
+			currentStep     int32
+			aborted         bool
+			paused          bool
+			pauseStartTime  *metav1.Time
+			conditionReason string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d := newCanaryDeployment(data.setup.canaryStatus, data.setup.annotations)
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d}, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				c.recorder = record.NewFakeRecorder(10)
+
+				hash := templateHash
+				if data.setup.templateChanged {
+					hash = "newer-hash"
+				}
+				newIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "new",
+						Labels: map[string]string{machinev1.DefaultMachineDeploymentUniqueLabelKey: hash},
+					},
+					Spec:   machinev1.MachineSetSpec{Replicas: data.setup.newReplicas},
+					Status: machinev1.MachineSetStatus{AvailableReplicas: data.setup.newAvailable},
+				}
+				oldIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "old"},
+					Spec:       machinev1.MachineSetSpec{Replicas: data.setup.oldReplicas},
+				}
+
+				updated, paused, err := c.syncCanaryStatus(context.TODO(), d, newIS, []*machinev1.MachineSet{oldIS})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(paused).To(Equal(data.expect.paused))
+
+				actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual.Annotations).ToNot(HaveKey(CanaryActionAnnotation))
+				for _, status := range []machinev1.MachineDeploymentStatus{updated.Status, actual.Status} {
+					Expect(status.Canary).ToNot(BeNil())
+					Expect(status.Canary.MachineTemplateHash).To(Equal(hash))
+					Expect(status.Canary.CurrentStep).To(Equal(data.expect.currentStep))
+					Expect(status.Canary.Aborted).To(Equal(data.expect.aborted))
+					Expect(status.Canary.PauseStartTime.Equal(data.expect.pauseStartTime)).To(BeTrue())
+					cond := GetMachineDeploymentCondition(status, machinev1.MachineDeploymentProgressing)
+					if data.expect.conditionReason == "" {
+						Expect(cond).To(BeNil())
+					} else {
+						Expect(cond).ToNot(BeNil())
+						Expect(cond.Reason).To(Equal(data.expect.conditionReason))
+					}
+				}
+			},
+			Entry("should start a new canary update at the first step", &data{
+				setup:  setup{oldReplicas: 8},
+				expect: expect{currentStep: 0},
+			}),
+			Entry("should complete a replicas step once the new machines are available and old machines are removed", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash},
+					newReplicas:  1,
+					newAvailable: 1,
+					oldReplicas:  7,
+				},
+				expect: expect{currentStep: 1, paused: true, pauseStartTime: &metav1.Time{Time: now}, conditionReason: CanaryPausedReason},
+			}),
+			Entry("should not start the pause while new machines are unavailable", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 1, PauseStartTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+					newReplicas:  1,
+					oldReplicas:  7,
+				},
+				expect: expect{currentStep: 1, paused: true, conditionReason: CanaryPausedReason},
+			}),
+			Entry("should complete a pause step after its duration", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 1, PauseStartTime: &metav1.Time{Time: now.Add(-10 * time.Minute)}},
+					newReplicas:  1,
+					newAvailable: 1,
+					oldReplicas:  7,
+				},
+				expect: expect{currentStep: 2},
+			}),
+			Entry("should hold an untimed pause until it is promoted", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 3, PauseStartTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+					newReplicas:  2,
+					newAvailable: 2,
+					oldReplicas:  6,
+				},
+				expect: expect{currentStep: 3, paused: true, pauseStartTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}, conditionReason: CanaryPausedReason},
+			}),
+			Entry("should complete the current step on promote", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 3, PauseStartTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+					annotations:  map[string]string{CanaryActionAnnotation: CanaryActionPromote},
+					newReplicas:  2,
+					newAvailable: 2,
+					oldReplicas:  6,
+				},
+				expect: expect{currentStep: 4},
+			}),
+			Entry("should abort the canary update on abort", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 3, PauseStartTime: &metav1.Time{Time: now.Add(-24 * time.Hour)}},
+					annotations:  map[string]string{CanaryActionAnnotation: CanaryActionAbort},
+					newReplicas:  2,
+					newAvailable: 2,
+					oldReplicas:  6,
+				},
+				expect: expect{currentStep: 3, aborted: true, conditionReason: CanaryAbortedReason},
+			}),
+			Entry("should restart an aborted canary update on promote", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 3, Aborted: true},
+					annotations:  map[string]string{CanaryActionAnnotation: CanaryActionPromote},
+					oldReplicas:  8,
+				},
+				expect: expect{currentStep: 0},
+			}),
+			Entry("should ignore unknown actions", &data{
+				setup: setup{
+					canaryStatus: &machinev1.CanaryStatus{MachineTemplateHash: templateHash},
+					annotations:  map[string]string{CanaryActionAnnotation: "skip"},
+					oldReplicas:  8,
+				},
+				expect: expect{currentStep: 0},
+			}),
+			Entry("should restart the canary update when the machine template changed", &data{
+				setup: setup{
+					canaryStatus:    &machinev1.CanaryStatus{MachineTemplateHash: templateHash, CurrentStep: 4},
+					oldReplicas:     8,
+					templateChanged: true,
+				},
+				expect: expect{currentStep: 0},
+			}),
+		)
+	})
+
+	Describe("#scaleCanaryMachineSets", func() {
+		two := intstr.FromInt(2)
+		zero := intstr.FromInt(0)
+
+		type setup struct {
+			maxSurge       *intstr.IntOrString
+			maxUnavailable *intstr.IntOrString
+			newReplicas    int32
+			newAvailable   int32
+			oldReplicas    int32
+			oldAvailable   int32
+			target         int32
+			// outsideWindow puts the deployment outside of its maintenance window
+			outsideWindow bool
+		}
+		type expect struct {
+			newReplicas int32
+			oldReplicas int32
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d := newCanaryDeployment(&machinev1.CanaryStatus{MachineTemplateHash: templateHash}, nil)
+				d.Spec.Strategy.Canary.MaxSurge = data.setup.maxSurge
+				d.Spec.Strategy.Canary.MaxUnavailable = data.setup.maxUnavailable
+				if data.setup.outsideWindow {
+					d.Spec.MaintenanceWindow = &machinev1.MachineDeploymentMaintenanceWindow{
+						// Opens at midnight on the 1st of January, closes a minute later
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}},
+					}
+				}
+				newIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: testNamespace},
+					Spec:       machinev1.MachineSetSpec{Replicas: data.setup.newReplicas},
+					Status:     machinev1.MachineSetStatus{AvailableReplicas: data.setup.newAvailable},
+				}
+				oldIS := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: testNamespace},
+					Spec:       machinev1.MachineSetSpec{Replicas: data.setup.oldReplicas},
+					Status:     machinev1.MachineSetStatus{AvailableReplicas: data.setup.oldAvailable},
+				}
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d, newIS, oldIS}, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				c.recorder = record.NewFakeRecorder(10)
+
+				updatedIS, err := c.scaleCanaryMachineSets(context.TODO(), d, newIS, []*machinev1.MachineSet{oldIS}, data.setup.target)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedIS.Spec.Replicas).To(Equal(data.expect.newReplicas))
+
+				actualNewIS, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), newIS.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actualNewIS.Spec.Replicas).To(Equal(data.expect.newReplicas))
+				actualOldIS, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), oldIS.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actualOldIS.Spec.Replicas).To(Equal(data.expect.oldReplicas))
+			},
+			Entry("should add new machines of a step within maxSurge", &data{
+				setup:  setup{oldReplicas: 8, oldAvailable: 8, target: 2},
+				expect: expect{newReplicas: 1, oldReplicas: 8},
+			}),
+			Entry("should remove old machines once the new machines are available", &data{
+				setup:  setup{newReplicas: 1, newAvailable: 1, oldReplicas: 8, oldAvailable: 8, target: 2},
+				expect: expect{newReplicas: 1, oldReplicas: 7},
+			}),
+			Entry("should not remove old machines while the new machines are unavailable", &data{
+				setup:  setup{newReplicas: 1, oldReplicas: 8, oldAvailable: 8, target: 2},
+				expect: expect{newReplicas: 1, oldReplicas: 8},
+			}),
+			Entry("should not jump to all replicas after the last step", &data{
+				setup:  setup{newReplicas: 2, newAvailable: 2, oldReplicas: 6, oldAvailable: 6, target: 8},
+				expect: expect{newReplicas: 3, oldReplicas: 6},
+			}),
+			Entry("should remove old machines within maxUnavailable without maxSurge", &data{
+				setup:  setup{maxSurge: &zero, maxUnavailable: &two, newReplicas: 2, newAvailable: 2, oldReplicas: 6, oldAvailable: 6, target: 8},
+				expect: expect{newReplicas: 2, oldReplicas: 4},
+			}),
+			Entry("should restore old machines of an aborted canary update within maxSurge", &data{
+				setup:  setup{newReplicas: 2, newAvailable: 2, oldReplicas: 6, oldAvailable: 6, target: 0},
+				expect: expect{newReplicas: 2, oldReplicas: 7},
+			}),
+			Entry("should keep new machines of an aborted canary update until the old machines are available", &data{
+				setup:  setup{newReplicas: 2, newAvailable: 2, oldReplicas: 7, oldAvailable: 6, target: 0},
+				expect: expect{newReplicas: 2, oldReplicas: 7},
+			}),
+			Entry("should remove new machines of an aborted canary update once the old machines are available", &data{
+				setup:  setup{newReplicas: 2, newAvailable: 2, oldReplicas: 7, oldAvailable: 7, target: 0},
+				expect: expect{newReplicas: 1, oldReplicas: 7},
+			}),
+			Entry("should not remove old machines outside of the maintenance window", &data{
+				setup:  setup{newReplicas: 1, newAvailable: 1, oldReplicas: 8, oldAvailable: 8, target: 2, outsideWindow: true},
+				expect: expect{newReplicas: 1, oldReplicas: 8},
+			}),
+			Entry("should not remove new machines of an aborted canary update outside of the maintenance window", &data{
+				setup:  setup{newReplicas: 2, newAvailable: 2, oldReplicas: 7, oldAvailable: 7, target: 0, outsideWindow: true},
+				expect: expect{newReplicas: 2, oldReplicas: 7},
+			}),
+		)
+	})
+
+	Describe("#CanaryNewISReplicas", func() {
+		DescribeTable("##table",
+			func(canaryStatus *machinev1.CanaryStatus, expected int32) {
+				Expect(CanaryNewISReplicas(newCanaryDeployment(canaryStatus, nil))).To(Equal(expected))
+			},
+			Entry("no canary status", nil, int32(0)),
+			Entry("first replicas step", &machinev1.CanaryStatus{CurrentStep: 0}, int32(1)),
+			Entry("pause after first replicas step", &machinev1.CanaryStatus{CurrentStep: 1}, int32(1)),
+			Entry("percentage replicas step", &machinev1.CanaryStatus{CurrentStep: 2}, int32(2)),
+			Entry("all steps completed", &machinev1.CanaryStatus{CurrentStep: 4}, int32(8)),
+			Entry("aborted", &machinev1.CanaryStatus{CurrentStep: 2, Aborted: true}, int32(0)),
+		)
+	})
+})
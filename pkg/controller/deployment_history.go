@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MachineDeploymentRevision describes a revision of a MachineDeployment, i.e. one of its machine sets.
+type MachineDeploymentRevision struct {
+	// Revision is the revision number of the machine set.
+	Revision int64 `json:"revision"`
+	// MachineSetName is the name of the machine set.
+	MachineSetName string `json:"machineSetName"`
+	// CreationTimestamp is the creation time of the machine set.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Class is the machine class of the machine template.
+	Class v1alpha1.ClassSpec `json:"class"`
+	// NodeTemplate summarizes the node template of the machine template.
+	NodeTemplate string `json:"nodeTemplate,omitempty"`
+	// MachineConfiguration summarizes the machine configuration of the machine template.
+	MachineConfiguration string `json:"machineConfiguration,omitempty"`
+	// Replicas is the number of desired replicas of the machine set.
+	Replicas int32 `json:"replicas"`
+	// AvailableReplicas is the number of available replicas of the machine set.
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// Complete is true if the rollout of the revision has completed.
+	Complete bool `json:"complete"`
+	// Template is the machine template of the machine set.
+	Template v1alpha1.MachineTemplateSpec `json:"template"`
+}
+
+// MachineTemplateChange is a change of a single field between two machine templates.
+type MachineTemplateChange struct {
+	// Path is the path of the changed field, e.g. spec.class.name.
+	Path string `json:"path"`
+	// From is the value of the field in the first template, nil if it is not set.
+	From interface{} `json:"from,omitempty"`
+	// To is the value of the field in the second template, nil if it is not set.
+	To interface{} `json:"to,omitempty"`
+}
+
+// MachineDeploymentHistory returns the revisions of the deployment, sorted by revision number, from
+// the machine sets controlled by it. Machine sets without a valid revision are skipped.
+func MachineDeploymentHistory(deployment *v1alpha1.MachineDeployment, isList []*v1alpha1.MachineSet) []MachineDeploymentRevision {
+	var history []MachineDeploymentRevision
+	for _, is := range isList {
+		if !metav1.IsControlledBy(is, deployment) {
+			continue
+		}
+		revision, err := Revision(is)
+		if err != nil || revision == 0 {
+			continue
+		}
+		_, complete := is.Annotations[RolloutCompleteAnnotation]
+		history = append(history, MachineDeploymentRevision{
+			Revision:             revision,
+			MachineSetName:       is.Name,
+			CreationTimestamp:    is.CreationTimestamp,
+			Class:                is.Spec.Template.Spec.Class,
+			NodeTemplate:         summarizeNodeTemplate(&is.Spec.Template.Spec.NodeTemplateSpec),
+			MachineConfiguration: summarizeMachineConfiguration(is.Spec.Template.Spec.MachineConfiguration),
+			Replicas:             is.Spec.Replicas,
+			AvailableReplicas:    is.Status.AvailableReplicas,
+			Complete:             complete,
+			Template:             is.Spec.Template,
+		})
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].Revision < history[j].Revision })
+	return history
+}
+
+// MachineDeploymentRolloutStatus returns a message describing the state of the rollout of the deployment
+// and whether the rollout is done. An error is returned if the rollout exceeded its progress deadline.
+func MachineDeploymentRolloutStatus(deployment *v1alpha1.MachineDeployment) (string, bool, error) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return fmt.Sprintf("Waiting for machine deployment %q spec update to be observed...", deployment.Name), false, nil
+	}
+	if condition := GetMachineDeploymentCondition(deployment.Status, v1alpha1.MachineDeploymentProgressing); condition != nil && condition.Reason == TimedOutReason {
+		return "", false, fmt.Errorf("machine deployment %q exceeded its progress deadline", deployment.Name)
+	}
+
+	var waiting string
+	switch status := deployment.Status; {
+	case status.UpdatedReplicas < deployment.Spec.Replicas:
+		waiting = fmt.Sprintf("%d out of %d new machines have been updated", status.UpdatedReplicas, deployment.Spec.Replicas)
+	case status.Replicas > status.UpdatedReplicas:
+		waiting = fmt.Sprintf("%d old machines are pending termination", status.Replicas-status.UpdatedReplicas)
+	case status.AvailableReplicas < status.UpdatedReplicas:
+		waiting = fmt.Sprintf("%d of %d updated machines are available", status.AvailableReplicas, status.UpdatedReplicas)
+	default:
+		return fmt.Sprintf("machine deployment %q successfully rolled out", deployment.Name), true, nil
+	}
+	if IsMachineDeploymentFrozen(deployment) {
+		waiting += ", the machine deployment is frozen"
+	}
+	return fmt.Sprintf("Waiting for machine deployment %q rollout to finish: %s...", deployment.Name, waiting), false, nil
+}
+
+// DiffMachineDeploymentRevisions returns the changes of the machine template between the given revisions
+// of the history. A to revision of 0 refers to the latest revision.
+func DiffMachineDeploymentRevisions(history []MachineDeploymentRevision, from, to int64) ([]MachineTemplateChange, error) {
+	if to == 0 && len(history) > 0 {
+		to = history[len(history)-1].Revision
+	}
+	var fromTemplate, toTemplate *v1alpha1.MachineTemplateSpec
+	for i := range history {
+		if history[i].Revision == from {
+			fromTemplate = &history[i].Template
+		}
+		if history[i].Revision == to {
+			toTemplate = &history[i].Template
+		}
+	}
+	if fromTemplate == nil {
+		return nil, fmt.Errorf("revision %d not found", from)
+	}
+	if toTemplate == nil {
+		return nil, fmt.Errorf("revision %d not found", to)
+	}
+	return DiffMachineTemplates(fromTemplate, toTemplate)
+}
+
+// DiffMachineTemplates returns the changed fields between two machine templates, sorted by path.
+// The machine template hash label is ignored.
+func DiffMachineTemplates(from, to *v1alpha1.MachineTemplateSpec) ([]MachineTemplateChange, error) {
+	fromValues, err := templateToUnstructured(from)
+	if err != nil {
+		return nil, err
+	}
+	toValues, err := templateToUnstructured(to)
+	if err != nil {
+		return nil, err
+	}
+	var changes []MachineTemplateChange
+	diffValues("", fromValues, toValues, &changes)
+	return changes, nil
+}
+
+func templateToUnstructured(template *v1alpha1.MachineTemplateSpec) (map[string]interface{}, error) {
+	t := template.DeepCopy()
+	delete(t.Labels, v1alpha1.DefaultMachineDeploymentUniqueLabelKey)
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(t)
+}
+
+func diffValues(path string, from, to interface{}, changes *[]MachineTemplateChange) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if (fromIsMap || from == nil) && (toIsMap || to == nil) && (fromIsMap || toIsMap) {
+		keys := make([]string, 0, len(fromMap)+len(toMap))
+		for k := range fromMap {
+			keys = append(keys, k)
+		}
+		for k := range toMap {
+			if _, ok := fromMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffValues(joinPath(path, k), fromMap[k], toMap[k], changes)
+		}
+		return
+	}
+
+	fromSlice, fromIsSlice := from.([]interface{})
+	toSlice, toIsSlice := to.([]interface{})
+	if fromIsSlice && toIsSlice {
+		for i := 0; i < len(fromSlice) || i < len(toSlice); i++ {
+			var f, t interface{}
+			if i < len(fromSlice) {
+				f = fromSlice[i]
+			}
+			if i < len(toSlice) {
+				t = toSlice[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), f, t, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, MachineTemplateChange{Path: path, From: from, To: to})
+	}
+}
+
+// joinPath appends the key to the path, keys like label names which contain dots are put in brackets.
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		return path + "[" + key + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func summarizeNodeTemplate(nodeTemplate *v1alpha1.NodeTemplateSpec) string {
+	var parts []string
+	if len(nodeTemplate.Labels) > 0 {
+		parts = append(parts, "labels="+joinSorted(nodeTemplate.Labels))
+	}
+	if len(nodeTemplate.Annotations) > 0 {
+		parts = append(parts, "annotations="+joinSorted(nodeTemplate.Annotations))
+	}
+	if len(nodeTemplate.Spec.Taints) > 0 {
+		taints := make([]string, 0, len(nodeTemplate.Spec.Taints))
+		for _, taint := range nodeTemplate.Spec.Taints {
+			taints = append(taints, taint.ToString())
+		}
+		parts = append(parts, "taints="+strings.Join(taints, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+func summarizeMachineConfiguration(machineConfiguration *v1alpha1.MachineConfiguration) string {
+	if machineConfiguration == nil {
+		return ""
+	}
+	var parts []string
+	if machineConfiguration.MachineDrainTimeout != nil {
+		parts = append(parts, "drainTimeout="+machineConfiguration.MachineDrainTimeout.Duration.String())
+	}
+	if machineConfiguration.MachineHealthTimeout != nil {
+		parts = append(parts, "healthTimeout="+machineConfiguration.MachineHealthTimeout.Duration.String())
+	}
+	if machineConfiguration.MachineCreationTimeout != nil {
+		parts = append(parts, "creationTimeout="+machineConfiguration.MachineCreationTimeout.Duration.String())
+	}
+	if machineConfiguration.MaxEvictRetries != nil {
+		parts = append(parts, fmt.Sprintf("maxEvictRetries=%d", *machineConfiguration.MaxEvictRetries))
+	}
+	if machineConfiguration.NodeConditions != nil {
+		parts = append(parts, "nodeConditions="+*machineConfiguration.NodeConditions)
+	}
+	return strings.Join(parts, " ")
+}
+
+func joinSorted(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("deployment_history", func() {
+	deployment := &machinev1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace, UID: "md-uid"},
+	}
+	newTemplate := func(machineClass string) machinev1.MachineTemplateSpec {
+		return machinev1.MachineTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+				"key": "value",
+				machinev1.DefaultMachineDeploymentUniqueLabelKey: machineClass,
+			}},
+			Spec: machinev1.MachineSpec{
+				Class: machinev1.ClassSpec{Kind: "MachineClass", Name: machineClass},
+				NodeTemplateSpec: machinev1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"node.kubernetes.io/role": "worker"}},
+					Spec:       corev1.NodeSpec{Taints: []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}},
+				},
+				MachineConfiguration: &machinev1.MachineConfiguration{
+					MachineDrainTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+					MaxEvictRetries:     ptr.To[int32](10),
+				},
+			},
+		}
+	}
+	newMachineSet := func(name, revision string, template machinev1.MachineTemplateSpec, owner *machinev1.MachineDeployment) *machinev1.MachineSet {
+		is := &machinev1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   testNamespace,
+				Annotations: map[string]string{RevisionAnnotation: revision},
+			},
+			Spec: machinev1.MachineSetSpec{Replicas: 3, Template: template},
+		}
+		if owner != nil {
+			is.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, machinev1.SchemeGroupVersion.WithKind("MachineDeployment"))}
+		}
+		return is
+	}
+
+	Describe("#MachineDeploymentHistory", func() {
+		It("should list the revisions of the deployment sorted by revision", func() {
+			completed := newMachineSet("md-1", "1", newTemplate("class-a"), deployment)
+			completed.Annotations[RolloutCompleteAnnotation] = "true"
+			completed.Spec.Replicas = 0
+			current := newMachineSet("md-3", "3", newTemplate("class-b"), deployment)
+			current.Status.AvailableReplicas = 2
+			other := newMachineSet("other-2", "2", newTemplate("class-c"), &machinev1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "other", UID: "other-uid"}})
+			orphan := newMachineSet("orphan", "4", newTemplate("class-d"), nil)
+
+			history := MachineDeploymentHistory(deployment, []*machinev1.MachineSet{current, other, completed, orphan})
+			Expect(history).To(HaveLen(2))
+
+			Expect(history[0].Revision).To(Equal(int64(1)))
+			Expect(history[0].MachineSetName).To(Equal("md-1"))
+			Expect(history[0].Class.Name).To(Equal("class-a"))
+			Expect(history[0].Replicas).To(Equal(int32(0)))
+			Expect(history[0].Complete).To(BeTrue())
+
+			Expect(history[1].Revision).To(Equal(int64(3)))
+			Expect(history[1].MachineSetName).To(Equal("md-3"))
+			Expect(history[1].Replicas).To(Equal(int32(3)))
+			Expect(history[1].AvailableReplicas).To(Equal(int32(2)))
+			Expect(history[1].Complete).To(BeFalse())
+			Expect(history[1].NodeTemplate).To(Equal("labels=node.kubernetes.io/role=worker taints=dedicated=gpu:NoSchedule"))
+			Expect(history[1].MachineConfiguration).To(Equal("drainTimeout=2h0m0s maxEvictRetries=10"))
+		})
+	})
+
+	Describe("#DiffMachineDeploymentRevisions", func() {
+		changed := newTemplate("class-b")
+		changed.Labels["team"] = "infra"
+		changed.Spec.NodeTemplateSpec.Labels["node.kubernetes.io/role"] = "gpu-worker"
+		changed.Spec.NodeTemplateSpec.Spec.Taints = nil
+		changed.Spec.MachineDrainTimeout = &metav1.Duration{Duration: time.Hour}
+		history := MachineDeploymentHistory(deployment, []*machinev1.MachineSet{
+			newMachineSet("md-1", "1", newTemplate("class-a"), deployment),
+			newMachineSet("md-2", "2", newTemplate("class-a"), deployment),
+			newMachineSet("md-3", "3", changed, deployment),
+		})
+
+		DescribeTable("##table",
+			func(from, to int64, expected []MachineTemplateChange, expectErr bool) {
+				changes, err := DiffMachineDeploymentRevisions(history, from, to)
+				if expectErr {
+					Expect(err).To(HaveOccurred())
+					return
+				}
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changes).To(Equal(expected))
+			},
+			Entry("no changes", int64(1), int64(2), nil, false),
+			Entry("changes to the latest revision", int64(1), int64(0), []MachineTemplateChange{
+				{Path: "metadata.labels.team", To: "infra"},
+				{Path: "spec.class.name", From: "class-a", To: "class-b"},
+				{Path: "spec.drainTimeout", From: "2h0m0s", To: "1h0m0s"},
+				{Path: "spec.nodeTemplate.metadata.labels[node.kubernetes.io/role]", From: "worker", To: "gpu-worker"},
+				{Path: "spec.nodeTemplate.spec.taints", From: []interface{}{map[string]interface{}{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"}}},
+			}, false),
+			Entry("unknown revision", int64(1), int64(5), nil, true),
+		)
+	})
+
+	Describe("#MachineDeploymentRolloutStatus", func() {
+		DescribeTable("##table",
+			func(spec machinev1.MachineDeploymentSpec, status machinev1.MachineDeploymentStatus, expectedMessage string, expectedDone, expectErr bool) {
+				message, done, err := MachineDeploymentRolloutStatus(&machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "md", Generation: 2},
+					Spec:       spec,
+					Status:     status,
+				})
+				if expectErr {
+					Expect(err).To(HaveOccurred())
+					return
+				}
+				Expect(err).ToNot(HaveOccurred())
+				Expect(message).To(Equal(expectedMessage))
+				Expect(done).To(Equal(expectedDone))
+			},
+			Entry("spec update not observed", machinev1.MachineDeploymentSpec{Replicas: 3},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 1},
+				`Waiting for machine deployment "md" spec update to be observed...`, false, false),
+			Entry("progress deadline exceeded", machinev1.MachineDeploymentSpec{Replicas: 3},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 2, Conditions: []machinev1.MachineDeploymentCondition{
+					{Type: machinev1.MachineDeploymentProgressing, Status: machinev1.ConditionFalse, Reason: TimedOutReason},
+				}}, "", false, true),
+			Entry("machines not updated", machinev1.MachineDeploymentSpec{Replicas: 3},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1},
+				`Waiting for machine deployment "md" rollout to finish: 1 out of 3 new machines have been updated...`, false, false),
+			Entry("old machines pending termination of a frozen deployment", machinev1.MachineDeploymentSpec{Replicas: 3, Frozen: true},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 3},
+				`Waiting for machine deployment "md" rollout to finish: 1 old machines are pending termination, the machine deployment is frozen...`, false, false),
+			Entry("updated machines not available", machinev1.MachineDeploymentSpec{Replicas: 3},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+				`Waiting for machine deployment "md" rollout to finish: 2 of 3 updated machines are available...`, false, false),
+			Entry("rolled out", machinev1.MachineDeploymentSpec{Replicas: 3},
+				machinev1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+				`machine deployment "md" successfully rolled out`, true, false),
+		)
+	})
+})
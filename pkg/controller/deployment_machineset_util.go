@@ -46,9 +46,11 @@ func updateMachineSetStatus(ctx context.Context, machineClient machineapi.Machin
 		is.Status.FullyLabeledReplicas == newStatus.FullyLabeledReplicas &&
 		is.Status.ReadyReplicas == newStatus.ReadyReplicas &&
 		is.Status.AvailableReplicas == newStatus.AvailableReplicas &&
+		is.Status.StandbyReplicas == newStatus.StandbyReplicas &&
 		is.Generation == is.Status.ObservedGeneration &&
 		reflect.DeepEqual(is.Status.Conditions, newStatus.Conditions) &&
-		reflect.DeepEqual(is.Status.FailedMachines, newStatus.FailedMachines) {
+		reflect.DeepEqual(is.Status.FailedMachines, newStatus.FailedMachines) &&
+		reflect.DeepEqual(is.Status.ClassFallback, newStatus.ClassFallback) {
 		return is, nil
 	}
 
@@ -69,7 +71,7 @@ func updateMachineSetStatus(ctx context.Context, machineClient machineapi.Machin
 			fmt.Sprintf("sequence No: %v->%v", is.Status.ObservedGeneration, newStatus.ObservedGeneration))
 
 		is.Status = newStatus
-		updatedIS, updateErr = c.UpdateStatus(ctx, is, metav1.UpdateOptions{})
+		updatedIS, updateErr = c.UpdateStatus(ctx, is, metav1.UpdateOptions{FieldManager: MachineSetFieldManager})
 
 		if updateErr == nil {
 			return updatedIS, nil
@@ -99,19 +101,25 @@ func calculateMachineSetStatus(is *v1alpha1.MachineSet, filteredMachines []*v1al
 	fullyLabeledReplicasCount := 0
 	readyReplicasCount := 0
 	availableReplicasCount := 0
+	standbyReplicasCount := 0
 
 	failedMachines := []v1alpha1.MachineSummary{}
 	var machineSummary v1alpha1.MachineSummary
 
 	templateLabel := labels.Set(is.Spec.Template.Labels).AsSelectorPreValidated()
 	for _, machine := range filteredMachines {
-		if templateLabel.Matches(labels.Set(machine.Labels)) {
-			fullyLabeledReplicasCount++
-		}
-		if isMachineAvailable(machine) {
-			availableReplicasCount++
-			if isMachineReady(machine) {
-				readyReplicasCount++
+		if isStandbyMachine(machine) {
+			// Machines of the warm pool are not counted as replicas
+			standbyReplicasCount++
+		} else {
+			if templateLabel.Matches(labels.Set(machine.Labels)) {
+				fullyLabeledReplicasCount++
+			}
+			if isMachineAvailable(machine) {
+				availableReplicasCount++
+				if isMachineReady(machine) {
+					readyReplicasCount++
+				}
 			}
 		}
 		if machine.Status.LastOperation.State == v1alpha1.MachineStateFailed {
@@ -139,7 +147,7 @@ func calculateMachineSetStatus(is *v1alpha1.MachineSet, filteredMachines []*v1al
 	failureCond := GetCondition(&is.Status, v1alpha1.MachineSetReplicaFailure)
 	if manageReplicasErr != nil && failureCond == nil {
 		var reason string
-		if diff := len(filteredMachines) - int(is.Spec.Replicas); diff < 0 {
+		if diff := len(filteredMachines) - standbyReplicasCount - int(is.Spec.Replicas); diff < 0 {
 			reason = "FailedCreate"
 		} else if diff > 0 {
 			reason = "FailedDelete"
@@ -150,10 +158,11 @@ func calculateMachineSetStatus(is *v1alpha1.MachineSet, filteredMachines []*v1al
 		RemoveCondition(&newStatus, v1alpha1.MachineSetReplicaFailure)
 	}
 
-	newStatus.Replicas = int32(len(filteredMachines))                 // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
-	newStatus.FullyLabeledReplicas = int32(fullyLabeledReplicasCount) // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
-	newStatus.ReadyReplicas = int32(readyReplicasCount)               // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
-	newStatus.AvailableReplicas = int32(availableReplicasCount)       // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
+	newStatus.Replicas = int32(len(filteredMachines) - standbyReplicasCount) // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
+	newStatus.FullyLabeledReplicas = int32(fullyLabeledReplicasCount)        // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
+	newStatus.ReadyReplicas = int32(readyReplicasCount)                      // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
+	newStatus.AvailableReplicas = int32(availableReplicasCount)              // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
+	newStatus.StandbyReplicas = int32(standbyReplicasCount)                  // #nosec  G115 (CWE-190) -- number of machines will not exceed MaxInt32
 	newStatus.LastOperation.LastUpdateTime = metav1.Now()
 	return newStatus
 }
@@ -113,7 +113,7 @@ func (dc *controller) syncRolloutStatus(ctx context.Context, allISs []*v1alpha1.
 
 	newDeployment := d
 	newDeployment.Status = newStatus
-	_, err := dc.controlMachineClient.MachineDeployments(newDeployment.Namespace).UpdateStatus(ctx, newDeployment, metav1.UpdateOptions{})
+	_, err := dc.controlMachineClient.MachineDeployments(newDeployment.Namespace).UpdateStatus(ctx, newDeployment, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 	return err
 }
 
@@ -33,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 )
 
@@ -140,6 +141,83 @@ func (dc *controller) updateMachineDeploymentAndClearRollbackTo(ctx context.Cont
 	return err
 }
 
+// syncAutoRollback marks the new machine set of a completed rollout and rolls a failing rollout back to
+// the last completed revision if the deployment has an auto rollback policy. It returns true if the
+// deployment has been rolled back.
+func (dc *controller) syncAutoRollback(ctx context.Context, d *v1alpha1.MachineDeployment, isList []*v1alpha1.MachineSet, machineMap map[types.UID]*v1alpha1.MachineList) (bool, error) {
+	newIS := FindNewMachineSet(d, isList)
+	if newIS == nil {
+		return false, nil
+	}
+	if _, ok := newIS.Annotations[RolloutCompleteAnnotation]; ok {
+		return false, nil
+	}
+	if MachineDeploymentComplete(d, &d.Status) {
+		isCopy := newIS.DeepCopy()
+		if isCopy.Annotations == nil {
+			isCopy.Annotations = make(map[string]string)
+		}
+		isCopy.Annotations[RolloutCompleteAnnotation] = "true"
+		_, err := dc.controlMachineClient.MachineSets(isCopy.Namespace).Update(ctx, isCopy, metav1.UpdateOptions{})
+		return false, err
+	}
+	if d.Spec.AutoRollback == nil {
+		return false, nil
+	}
+
+	var reason string
+	if cond := GetMachineDeploymentCondition(d.Status, v1alpha1.MachineDeploymentProgressing); cond != nil && cond.Reason == TimedOutReason {
+		reason = fmt.Sprintf("machine set %q has exceeded its progress deadline", newIS.Name)
+	} else if maxFailed := d.Spec.AutoRollback.MaxFailedMachines; maxFailed != nil {
+		// #nosec G115 -- number of machines will not exceed MaxInt32
+		if failed := int32(countFailedMachines(newIS, machineMap[newIS.UID])); failed > *maxFailed {
+			reason = fmt.Sprintf("%d machines of machine set %q have failed", failed, newIS.Name)
+		}
+	}
+	if reason == "" {
+		return false, nil
+	}
+
+	_, oldISs := FindOldMachineSets(d, isList)
+	var toRevision int64
+	for _, is := range oldISs {
+		if _, ok := is.Annotations[RolloutCompleteAnnotation]; !ok {
+			continue
+		}
+		if v, err := Revision(is); err == nil && v > toRevision {
+			toRevision = v
+		}
+	}
+	if toRevision == 0 {
+		dc.recorder.Eventf(d, v1.EventTypeWarning, AutoRollbackRevisionNotFound, "Unable to roll back automatically as %s: no completed revision found", reason)
+		return false, nil
+	}
+
+	klog.V(2).Infof("Rolling back machine deployment %q to revision %d as %s", d.Name, toRevision, reason)
+	dc.recorder.Eventf(d, v1.EventTypeWarning, AutoRollbackTriggered, "Rolling back to revision %d as %s", toRevision, reason)
+	d.Spec.RollbackTo = &v1alpha1.RollbackConfig{Revision: toRevision}
+	return true, dc.rollback(ctx, d, isList, machineMap)
+}
+
+// countFailedMachines returns the number of failed machines of the machine set, including the failed
+// machines which have already been replaced but are still reported in the machine set status.
+func countFailedMachines(is *v1alpha1.MachineSet, machines *v1alpha1.MachineList) int {
+	failed := sets.New[string]()
+	if is.Status.FailedMachines != nil {
+		for _, summary := range *is.Status.FailedMachines {
+			failed.Insert(summary.Name)
+		}
+	}
+	if machines != nil {
+		for _, machine := range machines.Items {
+			if machine.Status.CurrentStatus.Phase == v1alpha1.MachineFailed {
+				failed.Insert(machine.Name)
+			}
+		}
+	}
+	return failed.Len()
+}
+
 // removeTaintNodesBackingMachineSet removes taints from all nodes backing the machineSets
 func (dc *controller) removeTaintNodesBackingMachineSet(ctx context.Context, machineSet *v1alpha1.MachineSet, taint *v1.Taint) error {
 
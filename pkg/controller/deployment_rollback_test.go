@@ -6,12 +6,16 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("deployment_rollback", func() {
@@ -186,4 +190,152 @@ var _ = Describe("deployment_rollback", func() {
 			}),
 		)
 	})
+
+	Describe("#syncAutoRollback", func() {
+		type setup struct {
+			autoRollback     *machinev1.AutoRollbackPolicy
+			oldComplete      bool
+			complete         bool
+			timedOut         bool
+			failedMachines   int
+			newMachineFailed bool
+		}
+		type expect struct {
+			rolledBack    bool
+			newIsComplete bool
+			machineClass  string
+			event         string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		newTemplate := func(machineClass string) machinev1.MachineTemplateSpec {
+			return machinev1.MachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "value"}},
+				Spec: machinev1.MachineSpec{
+					Class: machinev1.ClassSpec{Kind: "MachineClass", Name: machineClass},
+				},
+			}
+		}
+		newMachineSet := func(name, revision, machineClass string, complete bool) *machinev1.MachineSet {
+			labels := map[string]string{"key": "value", machinev1.DefaultMachineDeploymentUniqueLabelKey: name}
+			is := &machinev1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   testNamespace,
+					UID:         types.UID(name),
+					Labels:      labels,
+					Annotations: map[string]string{RevisionAnnotation: revision},
+				},
+				Spec: machinev1.MachineSetSpec{
+					Replicas: 2,
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: newTemplate(machineClass),
+				},
+			}
+			is.Spec.Template.Labels = labels
+			if complete {
+				is.Annotations[RolloutCompleteAnnotation] = "true"
+			}
+			return is
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d := &machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace},
+					Spec: machinev1.MachineDeploymentSpec{
+						Replicas:                2,
+						Selector:                &metav1.LabelSelector{MatchLabels: map[string]string{"key": "value"}},
+						Template:                newTemplate("new-class"),
+						ProgressDeadlineSeconds: ptr.To[int32](600),
+						AutoRollback:            data.setup.autoRollback,
+					},
+				}
+				if data.setup.complete {
+					d.Status = machinev1.MachineDeploymentStatus{Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}
+				}
+				if data.setup.timedOut {
+					d.Status.Conditions = []machinev1.MachineDeploymentCondition{
+						*NewMachineDeploymentCondition(machinev1.MachineDeploymentProgressing, machinev1.ConditionFalse, TimedOutReason, ""),
+					}
+				}
+
+				oldIS := newMachineSet("old", "1", "old-class", data.setup.oldComplete)
+				newIS := newMachineSet("new", "2", "new-class", false)
+				if data.setup.failedMachines > 0 {
+					failedMachines := make([]machinev1.MachineSummary, 0, data.setup.failedMachines)
+					for i := 0; i < data.setup.failedMachines; i++ {
+						failedMachines = append(failedMachines, machinev1.MachineSummary{Name: fmt.Sprintf("failed-%d", i)})
+					}
+					newIS.Status.FailedMachines = &failedMachines
+				}
+				machineMap := map[types.UID]*machinev1.MachineList{newIS.UID: {}}
+				if data.setup.newMachineFailed {
+					machineMap[newIS.UID].Items = append(machineMap[newIS.UID].Items, machinev1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "failing"},
+						Status:     machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineFailed}},
+					})
+				}
+
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d, oldIS, newIS}, nil, nil)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+				recorder := record.NewFakeRecorder(10)
+				c.recorder = recorder
+
+				rolledBack, err := c.syncAutoRollback(context.TODO(), d, []*machinev1.MachineSet{oldIS, newIS}, machineMap)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rolledBack).To(Equal(data.expect.rolledBack))
+
+				actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual.Spec.Template.Spec.Class.Name).To(Equal(data.expect.machineClass))
+				Expect(actual.Spec.RollbackTo).To(BeNil())
+
+				actualIS, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), newIS.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				if data.expect.newIsComplete {
+					Expect(actualIS.Annotations).To(HaveKey(RolloutCompleteAnnotation))
+				} else {
+					Expect(actualIS.Annotations).ToNot(HaveKey(RolloutCompleteAnnotation))
+				}
+
+				if data.expect.event == "" {
+					Expect(recorder.Events).To(BeEmpty())
+				} else {
+					Expect(recorder.Events).To(Receive(ContainSubstring(data.expect.event)))
+				}
+			},
+			Entry("should mark the new machine set of a completed rollout", &data{
+				setup:  setup{complete: true},
+				expect: expect{newIsComplete: true, machineClass: "new-class"},
+			}),
+			Entry("should not roll back without auto rollback policy", &data{
+				setup:  setup{oldComplete: true, timedOut: true},
+				expect: expect{machineClass: "new-class"},
+			}),
+			Entry("should not roll back a progressing rollout", &data{
+				setup:  setup{autoRollback: &machinev1.AutoRollbackPolicy{MaxFailedMachines: ptr.To[int32](1)}, oldComplete: true, failedMachines: 1},
+				expect: expect{machineClass: "new-class"},
+			}),
+			Entry("should roll back a rollout exceeding its progress deadline", &data{
+				setup:  setup{autoRollback: &machinev1.AutoRollbackPolicy{}, oldComplete: true, timedOut: true},
+				expect: expect{rolledBack: true, machineClass: "old-class", event: AutoRollbackTriggered},
+			}),
+			Entry("should roll back a rollout with too many failed machines", &data{
+				setup:  setup{autoRollback: &machinev1.AutoRollbackPolicy{MaxFailedMachines: ptr.To[int32](1)}, oldComplete: true, failedMachines: 1, newMachineFailed: true},
+				expect: expect{rolledBack: true, machineClass: "old-class", event: AutoRollbackTriggered},
+			}),
+			Entry("should not roll back to a revision which has not completed", &data{
+				setup:  setup{autoRollback: &machinev1.AutoRollbackPolicy{}, timedOut: true},
+				expect: expect{machineClass: "new-class", event: AutoRollbackRevisionNotFound},
+			}),
+		)
+	})
 })
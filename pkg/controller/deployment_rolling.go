@@ -95,6 +95,17 @@ func (dc *controller) rolloutRolling(ctx context.Context, d *v1alpha1.MachineDep
 		return dc.syncRolloutStatus(ctx, allISs, newIS, d)
 	}
 
+	// Scale down old machine sets only within the maintenance window, as it drains their nodes.
+	if GetReplicaCountForMachineSets(oldISs) > 0 {
+		if inWindow, untilOpen := IsInMaintenanceWindow(d, dc.clock.Now()); !inWindow {
+			klog.V(3).Infof("MachineDeployment %q is outside of its maintenance window, postponing scale down of old machine sets", d.Name)
+			if untilOpen > 0 {
+				dc.enqueueMachineDeploymentAfter(d, untilOpen)
+			}
+			return dc.syncRolloutStatus(ctx, allISs, newIS, d)
+		}
+	}
+
 	// Scale down, if we can.
 	scaledDown, err := dc.reconcileOldMachineSets(ctx, allISs, FilterActiveMachineSets(oldISs), newIS, d)
 	if err != nil {
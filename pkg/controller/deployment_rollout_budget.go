@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// RolloutPriorityAnnotation is the priority of a machine deployment among the machine deployments waiting
+	// for a slot to roll out. Machine deployments with a higher priority are rolled out first.
+	RolloutPriorityAnnotation = "deployment.machine.sapcloud.io/rollout-priority"
+
+	// WaitingForRolloutSlotReason is added in a deployment when its rollout waits for a slot, because the
+	// maximum number of concurrent rollouts is reached. Lack of progress shouldn't be estimated while waiting.
+	WaitingForRolloutSlotReason = "WaitingForRolloutSlot"
+
+	// InvalidRolloutPriority is the reason of the event recorded when the rollout priority annotation of a deployment
+	// is not an integer and hence ignored.
+	InvalidRolloutPriority = "InvalidRolloutPriority"
+
+	// rolloutSlotRetryInterval is the interval after which a deployment waiting for a rollout slot is synced again.
+	rolloutSlotRetryInterval = 30 * time.Second
+)
+
+// rolloutCandidate is a machine deployment which waits for a slot to roll out a new machine set.
+type rolloutCandidate struct {
+	key          string
+	priority     int
+	waitingSince time.Time
+}
+
+// RolloutPriority returns the rollout priority of the deployment. It is zero if not set, and zero together with an
+// error if the annotation is not an integer.
+func RolloutPriority(deployment *v1alpha1.MachineDeployment) (int, error) {
+	value, ok := deployment.Annotations[RolloutPriorityAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("annotation %s is not an integer: %q", RolloutPriorityAnnotation, value)
+	}
+	return priority, nil
+}
+
+// rolloutWaitingSince returns the time since which the deployment waits for a rollout slot, or the given
+// time if it does not wait yet.
+func rolloutWaitingSince(deployment *v1alpha1.MachineDeployment, now time.Time) time.Time {
+	cond := GetMachineDeploymentCondition(deployment.Status, v1alpha1.MachineDeploymentProgressing)
+	if cond != nil && cond.Reason == WaitingForRolloutSlotReason {
+		return cond.LastTransitionTime.Time
+	}
+	return now
+}
+
+// rolloutState returns whether the deployment actively rolls out a new machine set and whether it needs
+// a rollout slot to do so. A deployment rolls out actively while its new machine set is not saturated and
+// either old machines exist or it has been granted a slot. A deployment needs a slot if its machine
+// template has changed and old machines exist, but no new machine set has been created yet.
+func rolloutState(d *v1alpha1.MachineDeployment, machineSets []*v1alpha1.MachineSet, granted bool) (active, needsSlot bool) {
+	if d.DeletionTimestamp != nil || d.Spec.Paused || IsZoneSpread(d) {
+		return false, false
+	}
+	newIS, oldISs := FindNewMachineSet(d, machineSets), FindOldMachineSetsWithMachines(d, machineSets)
+	oldMachinesExist := len(oldISs) > 0
+	if newIS == nil {
+		return granted && oldMachinesExist, !granted && oldMachinesExist
+	}
+	return !IsSaturated(d, newIS) && (oldMachinesExist || granted), false
+}
+
+// FindOldMachineSetsWithMachines returns the old machine sets of the deployment which have machines or
+// are supposed to have machines.
+func FindOldMachineSetsWithMachines(deployment *v1alpha1.MachineDeployment, isList []*v1alpha1.MachineSet) []*v1alpha1.MachineSet {
+	newIS := FindNewMachineSet(deployment, isList)
+	var oldISs []*v1alpha1.MachineSet
+	for _, is := range isList {
+		if is == newIS {
+			continue
+		}
+		if is.Spec.Replicas > 0 || is.Status.Replicas > 0 {
+			oldISs = append(oldISs, is)
+		}
+	}
+	return oldISs
+}
+
+// syncRolloutSlot checks whether the deployment may roll out a new machine set, if the number of concurrent
+// rollouts is limited. Deployments waiting for a slot are granted one in the order of their rollout priority
+// and the time since which they wait. A deployment which has to wait is marked with the WaitingForRolloutSlot
+// reason in its Progressing condition. It returns the updated deployment and whether it has to wait.
+func (dc *controller) syncRolloutSlot(ctx context.Context, d *v1alpha1.MachineDeployment, machineSets []*v1alpha1.MachineSet) (*v1alpha1.MachineDeployment, bool, error) {
+	if dc.maxConcurrentRollouts <= 0 {
+		return d, false, nil
+	}
+	key := d.Namespace + "/" + d.Name
+
+	waiting, err := dc.waitForRolloutSlot(d, key, machineSets)
+	if err != nil || !waiting {
+		return d, false, err
+	}
+
+	klog.V(3).Infof("MachineDeployment %q waits for one of %d rollout slots", d.Name, dc.maxConcurrentRollouts)
+	dc.enqueueMachineDeploymentAfter(d, rolloutSlotRetryInterval)
+
+	cond := GetMachineDeploymentCondition(d.Status, v1alpha1.MachineDeploymentProgressing)
+	if cond != nil && cond.Reason == WaitingForRolloutSlotReason {
+		return d, true, nil
+	}
+	dCopy := d.DeepCopy()
+	condition := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionUnknown, WaitingForRolloutSlotReason, fmt.Sprintf("Waiting for one of %d rollout slots", dc.maxConcurrentRollouts))
+	SetMachineDeploymentCondition(&dCopy.Status, *condition)
+	updated, err := dc.controlMachineClient.MachineDeployments(dCopy.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+	if err != nil {
+		return d, true, err
+	}
+	return updated, true, nil
+}
+
+// waitForRolloutSlot returns true if the deployment needs a rollout slot, but none is free for it. Slots
+// of deployments which do not roll out anymore are released.
+func (dc *controller) waitForRolloutSlot(d *v1alpha1.MachineDeployment, key string, machineSets []*v1alpha1.MachineSet) (bool, error) {
+	dc.rolloutSlotsLock.Lock()
+	defer dc.rolloutSlotsLock.Unlock()
+
+	ownActive, needsSlot := rolloutState(d, machineSets, dc.rolloutSlots[key])
+	if !ownActive {
+		delete(dc.rolloutSlots, key)
+	}
+	if !needsSlot {
+		return false, nil
+	}
+
+	deployments, err := dc.machineDeploymentLister.MachineDeployments(dc.namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	allMachineSets, err := dc.machineSetLister.MachineSets(dc.namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	machineSetsByOwner := make(map[types.UID][]*v1alpha1.MachineSet)
+	for _, is := range allMachineSets {
+		if controllerRef := metav1.GetControllerOf(is); controllerRef != nil {
+			machineSetsByOwner[controllerRef.UID] = append(machineSetsByOwner[controllerRef.UID], is)
+		}
+	}
+
+	now := dc.clock.Now()
+	active := 0
+	priority, err := RolloutPriority(d)
+	if err != nil {
+		klog.Warningf("Ignoring the rollout priority of MachineDeployment %q: %v", d.Name, err)
+		dc.recorder.Eventf(d, v1.EventTypeWarning, InvalidRolloutPriority, "Ignoring the rollout priority: %v", err)
+	}
+	candidates := []rolloutCandidate{{key: key, priority: priority, waitingSince: rolloutWaitingSince(d, now)}}
+	for _, deployment := range deployments {
+		deploymentKey := deployment.Namespace + "/" + deployment.Name
+		if deploymentKey == key {
+			continue
+		}
+		deploymentActive, deploymentNeedsSlot := rolloutState(deployment, machineSetsByOwner[deployment.UID], dc.rolloutSlots[deploymentKey])
+		switch {
+		case deploymentActive:
+			active++
+		case deploymentNeedsSlot:
+			// An invalid priority is reported by the sync of the deployment itself
+			deploymentPriority, _ := RolloutPriority(deployment)
+			candidates = append(candidates, rolloutCandidate{key: deploymentKey, priority: deploymentPriority, waitingSince: rolloutWaitingSince(deployment, now)})
+		}
+		if !deploymentActive {
+			delete(dc.rolloutSlots, deploymentKey)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].priority != candidates[j].priority {
+			return candidates[i].priority > candidates[j].priority
+		}
+		if !candidates[i].waitingSince.Equal(candidates[j].waitingSince) {
+			return candidates[i].waitingSince.Before(candidates[j].waitingSince)
+		}
+		return candidates[i].key < candidates[j].key
+	})
+	free := int(dc.maxConcurrentRollouts) - active
+	for i := 0; i < len(candidates) && i < free; i++ {
+		if candidates[i].key == key {
+			klog.V(3).Infof("Granting rollout slot to MachineDeployment %q, %d of %d slots in use", d.Name, active+1, dc.maxConcurrentRollouts)
+			dc.rolloutSlots[key] = true
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("deployment_rollout_budget", func() {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	const (
+		rolloutActive   = "active"
+		rolloutWaiting  = "waiting"
+		rolloutComplete = "complete"
+	)
+
+	type rollout struct {
+		name         string
+		state        string
+		priority     int
+		waitingSince time.Duration
+		granted      bool
+	}
+
+	newRolloutObjects := func(r rollout) (*machinev1.MachineDeployment, []*machinev1.MachineSet) {
+		d := &machinev1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        r.name,
+				Namespace:   testNamespace,
+				UID:         types.UID(r.name),
+				Annotations: map[string]string{},
+			},
+			Spec: machinev1.MachineDeploymentSpec{
+				Replicas: 2,
+				Template: machinev1.MachineTemplateSpec{Spec: machinev1.MachineSpec{Class: machinev1.ClassSpec{Name: "new"}}},
+			},
+		}
+		if r.priority != 0 {
+			d.Annotations[RolloutPriorityAnnotation] = strconv.Itoa(r.priority)
+		}
+		if r.waitingSince != 0 {
+			d.Status.Conditions = []machinev1.MachineDeploymentCondition{{
+				Type:               machinev1.MachineDeploymentProgressing,
+				Status:             machinev1.ConditionUnknown,
+				Reason:             WaitingForRolloutSlotReason,
+				LastTransitionTime: metav1.Time{Time: now.Add(-r.waitingSince)},
+			}}
+		}
+		newMachineSet := func(name, class string, replicas, available int32) *machinev1.MachineSet {
+			return &machinev1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              r.name + "-" + name,
+					Namespace:         testNamespace,
+					UID:               types.UID(r.name + "-" + name),
+					Annotations:       map[string]string{DesiredReplicasAnnotation: "2"},
+					OwnerReferences:   []metav1.OwnerReference{*metav1.NewControllerRef(d, machinev1.SchemeGroupVersion.WithKind("MachineDeployment"))},
+					CreationTimestamp: metav1.Time{Time: now.Add(-time.Hour)},
+				},
+				Spec: machinev1.MachineSetSpec{
+					Replicas: replicas,
+					Template: machinev1.MachineTemplateSpec{Spec: machinev1.MachineSpec{Class: machinev1.ClassSpec{Name: class}}},
+				},
+				Status: machinev1.MachineSetStatus{Replicas: replicas, AvailableReplicas: available},
+			}
+		}
+		switch r.state {
+		case rolloutActive:
+			return d, []*machinev1.MachineSet{newMachineSet("old", "old", 1, 1), newMachineSet("new", "new", 2, 1)}
+		case rolloutWaiting:
+			return d, []*machinev1.MachineSet{newMachineSet("old", "old", 2, 2)}
+		default:
+			return d, []*machinev1.MachineSet{newMachineSet("old", "old", 0, 0), newMachineSet("new", "new", 2, 2)}
+		}
+	}
+
+	Describe("#syncRolloutSlot", func() {
+		type setup struct {
+			maxConcurrentRollouts int32
+			deployment            rollout
+			others                []rollout
+		}
+		type expect struct {
+			waiting bool
+			slots   []string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				d, machineSets := newRolloutObjects(data.setup.deployment)
+				objects := []runtime.Object{d}
+				for _, is := range machineSets {
+					objects = append(objects, is)
+				}
+				for _, other := range data.setup.others {
+					otherDeployment, otherMachineSets := newRolloutObjects(other)
+					objects = append(objects, otherDeployment)
+					for _, is := range otherMachineSets {
+						objects = append(objects, is)
+					}
+				}
+
+				c, trackers := createController(stop, testNamespace, objects, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				c.maxConcurrentRollouts = data.setup.maxConcurrentRollouts
+				for _, r := range append([]rollout{data.setup.deployment}, data.setup.others...) {
+					if r.granted {
+						c.rolloutSlots[testNamespace+"/"+r.name] = true
+					}
+				}
+
+				updated, waiting, err := c.syncRolloutSlot(context.TODO(), d, machineSets)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(Equal(data.expect.waiting))
+
+				var slots []string
+				for key := range c.rolloutSlots {
+					slots = append(slots, key)
+				}
+				Expect(slots).To(ConsistOf(data.expect.slots))
+
+				if data.expect.waiting {
+					cond := GetMachineDeploymentCondition(updated.Status, machinev1.MachineDeploymentProgressing)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Reason).To(Equal(WaitingForRolloutSlotReason))
+				}
+			},
+			Entry("should not limit rollouts without a maximum", &data{
+				setup: setup{
+					deployment: rollout{name: "md", state: rolloutWaiting},
+					others:     []rollout{{name: "a", state: rolloutActive}},
+				},
+				expect: expect{},
+			}),
+			Entry("should grant a free slot", &data{
+				setup: setup{
+					maxConcurrentRollouts: 2,
+					deployment:            rollout{name: "md", state: rolloutWaiting},
+					others:                []rollout{{name: "a", state: rolloutActive}},
+				},
+				expect: expect{slots: []string{testNamespace + "/md"}},
+			}),
+			Entry("should wait while all slots are in use", &data{
+				setup: setup{
+					maxConcurrentRollouts: 1,
+					deployment:            rollout{name: "md", state: rolloutWaiting},
+					others:                []rollout{{name: "a", state: rolloutActive}},
+				},
+				expect: expect{waiting: true},
+			}),
+			Entry("should wait for deployments which have been waiting longer", &data{
+				setup: setup{
+					maxConcurrentRollouts: 2,
+					deployment:            rollout{name: "md", state: rolloutWaiting, waitingSince: time.Minute},
+					others:                []rollout{{name: "a", state: rolloutActive}, {name: "b", state: rolloutWaiting, waitingSince: time.Hour}},
+				},
+				expect: expect{waiting: true},
+			}),
+			Entry("should grant a slot to deployments with a higher priority first", &data{
+				setup: setup{
+					maxConcurrentRollouts: 2,
+					deployment:            rollout{name: "md", state: rolloutWaiting, priority: 10, waitingSince: time.Minute},
+					others:                []rollout{{name: "a", state: rolloutActive}, {name: "b", state: rolloutWaiting, waitingSince: time.Hour}},
+				},
+				expect: expect{slots: []string{testNamespace + "/md"}},
+			}),
+			Entry("should count deployments which have been granted a slot but not created their machine set yet", &data{
+				setup: setup{
+					maxConcurrentRollouts: 1,
+					deployment:            rollout{name: "md", state: rolloutWaiting},
+					others:                []rollout{{name: "a", state: rolloutWaiting, granted: true}},
+				},
+				expect: expect{waiting: true, slots: []string{testNamespace + "/a"}},
+			}),
+			Entry("should release the slots of completed rollouts", &data{
+				setup: setup{
+					maxConcurrentRollouts: 1,
+					deployment:            rollout{name: "md", state: rolloutWaiting},
+					others:                []rollout{{name: "a", state: rolloutComplete, granted: true}},
+				},
+				expect: expect{slots: []string{testNamespace + "/md"}},
+			}),
+			Entry("should not stop a rollout which has already started", &data{
+				setup: setup{
+					maxConcurrentRollouts: 1,
+					deployment:            rollout{name: "md", state: rolloutActive},
+					others:                []rollout{{name: "a", state: rolloutActive}},
+				},
+				expect: expect{},
+			}),
+		)
+
+		It("should ignore a rollout priority which is not an integer and record an event", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			d, machineSets := newRolloutObjects(rollout{name: "md", state: rolloutWaiting})
+			d.Annotations[RolloutPriorityAnnotation] = "high"
+			other, otherMachineSets := newRolloutObjects(rollout{name: "a", state: rolloutWaiting, waitingSince: time.Hour})
+			objects := []runtime.Object{d, other}
+			for _, is := range append(machineSets, otherMachineSets...) {
+				objects = append(objects, is)
+			}
+
+			c, trackers := createController(stop, testNamespace, objects, nil, nil)
+			defer trackers.Stop()
+			c.clock = testingclock.NewFakePassiveClock(now)
+			recorder := record.NewFakeRecorder(10)
+			c.recorder = recorder
+			waitForCacheSync(stop, c)
+			c.maxConcurrentRollouts = 1
+
+			_, waiting, err := c.syncRolloutSlot(context.TODO(), d, machineSets)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(waiting).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring(InvalidRolloutPriority)))
+		})
+	})
+
+	Describe("#RolloutPriority", func() {
+		DescribeTable("##table",
+			func(annotations map[string]string, expectPriority int, expectErr bool) {
+				priority, err := RolloutPriority(&machinev1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}})
+				Expect(priority).To(Equal(expectPriority))
+				if expectErr {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			},
+			Entry("should be zero if not set", nil, 0, false),
+			Entry("should parse an integer", map[string]string{RolloutPriorityAnnotation: "-5"}, -5, false),
+			Entry("should be zero and fail for a value which is not an integer", map[string]string{RolloutPriorityAnnotation: "high"}, 0, true),
+		)
+	})
+})
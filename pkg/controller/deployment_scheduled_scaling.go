@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller/autoscaler"
+	"github.com/gardener/machine-controller-manager/pkg/util/cron"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ScheduledScalingPostponedReason is the reason of the event recorded when a scale-down by a schedule is
+	// postponed, because scale-down by the cluster-autoscaler is disabled for the nodes during a rollout.
+	ScheduledScalingPostponedReason = "ScheduledScalingPostponed"
+
+	// scheduledScalingPostponeRetryInterval is the interval after which a deployment with a postponed
+	// scale-down is synced again.
+	scheduledScalingPostponeRetryInterval = 30 * time.Second
+)
+
+// ScheduledScalingReplicas returns the replicas the deployment is scaled to by the given schedule.
+func ScheduledScalingReplicas(schedule *v1alpha1.ScalingSchedule, replicas int32) int32 {
+	if schedule.Replicas != nil {
+		replicas = *schedule.Replicas
+	}
+	if schedule.MinReplicas != nil && replicas < *schedule.MinReplicas {
+		replicas = *schedule.MinReplicas
+	}
+	if schedule.MaxReplicas != nil && replicas > *schedule.MaxReplicas {
+		replicas = *schedule.MaxReplicas
+	}
+	return replicas
+}
+
+// scheduledScalingLocation returns the location in which the schedules of the deployment are evaluated.
+func scheduledScalingLocation(deployment *v1alpha1.MachineDeployment) *time.Location {
+	timeZone := deployment.Spec.ScheduledScaling.TimeZone
+	if timeZone == nil {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(*timeZone)
+	if err != nil {
+		klog.Warningf("Invalid time zone %q in scheduled scaling of MachineDeployment %q, using UTC: %s", *timeZone, deployment.Name, err)
+		return time.UTC
+	}
+	return location
+}
+
+// lastScheduleTime returns the last time at or before now the schedule has been due since it was last
+// expected to run. It is zero if the schedule is not due. The time is searched by bisection, so that
+// schedules which have not been run for a long time don't need to be walked through run by run.
+func lastScheduleTime(schedule *cron.Schedule, nextScheduleTime, now time.Time) time.Time {
+	if nextScheduleTime.IsZero() || nextScheduleTime.After(now) || !schedule.Matches(nextScheduleTime) {
+		return time.Time{}
+	}
+	// The schedule is due after low, but not after high
+	low, high := nextScheduleTime.Truncate(time.Minute).Add(-time.Minute), now.Truncate(time.Minute)
+	for high.Sub(low) > time.Minute {
+		middle := low.Add(high.Sub(low) / 2).Truncate(time.Minute)
+		if next := schedule.Next(middle); !next.IsZero() && !next.After(now) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return schedule.Next(low)
+}
+
+// isScaleDownDisabledByRollout returns true if a node of the deployment has the annotation disabling its
+// scale-down by the cluster-autoscaler, which is set during rollouts and removed by
+// removeAutoscalerAnnotationsIfRequired once the rollout is complete.
+func (dc *controller) isScaleDownDisabledByRollout(d *v1alpha1.MachineDeployment) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	machines, err := dc.machineLister.Machines(d.Namespace).List(selector)
+	if err != nil {
+		return false, err
+	}
+	for _, machine := range machines {
+		nodeName := machine.Labels[v1alpha1.NodeLabelKey]
+		if nodeName == "" {
+			continue
+		}
+		node, err := dc.nodeLister.Get(nodeName)
+		if err != nil {
+			continue
+		}
+		if _, exists := node.Annotations[autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMKey]; exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// syncScheduledScaling applies the schedules of a deployment with scheduled scaling which are due to its
+// replicas and records their last and next runs in its status. If several schedules are due, the one
+// which has been due last wins. Schedules which have not been run yet are only due from their next run on.
+// Scale-downs are deferred while scale-down by the cluster-autoscaler is disabled for the nodes of the
+// deployment because of its rollout. It returns the updated deployment.
+func (dc *controller) syncScheduledScaling(ctx context.Context, d *v1alpha1.MachineDeployment) (*v1alpha1.MachineDeployment, error) {
+	if d.Spec.ScheduledScaling == nil {
+		if len(d.Status.ScheduledScaling) == 0 {
+			return d, nil
+		}
+		dCopy := d.DeepCopy()
+		dCopy.Status.ScheduledScaling = nil
+		return dc.controlMachineClient.MachineDeployments(dCopy.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+	}
+
+	location := scheduledScalingLocation(d)
+	now := dc.clock.Now().In(location)
+
+	previous := make(map[string]v1alpha1.ScalingScheduleStatus, len(d.Status.ScheduledScaling))
+	for _, scheduleStatus := range d.Status.ScheduledScaling {
+		previous[scheduleStatus.Name] = scheduleStatus
+	}
+
+	var (
+		statuses = make([]v1alpha1.ScalingScheduleStatus, 0, len(d.Spec.ScheduledScaling.Schedules))
+		due      *v1alpha1.ScalingSchedule
+		dueIndex int
+		dueTime  time.Time
+		untilDue time.Duration
+	)
+	for i := range d.Spec.ScheduledScaling.Schedules {
+		scalingSchedule := &d.Spec.ScheduledScaling.Schedules[i]
+		schedule, err := cron.Parse(scalingSchedule.Schedule)
+		if err != nil {
+			klog.Warningf("Ignoring invalid scaling schedule %q of MachineDeployment %q: %s", scalingSchedule.Name, d.Name, err)
+			continue
+		}
+
+		scheduleStatus := previous[scalingSchedule.Name]
+		scheduleStatus.Name = scalingSchedule.Name
+		var nextScheduleTime time.Time
+		if scheduleStatus.NextScheduleTime != nil {
+			nextScheduleTime = scheduleStatus.NextScheduleTime.Time.In(location)
+		}
+		if last := lastScheduleTime(schedule, nextScheduleTime, now); !last.IsZero() {
+			if due == nil || !last.Before(dueTime) {
+				due, dueIndex, dueTime = scalingSchedule, len(statuses), last
+			}
+			scheduleStatus.LastScheduleTime = &metav1.Time{Time: last}
+			nextScheduleTime = time.Time{}
+		}
+		if nextScheduleTime.IsZero() || !schedule.Matches(nextScheduleTime) {
+			nextScheduleTime = schedule.Next(now)
+		}
+		scheduleStatus.NextScheduleTime = nil
+		if !nextScheduleTime.IsZero() {
+			scheduleStatus.NextScheduleTime = &metav1.Time{Time: nextScheduleTime}
+			if untilNext := nextScheduleTime.Sub(now); untilDue == 0 || untilNext < untilDue {
+				untilDue = untilNext
+			}
+		}
+		statuses = append(statuses, scheduleStatus)
+	}
+
+	if due != nil {
+		replicas := ScheduledScalingReplicas(due, d.Spec.Replicas)
+		postpone := false
+		if replicas < d.Spec.Replicas && dc.autoscalerScaleDownAnnotationDuringRollout {
+			var err error
+			if postpone, err = dc.isScaleDownDisabledByRollout(d); err != nil {
+				return nil, err
+			}
+		}
+		if postpone {
+			// Keep the schedule due by not recording its run
+			klog.V(3).Infof("MachineDeployment %q is rolling out, postponing scale down to %d replicas by schedule %q", d.Name, replicas, due.Name)
+			dc.recorder.Eventf(d, v1.EventTypeNormal, ScheduledScalingPostponedReason, "Postponed scale down to %d replicas by schedule %q until the rollout is complete", replicas, due.Name)
+			statuses[dueIndex] = previous[due.Name]
+			dc.enqueueMachineDeploymentAfter(d, scheduledScalingPostponeRetryInterval)
+		} else if replicas != d.Spec.Replicas {
+			klog.V(2).Infof("Scaling MachineDeployment %q from %d to %d replicas by schedule %q", d.Name, d.Spec.Replicas, replicas, due.Name)
+			dCopy := d.DeepCopy()
+			dCopy.Spec.Replicas = replicas
+			updated, err := dc.controlMachineClient.MachineDeployments(dCopy.Namespace).Update(ctx, dCopy, metav1.UpdateOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to scale MachineDeployment %q by schedule %q: %v", d.Name, due.Name, err)
+			}
+			dc.recorder.Eventf(updated, v1.EventTypeNormal, "ScheduledScaling", "Scaled to %d replicas by schedule %q", replicas, due.Name)
+			d = updated
+		}
+	}
+
+	if untilDue > 0 {
+		dc.enqueueMachineDeploymentAfter(d, untilDue)
+	}
+	if apiequality.Semantic.DeepEqual(d.Status.ScheduledScaling, statuses) {
+		return d, nil
+	}
+	dCopy := d.DeepCopy()
+	dCopy.Status.ScheduledScaling = statuses
+	return dc.controlMachineClient.MachineDeployments(dCopy.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+}
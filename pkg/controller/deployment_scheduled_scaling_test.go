@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller/autoscaler"
+	"github.com/gardener/machine-controller-manager/pkg/util/cron"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("deployment_scheduled_scaling", func() {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	at := func(day, hour, minute int) *metav1.Time {
+		t := metav1.NewTime(time.Date(2024, time.January, day, hour, minute, 0, 0, time.UTC))
+		return &t
+	}
+
+	Describe("#syncScheduledScaling", func() {
+		type setup struct {
+			schedules  []machinev1.ScalingSchedule
+			timeZone   *string
+			statuses   []machinev1.ScalingScheduleStatus
+			rollingOut bool
+			// scaleDownDisabled annotates the node of the deployment like during a rollout
+			scaleDownDisabled bool
+		}
+		type expect struct {
+			replicas  int32
+			statuses  []machinev1.ScalingScheduleStatus
+			postponed bool
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				labels := map[string]string{"machinedeployment": "md"}
+				d := &machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace},
+					Spec: machinev1.MachineDeploymentSpec{
+						Replicas: 3,
+						Selector: &metav1.LabelSelector{MatchLabels: labels},
+						ScheduledScaling: &machinev1.MachineDeploymentScheduledScaling{
+							Schedules: data.setup.schedules,
+							TimeZone:  data.setup.timeZone,
+						},
+					},
+					Status: machinev1.MachineDeploymentStatus{
+						Replicas:          3,
+						UpdatedReplicas:   3,
+						AvailableReplicas: 3,
+						ScheduledScaling:  data.setup.statuses,
+					},
+				}
+				if data.setup.rollingOut {
+					d.Status.UpdatedReplicas = 1
+				}
+				machine := &machinev1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "machine",
+						Namespace: testNamespace,
+						Labels:    map[string]string{"machinedeployment": "md", machinev1.NodeLabelKey: "node"},
+					},
+				}
+				node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+				if data.setup.scaleDownDisabled {
+					node.Annotations = map[string]string{autoscaler.ClusterAutoscalerScaleDownDisabledAnnotationByMCMKey: "true"}
+				}
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d, machine}, nil, []runtime.Object{node})
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				recorder := record.NewFakeRecorder(10)
+				c.recorder = recorder
+				c.autoscalerScaleDownAnnotationDuringRollout = true
+
+				updated, err := c.syncScheduledScaling(context.TODO(), d)
+				Expect(err).ToNot(HaveOccurred())
+				if data.expect.postponed {
+					Expect(recorder.Events).To(Receive(ContainSubstring(ScheduledScalingPostponedReason)))
+				}
+
+				actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				for _, md := range []*machinev1.MachineDeployment{updated, actual} {
+					Expect(md.Spec.Replicas).To(Equal(data.expect.replicas))
+					Expect(md.Status.ScheduledScaling).To(HaveLen(len(data.expect.statuses)))
+					for i, expected := range data.expect.statuses {
+						status := md.Status.ScheduledScaling[i]
+						Expect(status.Name).To(Equal(expected.Name))
+						Expect(status.LastScheduleTime.Equal(expected.LastScheduleTime)).To(BeTrue(), "last schedule time of %q is %v", status.Name, status.LastScheduleTime)
+						Expect(status.NextScheduleTime.Equal(expected.NextScheduleTime)).To(BeTrue(), "next schedule time of %q is %v", status.Name, status.NextScheduleTime)
+					}
+				}
+			},
+			Entry("should only record the next run of a new schedule", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)}},
+				},
+				expect: expect{
+					replicas: 3,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(2, 7, 0)}},
+				},
+			}),
+			Entry("should scale to the replicas of a due schedule", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)}},
+					statuses:  []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(1, 7, 0)}},
+				},
+				expect: expect{
+					replicas: 10,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "morning", LastScheduleTime: at(1, 7, 0), NextScheduleTime: at(2, 7, 0)}},
+				},
+			}),
+			Entry("should run a schedule only once for its missed runs", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{{Name: "hourly", Schedule: "0 * * * *", MinReplicas: ptr.To[int32](5)}},
+					statuses:  []machinev1.ScalingScheduleStatus{{Name: "hourly", LastScheduleTime: at(1, 8, 0), NextScheduleTime: at(1, 9, 0)}},
+				},
+				expect: expect{
+					replicas: 5,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "hourly", LastScheduleTime: at(1, 12, 0), NextScheduleTime: at(1, 13, 0)}},
+				},
+			}),
+			Entry("should apply the schedule which has been due last", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{
+						{Name: "noon", Schedule: "0 12 * * *", MaxReplicas: ptr.To[int32](2)},
+						{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)},
+					},
+					statuses: []machinev1.ScalingScheduleStatus{
+						{Name: "noon", NextScheduleTime: at(1, 12, 0)},
+						{Name: "morning", NextScheduleTime: at(1, 7, 0)},
+					},
+				},
+				expect: expect{
+					replicas: 2,
+					statuses: []machinev1.ScalingScheduleStatus{
+						{Name: "noon", LastScheduleTime: at(1, 12, 0), NextScheduleTime: at(2, 12, 0)},
+						{Name: "morning", LastScheduleTime: at(1, 7, 0), NextScheduleTime: at(2, 7, 0)},
+					},
+				},
+			}),
+			Entry("should postpone a scale down while the scale-down of the nodes is disabled for the rollout", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{
+						{Name: "evening", Schedule: "0 11 * * *", Replicas: ptr.To[int32](1)},
+						{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)},
+					},
+					statuses:          []machinev1.ScalingScheduleStatus{{Name: "evening", NextScheduleTime: at(1, 11, 0)}},
+					rollingOut:        true,
+					scaleDownDisabled: true,
+				},
+				expect: expect{
+					replicas: 3,
+					statuses: []machinev1.ScalingScheduleStatus{
+						{Name: "evening", NextScheduleTime: at(1, 11, 0)},
+						{Name: "morning", NextScheduleTime: at(2, 7, 0)},
+					},
+					postponed: true,
+				},
+			}),
+			Entry("should not postpone a scale down of a rolling out deployment whose nodes may be scaled down", &data{
+				setup: setup{
+					schedules:  []machinev1.ScalingSchedule{{Name: "evening", Schedule: "0 11 * * *", Replicas: ptr.To[int32](1)}},
+					statuses:   []machinev1.ScalingScheduleStatus{{Name: "evening", NextScheduleTime: at(1, 11, 0)}},
+					rollingOut: true,
+				},
+				expect: expect{
+					replicas: 1,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "evening", LastScheduleTime: at(1, 11, 0), NextScheduleTime: at(2, 11, 0)}},
+				},
+			}),
+			Entry("should not postpone a scale up while the deployment is rolling out", &data{
+				setup: setup{
+					schedules:         []machinev1.ScalingSchedule{{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)}},
+					statuses:          []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(1, 7, 0)}},
+					rollingOut:        true,
+					scaleDownDisabled: true,
+				},
+				expect: expect{
+					replicas: 10,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "morning", LastScheduleTime: at(1, 7, 0), NextScheduleTime: at(2, 7, 0)}},
+				},
+			}),
+			Entry("should evaluate the schedules in the time zone", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{{Name: "morning", Schedule: "0 7 * * *", Replicas: ptr.To[int32](10)}},
+					timeZone:  ptr.To("Europe/Berlin"),
+					statuses:  []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(1, 6, 0)}},
+				},
+				expect: expect{
+					replicas: 10,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "morning", LastScheduleTime: at(1, 6, 0), NextScheduleTime: at(2, 6, 0)}},
+				},
+			}),
+			Entry("should not run a schedule which has been changed since its next run was recorded", &data{
+				setup: setup{
+					schedules: []machinev1.ScalingSchedule{{Name: "morning", Schedule: "30 8 * * *", Replicas: ptr.To[int32](10)}},
+					statuses:  []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(1, 7, 0)}},
+				},
+				expect: expect{
+					replicas: 3,
+					statuses: []machinev1.ScalingScheduleStatus{{Name: "morning", NextScheduleTime: at(2, 8, 30)}},
+				},
+			}),
+		)
+	})
+
+	Describe("#lastScheduleTime", func() {
+		DescribeTable("##table",
+			func(spec string, nextScheduleTime, expected time.Time) {
+				schedule, err := cron.Parse(spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastScheduleTime(schedule, nextScheduleTime, now)).To(Equal(expected))
+			},
+			Entry("should not be due before the next run", "0 13 * * *", at(1, 13, 0).Time, time.Time{}),
+			Entry("should be due at the next run", "0 12 * * *", now, now),
+			Entry("should be due at the last of several missed runs", "0 */5 * * *", at(1, 0, 0).Time, at(1, 10, 0).Time),
+			Entry("should be due at the last run missed for years", "* 3 * * *", time.Date(2021, time.January, 1, 3, 0, 0, 0, time.UTC), at(1, 3, 59).Time),
+			Entry("should not be due at a next run which is not selected", "30 * * * *", at(1, 7, 0).Time, time.Time{}),
+		)
+	})
+})
@@ -0,0 +1,732 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	machineinternal "github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	machinefake "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/fake"
+	machinelisters "github.com/gardener/machine-controller-manager/pkg/client/listers/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/drain"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	policyv1listers "k8s.io/client-go/listers/policy/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultRolloutSimulationStep is the default simulated time between two reconciliations.
+	DefaultRolloutSimulationStep = 30 * time.Second
+	// DefaultRolloutSimulationMachineCreationTime is the default simulated time a machine takes to become running.
+	DefaultRolloutSimulationMachineCreationTime = 5 * time.Minute
+	// DefaultRolloutSimulationMaxSteps is the default number of steps after which a simulation gives up.
+	DefaultRolloutSimulationMaxSteps = 1000
+
+	// maxSimulationReconcileRounds limits the reconciliations within a step, which are repeated until the
+	// controllers don't change any more objects.
+	maxSimulationReconcileRounds = 10
+)
+
+// RolloutSnapshot is the state of the machine objects and of the cluster hosting the nodes on which the rollout
+// of a machine deployment is simulated.
+type RolloutSnapshot struct {
+	MachineDeployments   []v1alpha1.MachineDeployment
+	MachineSets          []v1alpha1.MachineSet
+	Machines             []v1alpha1.Machine
+	Nodes                []corev1.Node
+	Pods                 []corev1.Pod
+	PodDisruptionBudgets []policyv1.PodDisruptionBudget
+}
+
+// RolloutSimulationOptions configure the simulation of a rollout. Zero values are replaced by the defaults.
+type RolloutSimulationOptions struct {
+	// Step is the simulated time between two reconciliations of the machine deployments and machine sets.
+	Step time.Duration
+	// MachineCreationTime is the simulated time a machine takes from its creation until it is running.
+	MachineCreationTime time.Duration
+	// MaxSteps is the number of steps after which the simulation gives up if the rollout did not finish.
+	MaxSteps int
+}
+
+// RolloutSimulation is the result of the simulation of a rollout.
+type RolloutSimulation struct {
+	// MachineDeployment is the name of the simulated machine deployment.
+	MachineDeployment string `json:"machineDeployment"`
+	// Steps is the timeline of the rollout. Only the steps in which something changed are included.
+	Steps []RolloutSimulationStep `json:"steps"`
+	// PeakSurge is the highest number of machines of the machine deployment above its replicas.
+	PeakSurge int32 `json:"peakSurge"`
+	// PeakUnavailable is the highest number of replicas of the machine deployment which were not available.
+	PeakUnavailable int32 `json:"peakUnavailable"`
+	// Complete is true if the rollout finished within the maximum number of steps.
+	Complete bool `json:"complete"`
+	// Duration is the simulated time until the rollout finished or the simulation gave up.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// RolloutSimulationStep is a step of the timeline of a simulated rollout.
+type RolloutSimulationStep struct {
+	// Elapsed is the simulated time since the start of the rollout.
+	Elapsed metav1.Duration `json:"elapsed"`
+	// Created are the names of the machines created in the step.
+	Created []string `json:"created,omitempty"`
+	// Deleted are the names of the machines deleted in the step.
+	Deleted []string `json:"deleted,omitempty"`
+	// Machines is the number of active machines of the machine deployment after the step.
+	Machines int32 `json:"machines"`
+	// Available is the number of available machines of the machine deployment after the step.
+	Available int32 `json:"available"`
+	// Surge is the number of machines of the machine deployment above its replicas after the step.
+	Surge int32 `json:"surge"`
+	// BlockedDrains are the drains of the nodes of the deleted machines which would block.
+	BlockedDrains []BlockedDrain `json:"blockedDrains,omitempty"`
+}
+
+// BlockedDrain is the drain of the node of a deleted machine which would block on a pod disruption budget, or
+// which would fail because of the pods of the node.
+type BlockedDrain struct {
+	// Machine is the name of the deleted machine.
+	Machine string `json:"machine"`
+	// Node is the name of the node of the machine.
+	Node string `json:"node"`
+	// PodDisruptionBudget is the namespace and name of the pod disruption budget blocking the drain, if any.
+	PodDisruptionBudget string `json:"podDisruptionBudget,omitempty"`
+	// Pods are the namespaces and names of the pods which could not be evicted.
+	Pods []string `json:"pods,omitempty"`
+	// Reason describes why the drain blocks.
+	Reason string `json:"reason"`
+}
+
+// SimulateRollout simulates the rollout of the machine deployment on the snapshot. The deployment and machine set
+// controllers reconcile the machine objects of the namespace in steps of a simulated clock, while machines become
+// running after the machine creation time and the nodes of deleted machines are drained using the pod filters and
+// pod disruption budgets of the drain. Evicted pods are assumed to be healthy again in the next step.
+func SimulateRollout(snapshot *RolloutSnapshot, namespace, name string, options RolloutSimulationOptions) (*RolloutSimulation, error) {
+	if options.Step <= 0 {
+		options.Step = DefaultRolloutSimulationStep
+	}
+	if options.MachineCreationTime <= 0 {
+		options.MachineCreationTime = DefaultRolloutSimulationMachineCreationTime
+	}
+	if options.MaxSteps <= 0 {
+		options.MaxSteps = DefaultRolloutSimulationMaxSteps
+	}
+
+	s, err := newRolloutSimulator(snapshot, namespace, name, options)
+	if err != nil {
+		return nil, err
+	}
+	defer s.shutDown()
+
+	return s.run()
+}
+
+// rolloutSimulator runs the controllers against fake clients seeded with a snapshot.
+type rolloutSimulator struct {
+	options   RolloutSimulationOptions
+	namespace string
+	name      string
+	start     time.Time
+	now       time.Time
+
+	controller    *controller
+	machineClient *machinefake.Clientset
+	targetClient  *k8sfake.Clientset
+
+	machineDeploymentIndexer cache.Indexer
+	machineSetIndexer        cache.Indexer
+	machineIndexer           cache.Indexer
+	nodeIndexer              cache.Indexer
+	podIndexer               cache.Indexer
+	pdbIndexer               cache.Indexer
+
+	// mutations counts the changes of the controllers, to repeat reconciliations until they settle.
+	mutations      int
+	generatedNames int
+	created        []string
+	deleted        []simulatedMachineDeletion
+}
+
+// simulatedMachineDeletion is a machine deleted in the current step.
+type simulatedMachineDeletion struct {
+	machine string
+	node    string
+}
+
+func newRolloutSimulator(snapshot *RolloutSnapshot, namespace, name string, options RolloutSimulationOptions) (*rolloutSimulator, error) {
+	var machineObjects, targetObjects []runtime.Object
+	found := false
+	for i := range snapshot.MachineDeployments {
+		d := snapshot.MachineDeployments[i].DeepCopy()
+		if d.Namespace == namespace && d.Name == name {
+			// The simulated change of the machine deployment is a new generation of it.
+			d.Generation++
+			found = true
+		}
+		machineObjects = append(machineObjects, d)
+	}
+	if !found {
+		return nil, fmt.Errorf("machine deployment %s/%s not found in the snapshot", namespace, name)
+	}
+	for i := range snapshot.MachineSets {
+		machineObjects = append(machineObjects, snapshot.MachineSets[i].DeepCopy())
+	}
+	for i := range snapshot.Machines {
+		machineObjects = append(machineObjects, snapshot.Machines[i].DeepCopy())
+	}
+	for i := range snapshot.Nodes {
+		targetObjects = append(targetObjects, snapshot.Nodes[i].DeepCopy())
+	}
+	for i := range snapshot.Pods {
+		targetObjects = append(targetObjects, snapshot.Pods[i].DeepCopy())
+	}
+	for i := range snapshot.PodDisruptionBudgets {
+		targetObjects = append(targetObjects, snapshot.PodDisruptionBudgets[i].DeepCopy())
+	}
+	if err := completeSnapshotObjects(machineObjects); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	s := &rolloutSimulator{
+		options:                  options,
+		namespace:                namespace,
+		name:                     name,
+		start:                    start,
+		now:                      start,
+		machineClient:            machinefake.NewSimpleClientset(machineObjects...),
+		targetClient:             k8sfake.NewSimpleClientset(targetObjects...),
+		machineDeploymentIndexer: newSimulationIndexer(),
+		machineSetIndexer:        newSimulationIndexer(),
+		machineIndexer:           newSimulationIndexer(),
+		nodeIndexer:              newSimulationIndexer(),
+		podIndexer:               newSimulationIndexer(),
+		pdbIndexer:               newSimulationIndexer(),
+	}
+	s.machineClient.PrependReactor("*", "*", s.observeAction)
+	s.machineClient.PrependReactor("create", "*", s.completeCreatedObject)
+	s.machineClient.PrependReactor("delete", "machines", s.deleteMachine)
+	s.targetClient.PrependReactor("create", "*", s.completeCreatedObject)
+
+	internalExternalScheme := runtime.NewScheme()
+	if err := machineinternal.AddToScheme(internalExternalScheme); err != nil {
+		return nil, err
+	}
+	if err := v1alpha1.AddToScheme(internalExternalScheme); err != nil {
+		return nil, err
+	}
+	// Events of the simulation are dropped.
+	recorder := &record.FakeRecorder{}
+	controlMachineClient := s.machineClient.MachineV1alpha1()
+	s.controller = &controller{
+		namespace:                      namespace,
+		controlMachineClient:           controlMachineClient,
+		controlCoreClient:              k8sfake.NewSimpleClientset(),
+		targetCoreClient:               s.targetClient,
+		recorder:                       recorder,
+		machineControl:                 RealMachineControl{controlMachineClient: controlMachineClient, Recorder: recorder},
+		machineSetControl:              RealMachineSetControl{controlMachineClient: controlMachineClient, Recorder: recorder},
+		expectations:                   NewUIDTrackingContExpectations(NewContExpectations()),
+		internalExternalScheme:         internalExternalScheme,
+		nodeLister:                     corelisters.NewNodeLister(s.nodeIndexer),
+		machineLister:                  machinelisters.NewMachineLister(s.machineIndexer),
+		machineSetLister:               machinelisters.NewMachineSetLister(s.machineSetIndexer),
+		machineDeploymentLister:        machinelisters.NewMachineDeploymentLister(s.machineDeploymentIndexer),
+		nodeQueue:                      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "node"),
+		machineQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machine"),
+		machineSetQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineset"),
+		machineDeploymentQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinedeployment"),
+		machineSafetyOvershootingQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyovershooting"),
+		rolloutSlots:                   make(map[string]bool),
+		clock:                          s,
+	}
+	return s, nil
+}
+
+// Now returns the simulated time, as the simulator is the clock of the simulated controllers.
+func (s *rolloutSimulator) Now() time.Time {
+	return s.now
+}
+
+// Since returns the simulated time passed since t.
+func (s *rolloutSimulator) Since(t time.Time) time.Duration {
+	return s.now.Sub(t)
+}
+
+func (s *rolloutSimulator) shutDown() {
+	s.controller.nodeQueue.ShutDown()
+	s.controller.machineQueue.ShutDown()
+	s.controller.machineSetQueue.ShutDown()
+	s.controller.machineDeploymentQueue.ShutDown()
+	s.controller.machineSafetyOvershootingQueue.ShutDown()
+}
+
+func (s *rolloutSimulator) run() (*RolloutSimulation, error) {
+	result := &RolloutSimulation{MachineDeployment: s.name}
+	var last *RolloutSimulationStep
+	for i := 0; i < s.options.MaxSteps; i++ {
+		s.now = s.start.Add(time.Duration(i) * s.options.Step)
+		s.created, s.deleted = nil, nil
+
+		if err := s.simulateMachineController(); err != nil {
+			return nil, err
+		}
+		if err := s.reconcile(); err != nil {
+			return nil, err
+		}
+
+		step := RolloutSimulationStep{
+			Elapsed: metav1.Duration{Duration: s.now.Sub(s.start)},
+			Created: s.created,
+		}
+		budgets := make(map[string]int32)
+		for _, deletion := range s.deleted {
+			step.Deleted = append(step.Deleted, deletion.machine)
+			blocked, err := s.drain(deletion, budgets)
+			if err != nil {
+				return nil, err
+			}
+			step.BlockedDrains = append(step.BlockedDrains, blocked...)
+		}
+
+		d, err := s.machineClient.MachineV1alpha1().MachineDeployments(s.namespace).Get(context.TODO(), s.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if step.Machines, step.Available, err = s.countMachines(d); err != nil {
+			return nil, err
+		}
+		step.Surge = step.Machines - d.Spec.Replicas
+		result.PeakSurge = max(result.PeakSurge, step.Surge)
+		result.PeakUnavailable = max(result.PeakUnavailable, d.Spec.Replicas-step.Available)
+		result.Duration = step.Elapsed
+
+		if last == nil || len(step.Created) > 0 || len(step.Deleted) > 0 || len(step.BlockedDrains) > 0 ||
+			step.Machines != last.Machines || step.Available != last.Available {
+			result.Steps = append(result.Steps, step)
+			last = &result.Steps[len(result.Steps)-1]
+		}
+
+		// A timed out rollout is still progressing in the simulation.
+		if _, done, _ := MachineDeploymentRolloutStatus(d); done {
+			result.Complete = true
+			break
+		}
+	}
+	return result, nil
+}
+
+// simulateMachineController turns machines running once the machine creation time passed since their creation,
+// registering their nodes, and removes the machines whose deletion was triggered, as their nodes were drained.
+func (s *rolloutSimulator) simulateMachineController() error {
+	ctx := context.TODO()
+	machines, err := s.machineClient.MachineV1alpha1().Machines(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.DeletionTimestamp != nil {
+			if err := s.machineClient.MachineV1alpha1().Machines(s.namespace).Delete(ctx, machine.Name, metav1.DeleteOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+		phase := machine.Status.CurrentStatus.Phase
+		if phase != "" && phase != v1alpha1.MachinePending {
+			continue
+		}
+
+		now := metav1.NewTime(s.now)
+		if machine.CreationTimestamp.Add(s.options.MachineCreationTime).After(s.now) {
+			if phase == v1alpha1.MachinePending {
+				continue
+			}
+			machine.Status.CurrentStatus = v1alpha1.CurrentStatus{Phase: v1alpha1.MachinePending, LastUpdateTime: now}
+			machine.Status.LastOperation = v1alpha1.LastOperation{
+				Description:    "Creating machine on cloud provider",
+				State:          v1alpha1.MachineStateProcessing,
+				Type:           v1alpha1.MachineOperationCreate,
+				LastUpdateTime: now,
+			}
+		} else {
+			nodeName := machine.Labels[v1alpha1.NodeLabelKey]
+			if nodeName == "" {
+				nodeName = machine.Name
+				if machine.Labels == nil {
+					machine.Labels = make(map[string]string)
+				}
+				machine.Labels[v1alpha1.NodeLabelKey] = nodeName
+			}
+			if err := s.registerNode(nodeName); err != nil {
+				return err
+			}
+			machine.Status.CurrentStatus = v1alpha1.CurrentStatus{Phase: v1alpha1.MachineRunning, LastUpdateTime: now}
+			machine.Status.LastOperation = v1alpha1.LastOperation{
+				Description:    "Machine is now ready",
+				State:          v1alpha1.MachineStateSuccessful,
+				Type:           v1alpha1.MachineOperationCreate,
+				LastUpdateTime: now,
+			}
+		}
+		if _, err := s.machineClient.MachineV1alpha1().Machines(s.namespace).Update(ctx, machine, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerNode creates a ready node, as the kubelet of a new machine would.
+func (s *rolloutSimulator) registerNode(name string) error {
+	_, err := s.targetClient.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{
+				Type:               corev1.NodeReady,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(s.now),
+			}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcile reconciles all machine deployments and machine sets until they don't change any more objects. The
+// machine sets settle before and after the machine deployments are reconciled, as the machine set controller
+// observes the machines it creates or deletes long before the next reconciliation of the machine deployment.
+func (s *rolloutSimulator) reconcile() error {
+	for round := 0; round < maxSimulationReconcileRounds; round++ {
+		mutations := s.mutations
+		if err := s.reconcileMachineSets(); err != nil {
+			return err
+		}
+		for _, key := range sortedKeys(s.machineDeploymentIndexer) {
+			if err := s.controller.reconcileClusterMachineDeployment(key); err != nil {
+				klog.V(3).Infof("Simulated reconciliation of machine deployment %q failed: %v", key, err)
+			}
+		}
+		if err := s.reconcileMachineSets(); err != nil {
+			return err
+		}
+		if s.mutations == mutations {
+			return nil
+		}
+	}
+	return nil
+}
+
+// reconcileMachineSets reconciles all machine sets until they don't change any more objects.
+func (s *rolloutSimulator) reconcileMachineSets() error {
+	for round := 0; round < maxSimulationReconcileRounds; round++ {
+		mutations := s.mutations
+		if err := s.refresh(); err != nil {
+			return err
+		}
+		for _, key := range sortedKeys(s.machineSetIndexer) {
+			if err := s.controller.reconcileClusterMachineSet(key); err != nil {
+				klog.V(3).Infof("Simulated reconciliation of machine set %q failed: %v", key, err)
+			}
+		}
+		if err := s.refresh(); err != nil {
+			return err
+		}
+		if s.mutations == mutations {
+			return nil
+		}
+	}
+	return nil
+}
+
+// refresh replaces the contents of the listers with the objects of the fake clients, as the informers would.
+func (s *rolloutSimulator) refresh() error {
+	ctx := context.TODO()
+	machineDeployments, err := s.machineClient.MachineV1alpha1().MachineDeployments(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	machineSets, err := s.machineClient.MachineV1alpha1().MachineSets(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	machines, err := s.machineClient.MachineV1alpha1().Machines(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	nodes, err := s.targetClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pods, err := s.targetClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pdbs, err := s.targetClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range []struct {
+		indexer cache.Indexer
+		list    runtime.Object
+	}{
+		{s.machineDeploymentIndexer, machineDeployments},
+		{s.machineSetIndexer, machineSets},
+		{s.machineIndexer, machines},
+		{s.nodeIndexer, nodes},
+		{s.podIndexer, pods},
+		{s.pdbIndexer, pdbs},
+	} {
+		items, err := meta.ExtractList(r.list)
+		if err != nil {
+			return err
+		}
+		objects := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			objects = append(objects, item)
+		}
+		if err := r.indexer.Replace(objects, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drain simulates the drain of the node of the deleted machine and returns the blocked drains. The budgets are
+// the disruptions still allowed by the pod disruption budgets in the current step.
+func (s *rolloutSimulator) drain(deletion simulatedMachineDeletion, budgets map[string]int32) ([]BlockedDrain, error) {
+	if deletion.node == "" {
+		return nil, nil
+	}
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	// The drain options match the ones of the machine controller for a drain which isn't forced.
+	drainOptions := drain.NewDrainOptions(
+		s.targetClient,
+		nil,
+		0,
+		0,
+		0,
+		0,
+		deletion.node,
+		-1,
+		false,
+		true,
+		true,
+		true,
+		io.Discard,
+		io.Discard,
+		nil,
+		nil,
+		nil,
+		policyv1listers.NewPodDisruptionBudgetLister(s.pdbIndexer),
+		corelisters.NewNodeLister(s.nodeIndexer),
+		corelisters.NewPodLister(s.podIndexer),
+		nil,
+		nil,
+	)
+
+	var blocked []BlockedDrain
+	pods, err := drainOptions.PodsToEvict()
+	if err != nil {
+		blocked = append(blocked, BlockedDrain{Machine: deletion.machine, Node: deletion.node, Reason: err.Error()})
+	}
+	blockedByPDB := make(map[string]*BlockedDrain)
+	var pdbKeys []string
+	for i := range pods {
+		pod := &pods[i]
+		pdb := drainOptions.PodDisruptionBudgetForPod(pod)
+		if pdb == nil {
+			continue
+		}
+		key := pdb.Namespace + "/" + pdb.Name
+		if _, ok := budgets[key]; !ok {
+			budgets[key] = pdb.Status.DisruptionsAllowed
+		}
+		var reason string
+		switch {
+		case drain.IsMisconfiguredPodDisruptionBudget(pdb):
+			reason = "pod disruption budget is misconfigured and requires zero voluntary evictions"
+		case budgets[key] <= 0:
+			reason = "pod disruption budget allows no further disruptions"
+		default:
+			budgets[key]--
+			continue
+		}
+		if blockedByPDB[key] == nil {
+			blockedByPDB[key] = &BlockedDrain{Machine: deletion.machine, Node: deletion.node, PodDisruptionBudget: key, Reason: reason}
+			pdbKeys = append(pdbKeys, key)
+		}
+		blockedByPDB[key].Pods = append(blockedByPDB[key].Pods, pod.Namespace+"/"+pod.Name)
+	}
+	for _, key := range pdbKeys {
+		blocked = append(blocked, *blockedByPDB[key])
+	}
+
+	// The pods of the node are rescheduled and the node leaves the cluster with its machine.
+	for _, obj := range s.podIndexer.List() {
+		pod := obj.(*corev1.Pod)
+		if pod.Spec.NodeName != deletion.node {
+			continue
+		}
+		if err := s.targetClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	if err := s.targetClient.CoreV1().Nodes().Delete(context.TODO(), deletion.node, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	return blocked, nil
+}
+
+// countMachines returns the number of active and available machines selected by the machine deployment.
+func (s *rolloutSimulator) countMachines(d *v1alpha1.MachineDeployment) (int32, int32, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return 0, 0, err
+	}
+	machines, err := s.machineClient.MachineV1alpha1().Machines(s.namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return 0, 0, err
+	}
+	var active, available int32
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.DeletionTimestamp != nil || !IsMachineActive(machine) || isStandbyMachine(machine) {
+			continue
+		}
+		active++
+		if isMachineAvailable(machine) {
+			available++
+		}
+	}
+	return active, available, nil
+}
+
+// observeAction counts the changes of the controllers.
+func (s *rolloutSimulator) observeAction(action k8stesting.Action) (bool, runtime.Object, error) {
+	switch action.GetVerb() {
+	case "get", "list", "watch":
+	default:
+		s.mutations++
+	}
+	return false, nil, nil
+}
+
+// completeCreatedObject sets the name, UID and creation timestamp of created objects, as the API server would.
+func (s *rolloutSimulator) completeCreatedObject(action k8stesting.Action) (bool, runtime.Object, error) {
+	obj, err := meta.Accessor(action.(k8stesting.CreateAction).GetObject())
+	if err != nil {
+		return true, nil, err
+	}
+	if obj.GetName() == "" && obj.GetGenerateName() != "" {
+		s.generatedNames++
+		obj.SetName(obj.GetGenerateName() + strconv.Itoa(s.generatedNames))
+	}
+	if obj.GetUID() == "" {
+		obj.SetUID(uuid.NewUUID())
+	}
+	if creationTimestamp := obj.GetCreationTimestamp(); creationTimestamp.IsZero() {
+		obj.SetCreationTimestamp(metav1.NewTime(s.now))
+	}
+	if action.GetResource().Resource == "machines" {
+		s.created = append(s.created, obj.GetName())
+	}
+	return false, nil, nil
+}
+
+// deleteMachine records the deleted machines together with their nodes. As the finalizer of the machine
+// controller would, it only sets the deletion timestamp of a machine, which is removed in the next step.
+func (s *rolloutSimulator) deleteMachine(action k8stesting.Action) (bool, runtime.Object, error) {
+	deleteAction := action.(k8stesting.DeleteAction)
+	obj, err := s.machineClient.Tracker().Get(deleteAction.GetResource(), deleteAction.GetNamespace(), deleteAction.GetName())
+	if err != nil {
+		return false, nil, nil
+	}
+	machine := obj.(*v1alpha1.Machine).DeepCopy()
+	if machine.DeletionTimestamp != nil {
+		return false, nil, nil
+	}
+	now := metav1.NewTime(s.now)
+	machine.DeletionTimestamp = &now
+	if err := s.machineClient.Tracker().Update(deleteAction.GetResource(), machine, machine.Namespace); err != nil {
+		return true, nil, err
+	}
+	s.deleted = append(s.deleted, simulatedMachineDeletion{machine: machine.Name, node: machine.Labels[v1alpha1.NodeLabelKey]})
+	return true, machine, nil
+}
+
+// completeSnapshotObjects sets missing UIDs of the machine objects of hand-written snapshots and resolves owner
+// references without a UID by the kind and name of the owner.
+func completeSnapshotObjects(objects []runtime.Object) error {
+	uids := make(map[string]types.UID)
+	accessors := make([]metav1.Object, 0, len(objects))
+	for _, object := range objects {
+		obj, err := meta.Accessor(object)
+		if err != nil {
+			return err
+		}
+		if obj.GetUID() == "" {
+			obj.SetUID(uuid.NewUUID())
+		}
+		uids[objectKind(object)+"/"+obj.GetName()] = obj.GetUID()
+		accessors = append(accessors, obj)
+	}
+	for _, obj := range accessors {
+		ownerReferences := obj.GetOwnerReferences()
+		for i := range ownerReferences {
+			if ownerReferences[i].UID == "" {
+				ownerReferences[i].UID = uids[ownerReferences[i].Kind+"/"+ownerReferences[i].Name]
+			}
+		}
+		obj.SetOwnerReferences(ownerReferences)
+	}
+	return nil
+}
+
+// objectKind returns the kind of the machine object, which the type meta of decoded objects may lack.
+func objectKind(object runtime.Object) string {
+	switch object.(type) {
+	case *v1alpha1.MachineDeployment:
+		return "MachineDeployment"
+	case *v1alpha1.MachineSet:
+		return "MachineSet"
+	case *v1alpha1.Machine:
+		return "Machine"
+	}
+	return ""
+}
+
+func newSimulationIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+func sortedKeys(indexer cache.Indexer) []string {
+	keys := indexer.ListKeys()
+	sort.Strings(keys)
+	return keys
+}
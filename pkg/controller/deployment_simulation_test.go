@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("deployment_simulation", func() {
+	// newSnapshot returns a snapshot of a machine deployment with three running machines of class-a, each running a
+	// pod guarded by a pod disruption budget, whose template was changed to class-b.
+	newSnapshot := func(maxSurge int32, disruptionsAllowed int32) *RolloutSnapshot {
+		selector := map[string]string{"name": "md"}
+		oldLabels := map[string]string{"name": "md", machinev1.DefaultMachineDeploymentUniqueLabelKey: "old"}
+		template := func(class string, labels map[string]string) machinev1.MachineTemplateSpec {
+			return machinev1.MachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       machinev1.MachineSpec{Class: machinev1.ClassSpec{Kind: "MachineClass", Name: class}},
+			}
+		}
+		snapshot := &RolloutSnapshot{
+			MachineDeployments: []machinev1.MachineDeployment{{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "machine.sapcloud.io/v1alpha1", Kind: "MachineDeployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace, Annotations: map[string]string{RevisionAnnotation: "1"}},
+				Spec: machinev1.MachineDeploymentSpec{
+					Replicas: 3,
+					Selector: &metav1.LabelSelector{MatchLabels: selector},
+					Strategy: machinev1.MachineDeploymentStrategy{
+						Type: machinev1.RollingUpdateMachineDeploymentStrategyType,
+						RollingUpdate: &machinev1.RollingUpdateMachineDeployment{
+							MaxSurge:       ptr.To(intstr.FromInt32(maxSurge)),
+							MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+						},
+					},
+					Template: template("class-b", selector),
+				},
+			}},
+			MachineSets: []machinev1.MachineSet{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "md-old",
+					Namespace:       testNamespace,
+					Labels:          oldLabels,
+					Annotations:     map[string]string{RevisionAnnotation: "1"},
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "machine.sapcloud.io/v1alpha1", Kind: "MachineDeployment", Name: "md", Controller: ptr.To(true)}},
+				},
+				Spec: machinev1.MachineSetSpec{
+					Replicas: 3,
+					Selector: &metav1.LabelSelector{MatchLabels: oldLabels},
+					Template: template("class-a", oldLabels),
+				},
+				Status: machinev1.MachineSetStatus{Replicas: 3, ReadyReplicas: 3, AvailableReplicas: 3, FullyLabeledReplicas: 3},
+			}},
+			PodDisruptionBudgets: []policyv1.PodDisruptionBudget{{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+				Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 4},
+			}},
+		}
+		replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "web-uid"}}
+		for i := 0; i < 3; i++ {
+			name := fmt.Sprintf("md-old-%d", i)
+			snapshot.Machines = append(snapshot.Machines, machinev1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         testNamespace,
+					Labels:            map[string]string{"name": "md", machinev1.DefaultMachineDeploymentUniqueLabelKey: "old", machinev1.NodeLabelKey: name},
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-24 * time.Hour)),
+					OwnerReferences:   []metav1.OwnerReference{{APIVersion: "machine.sapcloud.io/v1alpha1", Kind: "MachineSet", Name: "md-old", Controller: ptr.To(true)}},
+				},
+				Spec:   template("class-a", oldLabels).Spec,
+				Status: machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineRunning}},
+			})
+			snapshot.Nodes = append(snapshot.Nodes, corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}})
+			snapshot.Pods = append(snapshot.Pods,
+				corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "web-" + name,
+						Namespace:       "default",
+						Labels:          map[string]string{"app": "web"},
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(replicaSet, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+					},
+					Spec: corev1.PodSpec{NodeName: name},
+				},
+				corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "agent-" + name,
+						Namespace:       "kube-system",
+						Labels:          map[string]string{"app": "web"},
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", UID: "agent-uid", Controller: ptr.To(true)}},
+					},
+					Spec: corev1.PodSpec{NodeName: name},
+				},
+			)
+		}
+		return snapshot
+	}
+
+	machines := func(simulation *RolloutSimulation) (created, deleted []string, blocked []BlockedDrain) {
+		for _, step := range simulation.Steps {
+			created = append(created, step.Created...)
+			deleted = append(deleted, step.Deleted...)
+			blocked = append(blocked, step.BlockedDrains...)
+		}
+		return created, deleted, blocked
+	}
+
+	Describe("#SimulateRollout", func() {
+		It("should replace the machines of the old machine set within the surge", func() {
+			simulation, err := SimulateRollout(newSnapshot(1, 2), testNamespace, "md", RolloutSimulationOptions{Step: time.Minute, MachineCreationTime: 3 * time.Minute})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(simulation.Complete).To(BeTrue())
+			Expect(simulation.Duration.Duration).To(Equal(8 * time.Minute))
+			Expect(simulation.PeakUnavailable).To(BeZero())
+			created, deleted, blocked := machines(simulation)
+			Expect(created).To(HaveLen(3))
+			Expect(deleted).To(ConsistOf("md-old-0", "md-old-1", "md-old-2"))
+			Expect(blocked).To(BeEmpty())
+			// The new machine set is scaled up by the actual replicas of the machine sets, which don't include the
+			// machine created together with it yet.
+			Expect(simulation.Steps[0].Elapsed.Duration).To(BeZero())
+			Expect(simulation.Steps[0].Created).To(HaveLen(2))
+			Expect(simulation.PeakSurge).To(Equal(int32(2)))
+		})
+
+		It("should report drains blocked by a pod disruption budget without disruptions allowed", func() {
+			simulation, err := SimulateRollout(newSnapshot(1, 0), testNamespace, "md", RolloutSimulationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(simulation.Complete).To(BeTrue())
+			_, deleted, blocked := machines(simulation)
+			Expect(blocked).To(HaveLen(len(deleted)))
+			Expect(blocked[0].PodDisruptionBudget).To(Equal("default/web"))
+			Expect(blocked[0].Pods).To(ConsistOf("default/web-" + blocked[0].Node))
+			Expect(blocked[0].Reason).To(Equal("pod disruption budget allows no further disruptions"))
+		})
+
+		It("should report drains exceeding the disruptions allowed within a step", func() {
+			simulation, err := SimulateRollout(newSnapshot(3, 1), testNamespace, "md", RolloutSimulationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(simulation.Complete).To(BeTrue())
+			Expect(simulation.PeakSurge).To(Equal(int32(3)))
+			_, _, blocked := machines(simulation)
+			Expect(blocked).To(HaveLen(2))
+		})
+
+		It("should give up after the maximum number of steps", func() {
+			simulation, err := SimulateRollout(newSnapshot(1, 1), testNamespace, "md", RolloutSimulationOptions{Step: time.Minute, MachineCreationTime: time.Hour, MaxSteps: 5})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(simulation.Complete).To(BeFalse())
+			Expect(simulation.Duration.Duration).To(Equal(4 * time.Minute))
+		})
+
+		It("should fail if the machine deployment is not part of the snapshot", func() {
+			_, err := SimulateRollout(newSnapshot(1, 1), testNamespace, "other", RolloutSimulationOptions{})
+			Expect(err).To(MatchError(ContainSubstring("not found in the snapshot")))
+		})
+	})
+})
@@ -101,7 +101,7 @@ func (dc *controller) checkPausedConditions(ctx context.Context, d *v1alpha1.Mac
 		return nil
 	}
 
-	_, err := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{})
+	_, err := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 	return err
 }
 
@@ -125,6 +125,12 @@ func (dc *controller) getAllMachineSetsAndSyncRevision(ctx context.Context, d *v
 	}
 	_, allOldISs := FindOldMachineSets(d, isList)
 
+	// Only the new machine set keeps a warm pool, the warm pools of old machine sets are deleted
+	allOldISs, err = dc.clearWarmPoolOfOldMachineSets(ctx, allOldISs)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Get new machine set with the updated revision number
 	newIS, err := dc.getNewMachineSet(ctx, d, isList, allOldISs, createIfNotExisted)
 	if err != nil {
@@ -250,12 +256,18 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 		// Set existing new machine set's annotation
 		annotationsUpdated := SetNewMachineSetAnnotations(d, isCopy, newRevision, true)
 		minReadySecondsNeedsUpdate := isCopy.Spec.MinReadySeconds != d.Spec.MinReadySeconds
+		deletePolicyNeedsUpdate := isCopy.Spec.DeletePolicy != d.Spec.DeletePolicy
+		classFallbackNeedsUpdate := !reflect.DeepEqual(isCopy.Spec.ClassFallback, d.Spec.ClassFallback)
+		warmPoolNeedsUpdate := !reflect.DeepEqual(isCopy.Spec.WarmPool, d.Spec.WarmPool)
 		nodeTemplateUpdated := SetNewMachineSetNodeTemplate(d, isCopy, newRevision, true)
 		machineConfigUpdated := SetNewMachineSetConfig(d, isCopy, newRevision, true)
 		updateMachineSetClassKind := UpdateMachineSetClassKind(d, isCopy, newRevision, true)
 
-		if annotationsUpdated || minReadySecondsNeedsUpdate || nodeTemplateUpdated || machineConfigUpdated || updateMachineSetClassKind {
+		if annotationsUpdated || minReadySecondsNeedsUpdate || deletePolicyNeedsUpdate || classFallbackNeedsUpdate || warmPoolNeedsUpdate || nodeTemplateUpdated || machineConfigUpdated || updateMachineSetClassKind {
 			isCopy.Spec.MinReadySeconds = d.Spec.MinReadySeconds
+			isCopy.Spec.DeletePolicy = d.Spec.DeletePolicy
+			isCopy.Spec.ClassFallback = d.Spec.ClassFallback.DeepCopy()
+			isCopy.Spec.WarmPool = d.Spec.WarmPool.DeepCopy()
 			return dc.controlMachineClient.MachineSets(isCopy.Namespace).Update(ctx, isCopy, metav1.UpdateOptions{})
 		}
 
@@ -277,7 +289,7 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 			}
 			dCopy := d.DeepCopy()
 			dCopy.Status = newStatus
-			if _, err = dc.controlMachineClient.MachineDeployments(dCopy.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{}); err != nil {
+			if _, err = dc.controlMachineClient.MachineDeployments(dCopy.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager}); err != nil {
 				return nil, err
 			}
 		}
@@ -315,6 +327,9 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 			MinReadySeconds: d.Spec.MinReadySeconds,
 			Selector:        newISSelector,
 			Template:        newISTemplate,
+			DeletePolicy:    d.Spec.DeletePolicy,
+			ClassFallback:   d.Spec.ClassFallback.DeepCopy(),
+			WarmPool:        d.Spec.WarmPool.DeepCopy(),
 		},
 	}
 	allISs := append(oldISs, &newIS)
@@ -362,7 +377,7 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 		*d.Status.CollisionCount++
 		// Update the collisionCount for the Deployment and let it requeue by returning the original
 		// error.
-		_, dErr := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{})
+		_, dErr := dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 		if dErr == nil {
 			klog.V(2).Infof("Found a hash collision for machine deployment %q - bumping collisionCount (%d->%d) to resolve it", d.Name, preCollisionCount, *d.Status.CollisionCount)
 		}
@@ -378,7 +393,7 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 		// We don't really care about this error at this point, since we have a bigger issue to report.
 		// TODO: Identify which errors are permanent and switch DeploymentIsFailed  to take into account
 		// these reasons as well. Related issue: https://github.com/kubernetes/kubernetes/issues/18568
-		_, _ = dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{})
+		_, _ = dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 		dc.recorder.Eventf(d, v1.EventTypeWarning, FailedISCreateReason, msg)
 		return nil, err
 	}
@@ -394,12 +409,17 @@ func (dc *controller) getNewMachineSet(ctx context.Context, d *v1alpha1.MachineD
 		needsUpdate = true
 	}
 	if needsUpdate {
-		_, err = dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{})
+		_, err = dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, d, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 	}
 	return createdIS, err
 }
 
 func (dc *controller) scale(ctx context.Context, deployment *v1alpha1.MachineDeployment, newIS *v1alpha1.MachineSet, oldISs []*v1alpha1.MachineSet) error {
+	// A blue/green update scales the machine sets serving the workload, independent of which of them are active.
+	if IsBlueGreen(deployment) {
+		return dc.scaleBlueGreen(ctx, deployment, newIS, oldISs)
+	}
+
 	// If there is only one active machine set then we should scale that up to the full count of the
 	// deployment. If there is no active machine set, then we should scale up the newest machine set.
 	if activeOrLatest := FindActiveOrLatest(newIS, oldISs); activeOrLatest != nil {
@@ -430,7 +450,8 @@ func (dc *controller) scale(ctx context.Context, deployment *v1alpha1.MachineDep
 	// So the scaling is handled this way:
 	// - Scale up   ? -> scale up only the new machineSet
 	// - Scale down ? -> scale down all active machineSets proportionally
-	if IsRollingUpdate(deployment) {
+	// A canary update corrects the proportions of its machine sets in its next step.
+	if IsRollingUpdate(deployment) || IsCanary(deployment) {
 		klog.V(3).Infof("Scaling all active machineSets proportionally for scale-in, while scaling up latest machineSet only for scale-out, machineDeployment %s", deployment.Name)
 		allISs := FilterActiveMachineSets(append(oldISs, newIS))
 		allISsReplicas := GetReplicaCountForMachineSets(allISs)
@@ -613,7 +634,7 @@ func (dc *controller) syncMachineDeploymentStatus(ctx context.Context, allISs []
 
 	newDeployment := d
 	newDeployment.Status = newStatus
-	_, err := dc.controlMachineClient.MachineDeployments(newDeployment.Namespace).UpdateStatus(ctx, newDeployment, metav1.UpdateOptions{})
+	_, err := dc.controlMachineClient.MachineDeployments(newDeployment.Namespace).UpdateStatus(ctx, newDeployment, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
 	return err
 }
 
@@ -636,7 +657,11 @@ func calculateDeploymentStatus(allISs []*v1alpha1.MachineSet, newIS *v1alpha1.Ma
 		ReadyReplicas:       GetReadyReplicaCountForMachineSets(allISs),
 		AvailableReplicas:   availableReplicas,
 		UnavailableReplicas: unavailableReplicas,
+		StandbyReplicas:     GetStandbyReplicaCountForMachineSets(allISs),
 		CollisionCount:      deployment.Status.CollisionCount,
+		Canary:              deployment.Status.Canary,
+		BlueGreen:           deployment.Status.BlueGreen,
+		ScheduledScaling:    deployment.Status.ScheduledScaling,
 	}
 	status.FailedMachines = []*v1alpha1.MachineSummary{}
 
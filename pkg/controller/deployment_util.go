@@ -35,7 +35,9 @@ import (
 
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	v1alpha1client "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/cron"
 	labelsutil "github.com/gardener/machine-controller-manager/pkg/util/labels"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
 	v1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -117,6 +119,13 @@ const (
 	RollbackTemplateUnchanged = "DeploymentRollbackTemplateUnchanged"
 	// RollbackDone is the done rollback event reason
 	RollbackDone = "DeploymentRollback"
+	// AutoRollbackTriggered is the event reason of an automatic rollback of a failing rollout
+	AutoRollbackTriggered = "DeploymentAutoRollback"
+	// AutoRollbackRevisionNotFound is the event reason of an automatic rollback without a completed revision to roll back to
+	AutoRollbackRevisionNotFound = "DeploymentAutoRollbackRevisionNotFound"
+	// RolloutCompleteAnnotation is set on the machine sets of a deployment whose rollout has completed.
+	// Automatic rollbacks only roll back to revisions carrying this annotation.
+	RolloutCompleteAnnotation = "deployment.machine.sapcloud.io/rollout-complete"
 
 	// MachineSetUpdatedReason is added in a deployment when one of its machine sets is updated as part
 	// of the rollout process.
@@ -449,13 +458,14 @@ func UpdateMachineSetClassKind(deployment *v1alpha1.MachineDeployment, newIS *v1
 }
 
 var annotationsToSkip = map[string]bool{
-	v1.LastAppliedConfigAnnotation: true,
-	RevisionAnnotation:             true,
-	RevisionHistoryAnnotation:      true,
-	DesiredReplicasAnnotation:      true,
-	MaxReplicasAnnotation:          true,
-	PreferNoScheduleKey:            true,
-	UnfreezeAnnotation:             true,
+	v1.LastAppliedConfigAnnotation:  true,
+	RevisionAnnotation:              true,
+	RevisionHistoryAnnotation:       true,
+	DesiredReplicasAnnotation:       true,
+	MaxReplicasAnnotation:           true,
+	PreferNoScheduleKey:             true,
+	machineutils.UnfreezeAnnotation: true,
+	RolloutCompleteAnnotation:       true,
 }
 
 // skipCopyAnnotation returns true if we should skip copying the annotation with the given annotation key
@@ -998,6 +1008,17 @@ func GetAvailableReplicaCountForMachineSets(MachineSets []*v1alpha1.MachineSet)
 	return totalAvailableReplicas
 }
 
+// GetStandbyReplicaCountForMachineSets returns the number of machines of the warm pools of the given machine sets.
+func GetStandbyReplicaCountForMachineSets(MachineSets []*v1alpha1.MachineSet) int32 {
+	totalStandbyReplicas := int32(0)
+	for _, is := range MachineSets {
+		if is != nil {
+			totalStandbyReplicas += is.Status.StandbyReplicas
+		}
+	}
+	return totalStandbyReplicas
+}
+
 // IsRollingUpdate returns true if the strategy type is a rolling update.
 func IsRollingUpdate(deployment *v1alpha1.MachineDeployment) bool {
 	return deployment.Spec.Strategy.Type == v1alpha1.RollingUpdateMachineDeploymentStrategyType
@@ -1077,6 +1098,52 @@ func MachineDeploymentTimedOut(deployment *v1alpha1.MachineDeployment, newStatus
 	return timedOut
 }
 
+// IsInMaintenanceWindow returns true if disruptive operations are allowed for the deployment at the given time.
+// If they are not, the duration until the next maintenance window opens is returned as well. It is 0
+// if none of the windows ever opens again.
+func IsInMaintenanceWindow(deployment *v1alpha1.MachineDeployment, now time.Time) (bool, time.Duration) {
+	maintenanceWindow := deployment.Spec.MaintenanceWindow
+	if maintenanceWindow == nil || len(maintenanceWindow.Windows) == 0 {
+		return true, 0
+	}
+
+	location := time.UTC
+	if maintenanceWindow.TimeZone != nil {
+		var err error
+		if location, err = time.LoadLocation(*maintenanceWindow.TimeZone); err != nil {
+			klog.Warningf("Invalid time zone %q in maintenance window of MachineDeployment %q, using UTC: %s", *maintenanceWindow.TimeZone, deployment.Name, err)
+			location = time.UTC
+		}
+	}
+	now = now.In(location)
+
+	var (
+		untilOpen    time.Duration
+		validWindows int
+	)
+	for _, window := range maintenanceWindow.Windows {
+		schedule, err := cron.Parse(window.Schedule)
+		if err != nil {
+			klog.Warningf("Ignoring invalid maintenance window schedule of MachineDeployment %q: %s", deployment.Name, err)
+			continue
+		}
+		validWindows++
+
+		// The window is open if it was opened within the last duration
+		if opened := schedule.Next(now.Add(-window.Duration.Duration)); !opened.IsZero() && !opened.After(now) {
+			return true, 0
+		}
+
+		if next := schedule.Next(now); !next.IsZero() && (untilOpen == 0 || next.Sub(now) < untilOpen) {
+			untilOpen = next.Sub(now)
+		}
+	}
+	if validWindows == 0 {
+		return true, 0
+	}
+	return false, untilOpen
+}
+
 // NewISNewReplicas calculates the number of replicas a deployment's new IS should have.
 // When one of the followings is true, we're rolling out the deployment; otherwise, we're scaling it.
 // 1) The new RS is saturated: newRS's replicas == deployment's replicas
@@ -1104,6 +1171,12 @@ func NewISNewReplicas(deployment *v1alpha1.MachineDeployment, allISs []*v1alpha1
 		return (newIS.Spec.Replicas) + scaleUpCount, nil
 	case v1alpha1.RecreateMachineDeploymentStrategyType:
 		return (deployment.Spec.Replicas), nil
+	case v1alpha1.CanaryMachineDeploymentStrategyType:
+		// The canary update scales up the new machine set step by step.
+		return 0, nil
+	case v1alpha1.BlueGreenMachineDeploymentStrategyType:
+		// The blue/green update scales up the new machine set to its full size next to the old ones.
+		return (deployment.Spec.Replicas), nil
 	default:
 		return 0, fmt.Errorf("machine deployment type %v isn't supported", deployment.Spec.Strategy.Type)
 	}
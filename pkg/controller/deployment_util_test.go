@@ -5,6 +5,8 @@
 package controller
 
 import (
+	"time"
+
 	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -12,6 +14,7 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 var _ = Describe("deployment_util", func() {
@@ -376,4 +379,33 @@ var _ = Describe("deployment_util", func() {
 
 	})
 
+	Describe("#IsInMaintenanceWindow", func() {
+		// 2024-01-01 is a Monday
+		monday := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		nightlyWindow := []machinev1.MaintenanceWindow{{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 4 * time.Hour}}}
+
+		DescribeTable("##maintenance window scenarios",
+			func(maintenanceWindow *machinev1.MachineDeploymentMaintenanceWindow, now time.Time, expectedInWindow bool, expectedUntilOpen time.Duration) {
+				machineDeployment := &machinev1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "md"},
+					Spec:       machinev1.MachineDeploymentSpec{MaintenanceWindow: maintenanceWindow},
+				}
+				inWindow, untilOpen := IsInMaintenanceWindow(machineDeployment, now)
+				Expect(inWindow).To(Equal(expectedInWindow))
+				Expect(untilOpen).To(Equal(expectedUntilOpen))
+			},
+			Entry("no maintenance window", nil, monday, true, time.Duration(0)),
+			Entry("window opened this minute", &machinev1.MachineDeploymentMaintenanceWindow{Windows: nightlyWindow},
+				monday.Add(22*time.Hour), true, time.Duration(0)),
+			Entry("window opened yesterday and still open", &machinev1.MachineDeploymentMaintenanceWindow{Windows: nightlyWindow},
+				monday.Add(time.Hour+30*time.Minute), true, time.Duration(0)),
+			Entry("window closed", &machinev1.MachineDeploymentMaintenanceWindow{Windows: nightlyWindow},
+				monday.Add(2*time.Hour), false, 20*time.Hour),
+			Entry("window evaluated in time zone", &machinev1.MachineDeploymentMaintenanceWindow{Windows: nightlyWindow, TimeZone: ptr.To("Asia/Kolkata")},
+				monday.Add(16*time.Hour+30*time.Minute), true, time.Duration(0)),
+			Entry("earliest of multiple windows", &machinev1.MachineDeploymentMaintenanceWindow{Windows: append([]machinev1.MaintenanceWindow{
+				{Schedule: "0 12 * * 1", Duration: metav1.Duration{Duration: time.Hour}},
+			}, nightlyWindow...)}, monday.Add(10*time.Hour), false, 2*time.Hour),
+		)
+	})
 })
@@ -0,0 +1,466 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	labelsutil "github.com/gardener/machine-controller-manager/pkg/util/labels"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ZoneSpreadZoneLabelKey is the label carrying the zone on the MachineDeployments managing the zones
+	// of a MachineDeployment with zone spread, and on their selectors and machine templates.
+	ZoneSpreadZoneLabelKey = "deployment.machine.sapcloud.io/zone"
+
+	// ZonesProgressingReason is added in a deployment with zone spread when the deployments of its zones are progressing.
+	ZonesProgressingReason = "ZonesProgressing"
+	// ZonesAvailableReason is added in a deployment with zone spread when the deployments of all its zones are complete.
+	ZonesAvailableReason = "ZonesAvailable"
+	// ZoneMachineDeploymentConflictReason is the event reason for a zone whose MachineDeployment name is taken by
+	// a MachineDeployment not managed by the deployment with zone spread.
+	ZoneMachineDeploymentConflictReason = "ZoneMachineDeploymentConflict"
+)
+
+// IsZoneSpread returns true if the machines of the deployment are spread across zones.
+func IsZoneSpread(deployment *v1alpha1.MachineDeployment) bool {
+	return deployment.Spec.ZoneSpread != nil
+}
+
+// ZoneMachineDeploymentName returns the name of the MachineDeployment managing the given zone of the deployment.
+func ZoneMachineDeploymentName(deployment *v1alpha1.MachineDeployment, zone string) string {
+	return deployment.Name + "-" + zone
+}
+
+// syncZoneSpread manages one MachineDeployment per zone of a deployment with zone spread, distributes the
+// replicas of the deployment across them and aggregates their status.
+func (dc *controller) syncZoneSpread(ctx context.Context, d *v1alpha1.MachineDeployment) error {
+	zoneDeployments, err := dc.getZoneMachineDeployments(d)
+	if err != nil {
+		return err
+	}
+	// Machine sets created before the zone spread was configured
+	machineSets, err := dc.getOwnedMachineSets(d)
+	if err != nil {
+		return err
+	}
+
+	if d.DeletionTimestamp != nil {
+		if finalizers := sets.NewString(d.Finalizers...); !finalizers.Has(DeleteFinalizerName) {
+			return nil
+		}
+		if len(zoneDeployments) == 0 && len(machineSets) == 0 {
+			dc.deleteMachineDeploymentFinalizers(ctx, d)
+			return nil
+		}
+		klog.V(4).Infof("Deleting all zone MachineDeployments as MachineDeployment %s has set deletionTimestamp", d.Name)
+		for _, zd := range zoneDeployments {
+			if zd.DeletionTimestamp == nil {
+				if err := dc.controlMachineClient.MachineDeployments(zd.Namespace).Delete(ctx, zd.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+					return err
+				}
+			}
+		}
+		dc.terminateMachineSets(ctx, machineSets, d)
+		return nil
+	}
+
+	failingZones := sets.New[string]()
+	for zone, zd := range zoneDeployments {
+		if cond := GetMachineDeploymentCondition(zd.Status, v1alpha1.MachineDeploymentProgressing); cond != nil && cond.Reason == TimedOutReason {
+			failingZones.Insert(zone)
+		}
+	}
+	zoneReplicas := ZoneSpreadReplicas(d.Spec.ZoneSpread, d.Spec.Replicas, failingZones)
+
+	zones := sets.New[string]()
+	for _, zone := range d.Spec.ZoneSpread.Zones {
+		zones.Insert(zone.Name)
+	}
+	// The machines of the machine sets created before the zone spread was configured and of the removed zones
+	// are moved to the zones within the surge and unavailability of the deployment, like in a rolling update.
+	var removedZoneDeployments []*v1alpha1.MachineDeployment
+	for zone, zd := range zoneDeployments {
+		if !zones.Has(zone) {
+			removedZoneDeployments = append(removedZoneDeployments, zd)
+		}
+	}
+	sort.Slice(removedZoneDeployments, func(i, j int) bool { return removedZoneDeployments[i].Name < removedZoneDeployments[j].Name })
+	leftoverReplicas := zoneSpreadLeftoverReplicas(machineSets, removedZoneDeployments)
+	maxSurge, maxUnavailable := zoneSpreadMigrationLimits(d)
+	if leftoverReplicas > 0 {
+		zoneReplicas = LimitZoneSpreadReplicas(d.Spec.ZoneSpread, zoneReplicas, d.Spec.Replicas+maxSurge-leftoverReplicas, failingZones)
+	}
+
+	var available int32
+	for i, zone := range d.Spec.ZoneSpread.Zones {
+		desired := newZoneMachineDeployment(d, &zone, zoneReplicas[i])
+		zd, ok := zoneDeployments[zone.Name]
+		if !ok {
+			if existing, err := dc.machineDeploymentLister.MachineDeployments(d.Namespace).Get(desired.Name); err == nil {
+				msg := fmt.Sprintf("MachineDeployment %q for zone %q already exists and is not managed by MachineDeployment %q", existing.Name, zone.Name, d.Name)
+				klog.Warning(msg)
+				dc.recorder.Event(d, v1.EventTypeWarning, ZoneMachineDeploymentConflictReason, msg)
+				continue
+			} else if !errors.IsNotFound(err) {
+				return err
+			}
+			klog.V(2).Infof("Creating MachineDeployment %q for zone %q of MachineDeployment %q", desired.Name, zone.Name, d.Name)
+			if zoneDeployments[zone.Name], err = dc.controlMachineClient.MachineDeployments(d.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+		available += zd.Status.AvailableReplicas
+		// The freeze of a zone MachineDeployment is owned by the safety controller
+		desired.Spec.Frozen = zd.Spec.Frozen
+		if apiequality.Semantic.DeepEqual(zd.Spec, desired.Spec) && apiequality.Semantic.DeepEqual(zd.Labels, desired.Labels) {
+			continue
+		}
+		zdCopy := zd.DeepCopy()
+		zdCopy.Labels = desired.Labels
+		zdCopy.Spec = desired.Spec
+		if zoneDeployments[zone.Name], err = dc.controlMachineClient.MachineDeployments(d.Namespace).Update(ctx, zdCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if err := dc.scaleDownZoneSpreadLeftovers(ctx, d, machineSets, removedZoneDeployments, leftoverReplicas+available-(d.Spec.Replicas-maxUnavailable)); err != nil {
+		return err
+	}
+
+	newStatus := calculateZoneSpreadStatus(d, zoneDeployments, zoneReplicas, failingZones)
+	if apiequality.Semantic.DeepEqual(d.Status, newStatus) {
+		return nil
+	}
+	dCopy := d.DeepCopy()
+	dCopy.Status = newStatus
+	_, err = dc.controlMachineClient.MachineDeployments(d.Namespace).UpdateStatus(ctx, dCopy, metav1.UpdateOptions{FieldManager: MachineDeploymentFieldManager})
+	return err
+}
+
+// zoneSpreadLeftoverReplicas returns the replicas of the machine sets created before the zone spread was
+// configured and of the deployments of removed zones.
+func zoneSpreadLeftoverReplicas(machineSets []*v1alpha1.MachineSet, removedZoneDeployments []*v1alpha1.MachineDeployment) int32 {
+	replicas := GetReplicaCountForMachineSets(machineSets)
+	for _, zd := range removedZoneDeployments {
+		replicas += zd.Spec.Replicas
+	}
+	return replicas
+}
+
+// zoneSpreadMigrationLimits returns the number of machines the zones of the deployment may surge and the number
+// of machines which may be unavailable while machines are moved to the zones. They are the limits of a rolling
+// update. Other strategies move one machine at a time.
+func zoneSpreadMigrationLimits(d *v1alpha1.MachineDeployment) (int32, int32) {
+	maxSurge, maxUnavailable := MaxSurge(*d), MaxUnavailable(*d)
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxSurge = 1
+	}
+	return maxSurge, maxUnavailable
+}
+
+// LimitZoneSpreadReplicas limits the sum of the replicas of the zones to the given number of replicas, which
+// are distributed by the policy of the zone spread. No zone gets more replicas than given.
+func LimitZoneSpreadReplicas(zoneSpread *v1alpha1.MachineDeploymentZoneSpread, zoneReplicas []int32, limit int32, failingZones sets.Set[string]) []int32 {
+	var sum int32
+	for _, replicas := range zoneReplicas {
+		sum += replicas
+	}
+	if sum <= limit {
+		return zoneReplicas
+	}
+	limited := ZoneSpreadReplicas(zoneSpread, max(limit, 0), failingZones)
+	for i := range limited {
+		limited[i] = min(limited[i], zoneReplicas[i])
+	}
+	return limited
+}
+
+// scaleDownZoneSpreadLeftovers scales down the machine sets created before the zone spread was configured and the
+// deployments of removed zones by at most the given number of replicas, within the maintenance window of the
+// deployment. The oldest machine sets are scaled down first. Once they have no machines left, they are deleted.
+func (dc *controller) scaleDownZoneSpreadLeftovers(ctx context.Context, d *v1alpha1.MachineDeployment, machineSets []*v1alpha1.MachineSet, removedZoneDeployments []*v1alpha1.MachineDeployment, scaleDownCount int32) error {
+	var emptyMachineSets []*v1alpha1.MachineSet
+	for _, is := range machineSets {
+		if is.Spec.Replicas == 0 && is.Status.Replicas == 0 {
+			emptyMachineSets = append(emptyMachineSets, is)
+		}
+	}
+	if len(emptyMachineSets) > 0 {
+		klog.V(2).Infof("Deleting the scaled down MachineSets of MachineDeployment %s", d.Name)
+		dc.terminateMachineSets(ctx, emptyMachineSets, d)
+	}
+	for _, zd := range removedZoneDeployments {
+		if zd.Spec.Replicas != 0 || zd.Status.Replicas != 0 || zd.DeletionTimestamp != nil {
+			continue
+		}
+		klog.V(2).Infof("Deleting MachineDeployment %q of removed zone %q of MachineDeployment %q", zd.Name, zd.Labels[ZoneSpreadZoneLabelKey], d.Name)
+		if err := dc.controlMachineClient.MachineDeployments(zd.Namespace).Delete(ctx, zd.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	leftoverReplicas := zoneSpreadLeftoverReplicas(machineSets, removedZoneDeployments)
+	if leftoverReplicas == 0 || scaleDownCount <= 0 {
+		return nil
+	}
+	if inWindow, untilOpen := IsInMaintenanceWindow(d, dc.clock.Now()); !inWindow {
+		klog.V(3).Infof("MachineDeployment %q is outside of its maintenance window, postponing scale down of the machines outside of its zones", d.Name)
+		if untilOpen > 0 {
+			dc.enqueueMachineDeploymentAfter(d, untilOpen)
+		}
+		return nil
+	}
+
+	sortedISs := append([]*v1alpha1.MachineSet{}, machineSets...)
+	sort.Sort(MachineSetsByCreationTimestamp(sortedISs))
+	for _, is := range sortedISs {
+		if scaleDownCount <= 0 {
+			return nil
+		}
+		if is.Spec.Replicas == 0 || is.DeletionTimestamp != nil {
+			continue
+		}
+		count := min(is.Spec.Replicas, scaleDownCount)
+		if _, _, err := dc.scaleMachineSetAndRecordEvent(ctx, is, is.Spec.Replicas-count, d); err != nil {
+			return err
+		}
+		scaleDownCount -= count
+	}
+	for _, zd := range removedZoneDeployments {
+		if scaleDownCount <= 0 {
+			return nil
+		}
+		if zd.Spec.Replicas == 0 || zd.DeletionTimestamp != nil {
+			continue
+		}
+		count := min(zd.Spec.Replicas, scaleDownCount)
+		zdCopy := zd.DeepCopy()
+		zdCopy.Spec.Replicas -= count
+		if _, err := dc.controlMachineClient.MachineDeployments(zd.Namespace).Update(ctx, zdCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		dc.recorder.Eventf(d, v1.EventTypeNormal, "ScalingMachineDeployment", "Scaled down MachineDeployment %s of removed zone to %d", zd.Name, zdCopy.Spec.Replicas)
+		scaleDownCount -= count
+	}
+	return nil
+}
+
+// getZoneMachineDeployments returns the MachineDeployments controlled by the deployment by their zone.
+func (dc *controller) getZoneMachineDeployments(d *v1alpha1.MachineDeployment) (map[string]*v1alpha1.MachineDeployment, error) {
+	deployments, err := dc.machineDeploymentLister.MachineDeployments(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	zoneDeployments := make(map[string]*v1alpha1.MachineDeployment)
+	for _, zd := range deployments {
+		if metav1.IsControlledBy(zd, d) {
+			zoneDeployments[zd.Labels[ZoneSpreadZoneLabelKey]] = zd
+		}
+	}
+	return zoneDeployments, nil
+}
+
+// getOwnedMachineSets returns the machine sets controlled by the deployment.
+func (dc *controller) getOwnedMachineSets(d *v1alpha1.MachineDeployment) ([]*v1alpha1.MachineSet, error) {
+	machineSets, err := dc.machineSetLister.MachineSets(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var owned []*v1alpha1.MachineSet
+	for _, is := range machineSets {
+		if metav1.IsControlledBy(is, d) {
+			owned = append(owned, is)
+		}
+	}
+	return owned, nil
+}
+
+// newZoneMachineDeployment returns the MachineDeployment managing the given zone of the deployment.
+func newZoneMachineDeployment(d *v1alpha1.MachineDeployment, zone *v1alpha1.MachineDeploymentZone, replicas int32) *v1alpha1.MachineDeployment {
+	spec := d.Spec.DeepCopy()
+	spec.Replicas = replicas
+	spec.ZoneSpread = nil
+	spec.ScheduledScaling = nil
+	spec.RollbackTo = nil
+	spec.Selector = labelsutil.CloneSelectorAndAddLabel(d.Spec.Selector, ZoneSpreadZoneLabelKey, zone.Name)
+	spec.Template.Labels = labelsutil.CloneAndAddLabel(d.Spec.Template.Labels, ZoneSpreadZoneLabelKey, zone.Name)
+	spec.Template.Spec.Class = zone.Class
+	return &v1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ZoneMachineDeploymentName(d, zone.Name),
+			Namespace:       d.Namespace,
+			Labels:          labelsutil.CloneAndAddLabel(d.Labels, ZoneSpreadZoneLabelKey, zone.Name),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(d, controllerKind)},
+		},
+		Spec: *spec,
+	}
+}
+
+// ZoneSpreadReplicas distributes the replicas across the zones of the zone spread by its policy and returns
+// the replicas of the zones in their order. The MaxSkew policy moves replicas from the failing zones to
+// the other zones as long as the replicas of any two zones differ by at most the max skew.
+func ZoneSpreadReplicas(zoneSpread *v1alpha1.MachineDeploymentZoneSpread, replicas int32, failingZones sets.Set[string]) []int32 {
+	zones := zoneSpread.Zones
+	zoneReplicas := make([]int32, len(zones))
+	if len(zones) == 0 {
+		return zoneReplicas
+	}
+
+	weights := make([]int64, len(zones))
+	var totalWeight int64
+	for i, zone := range zones {
+		weights[i] = 1
+		if zoneSpread.Policy == v1alpha1.WeightedZoneSpreadPolicy && zone.Weight != nil {
+			weights[i] = int64(*zone.Weight)
+		}
+		totalWeight += weights[i]
+	}
+	if totalWeight == 0 {
+		return zoneReplicas
+	}
+
+	// Distribute by the largest remainder, ties are resolved by the order of the zones.
+	remainders := make([]int64, len(zones))
+	leftover := replicas
+	for i := range zones {
+		// #nosec G115 -- the share of a zone does not exceed the replicas
+		zoneReplicas[i] = int32(int64(replicas) * weights[i] / totalWeight)
+		remainders[i] = int64(replicas) * weights[i] % totalWeight
+		leftover -= zoneReplicas[i]
+	}
+	order := make([]int, len(zones))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+	for i := 0; i < int(leftover); i++ {
+		zoneReplicas[order[i%len(order)]]++
+	}
+
+	if zoneSpread.Policy != v1alpha1.MaxSkewZoneSpreadPolicy || zoneSpread.MaxSkew == nil {
+		return zoneReplicas
+	}
+	for {
+		from, to := -1, -1
+		for i, zone := range zones {
+			if failingZones.Has(zone.Name) {
+				if zoneReplicas[i] > 0 && (from < 0 || zoneReplicas[i] > zoneReplicas[from]) {
+					from = i
+				}
+			} else if to < 0 || zoneReplicas[i] < zoneReplicas[to] {
+				to = i
+			}
+		}
+		if from < 0 || to < 0 {
+			return zoneReplicas
+		}
+		zoneReplicas[from]--
+		zoneReplicas[to]++
+		if maxReplicas(zoneReplicas)-minReplicas(zoneReplicas) > *zoneSpread.MaxSkew {
+			zoneReplicas[from]++
+			zoneReplicas[to]--
+			return zoneReplicas
+		}
+	}
+}
+
+func maxReplicas(replicas []int32) int32 {
+	m := replicas[0]
+	for _, r := range replicas[1:] {
+		m = max(m, r)
+	}
+	return m
+}
+
+func minReplicas(replicas []int32) int32 {
+	m := replicas[0]
+	for _, r := range replicas[1:] {
+		m = min(m, r)
+	}
+	return m
+}
+
+// calculateZoneSpreadStatus aggregates the status of the deployment from the deployments of its zones.
+func calculateZoneSpreadStatus(d *v1alpha1.MachineDeployment, zoneDeployments map[string]*v1alpha1.MachineDeployment, zoneReplicas []int32, failingZones sets.Set[string]) v1alpha1.MachineDeploymentStatus {
+	status := v1alpha1.MachineDeploymentStatus{
+		ObservedGeneration: d.Generation,
+		Conditions:         append([]v1alpha1.MachineDeploymentCondition(nil), d.Status.Conditions...),
+		ScheduledScaling:   d.Status.ScheduledScaling,
+	}
+	var unavailableZones, progressingZones []string
+	for i, zone := range d.Spec.ZoneSpread.Zones {
+		zoneStatus := v1alpha1.MachineDeploymentZoneStatus{
+			Name:              zone.Name,
+			MachineDeployment: ZoneMachineDeploymentName(d, zone.Name),
+			Replicas:          zoneReplicas[i],
+		}
+		zd, ok := zoneDeployments[zone.Name]
+		if !ok {
+			unavailableZones = append(unavailableZones, zone.Name)
+			progressingZones = append(progressingZones, zone.Name)
+			status.Zones = append(status.Zones, zoneStatus)
+			continue
+		}
+		zoneStatus.UpdatedReplicas = zd.Status.UpdatedReplicas
+		zoneStatus.ReadyReplicas = zd.Status.ReadyReplicas
+		zoneStatus.AvailableReplicas = zd.Status.AvailableReplicas
+		status.Zones = append(status.Zones, zoneStatus)
+
+		status.Replicas += zd.Status.Replicas
+		status.UpdatedReplicas += zd.Status.UpdatedReplicas
+		status.ReadyReplicas += zd.Status.ReadyReplicas
+		status.AvailableReplicas += zd.Status.AvailableReplicas
+		status.UnavailableReplicas += zd.Status.UnavailableReplicas
+
+		if cond := GetMachineDeploymentCondition(zd.Status, v1alpha1.MachineDeploymentAvailable); cond == nil || cond.Status != v1alpha1.ConditionTrue {
+			unavailableZones = append(unavailableZones, zone.Name)
+		}
+		if zd.Spec.Replicas != zoneReplicas[i] || !MachineDeploymentComplete(zd, &zd.Status) {
+			progressingZones = append(progressingZones, zone.Name)
+		}
+	}
+
+	if len(unavailableZones) == 0 {
+		SetMachineDeploymentCondition(&status, *NewMachineDeploymentCondition(v1alpha1.MachineDeploymentAvailable, v1alpha1.ConditionTrue, MinimumReplicasAvailable, "All zones have minimum availability."))
+	} else {
+		msg := fmt.Sprintf("Zones %s do not have minimum availability.", strings.Join(unavailableZones, ", "))
+		SetMachineDeploymentCondition(&status, *NewMachineDeploymentCondition(v1alpha1.MachineDeploymentAvailable, v1alpha1.ConditionFalse, MinimumReplicasUnavailable, msg))
+	}
+	switch {
+	case failingZones.Len() > 0:
+		msg := fmt.Sprintf("Zones %s have exceeded their progress deadline.", strings.Join(sets.List(failingZones), ", "))
+		SetMachineDeploymentCondition(&status, *NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionFalse, TimedOutReason, msg))
+	case len(progressingZones) > 0:
+		msg := fmt.Sprintf("Zones %s are progressing.", strings.Join(progressingZones, ", "))
+		SetMachineDeploymentCondition(&status, *NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionTrue, ZonesProgressingReason, msg))
+	default:
+		SetMachineDeploymentCondition(&status, *NewMachineDeploymentCondition(v1alpha1.MachineDeploymentProgressing, v1alpha1.ConditionTrue, ZonesAvailableReason, "All zones have successfully progressed."))
+	}
+	return status
+}
+
+// enqueueZoneSpreadDeployment enqueues the deployment with zone spread controlling the given deployment, if any.
+func (dc *controller) enqueueZoneSpreadDeployment(d *v1alpha1.MachineDeployment) {
+	controllerRef := metav1.GetControllerOf(d)
+	if controllerRef == nil {
+		return
+	}
+	if parent := dc.resolveDeploymentControllerRef(d.Namespace, controllerRef); parent != nil {
+		dc.enqueueMachineDeployment(parent)
+	}
+}
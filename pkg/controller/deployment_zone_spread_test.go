@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("deployment_zone_spread", func() {
+	newZones := func(weights ...int32) []machinev1.MachineDeploymentZone {
+		names := []string{"a", "b", "c"}
+		zones := make([]machinev1.MachineDeploymentZone, 0, len(weights))
+		for i, weight := range weights {
+			zones = append(zones, machinev1.MachineDeploymentZone{
+				Name:   names[i],
+				Class:  machinev1.ClassSpec{Kind: "MachineClass", Name: "class-" + names[i]},
+				Weight: ptr.To(weight),
+			})
+		}
+		return zones
+	}
+
+	Describe("#ZoneSpreadReplicas", func() {
+		DescribeTable("##table",
+			func(zoneSpread *machinev1.MachineDeploymentZoneSpread, replicas int32, failingZones []string, expected []int32) {
+				Expect(ZoneSpreadReplicas(zoneSpread, replicas, sets.New(failingZones...))).To(Equal(expected))
+			},
+			Entry("even spread", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(5, 1, 1), Policy: machinev1.EvenZoneSpreadPolicy,
+			}, int32(7), nil, []int32{3, 2, 2}),
+			Entry("weighted spread", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(2, 1, 1), Policy: machinev1.WeightedZoneSpreadPolicy,
+			}, int32(6), nil, []int32{3, 2, 1}),
+			Entry("weighted spread with zero weight", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(1, 0, 1), Policy: machinev1.WeightedZoneSpreadPolicy,
+			}, int32(5), nil, []int32{3, 0, 2}),
+			Entry("max skew without failing zones", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(1, 1, 1), Policy: machinev1.MaxSkewZoneSpreadPolicy, MaxSkew: ptr.To[int32](2),
+			}, int32(6), nil, []int32{2, 2, 2}),
+			Entry("max skew moves replicas out of a failing zone", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(1, 1, 1), Policy: machinev1.MaxSkewZoneSpreadPolicy, MaxSkew: ptr.To[int32](2),
+			}, int32(6), []string{"b"}, []int32{3, 1, 2}),
+			Entry("max skew moves all replicas out of a failing zone", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(1, 1), Policy: machinev1.MaxSkewZoneSpreadPolicy, MaxSkew: ptr.To[int32](10),
+			}, int32(4), []string{"a"}, []int32{0, 4}),
+			Entry("max skew with all zones failing", &machinev1.MachineDeploymentZoneSpread{
+				Zones: newZones(1, 1), Policy: machinev1.MaxSkewZoneSpreadPolicy, MaxSkew: ptr.To[int32](1),
+			}, int32(4), []string{"a", "b"}, []int32{2, 2}),
+		)
+	})
+
+	Describe("#syncZoneSpread", func() {
+		newDeployment := func() *machinev1.MachineDeployment {
+			return &machinev1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: testNamespace, UID: "md-uid", Generation: 2},
+				Spec: machinev1.MachineDeploymentSpec{
+					Replicas: 5,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"key": "value"}},
+					Template: machinev1.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "value"}},
+					},
+					ZoneSpread: &machinev1.MachineDeploymentZoneSpread{
+						Zones:  newZones(1, 1),
+						Policy: machinev1.EvenZoneSpreadPolicy,
+					},
+				},
+			}
+		}
+
+		It("should create a deployment per zone", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			d := newDeployment()
+			c, trackers := createController(stop, testNamespace, []runtime.Object{d}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+			c.recorder = record.NewFakeRecorder(10)
+
+			Expect(c.syncZoneSpread(context.TODO(), d)).To(Succeed())
+
+			zoneA, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), "md-a", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metav1.IsControlledBy(zoneA, d)).To(BeTrue())
+			Expect(zoneA.Labels).To(HaveKeyWithValue(ZoneSpreadZoneLabelKey, "a"))
+			Expect(zoneA.Spec.Replicas).To(Equal(int32(3)))
+			Expect(zoneA.Spec.ZoneSpread).To(BeNil())
+			Expect(zoneA.Spec.Selector.MatchLabels).To(Equal(map[string]string{"key": "value", ZoneSpreadZoneLabelKey: "a"}))
+			Expect(zoneA.Spec.Template.Labels).To(Equal(map[string]string{"key": "value", ZoneSpreadZoneLabelKey: "a"}))
+			Expect(zoneA.Spec.Template.Spec.Class.Name).To(Equal("class-a"))
+
+			zoneB, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), "md-b", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zoneB.Spec.Replicas).To(Equal(int32(2)))
+			Expect(zoneB.Spec.Template.Spec.Class.Name).To(Equal("class-b"))
+
+			actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Status.ObservedGeneration).To(Equal(int64(2)))
+			Expect(actual.Status.Zones).To(HaveLen(2))
+			Expect(actual.Status.Zones[0].MachineDeployment).To(Equal("md-a"))
+			Expect(actual.Status.Zones[0].Replicas).To(Equal(int32(3)))
+			Expect(GetMachineDeploymentCondition(actual.Status, machinev1.MachineDeploymentProgressing).Reason).To(Equal(ZonesProgressingReason))
+		})
+
+		It("should aggregate the status of the zones and drain the deployments of removed zones", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			d := newDeployment()
+			d.Spec.Replicas = 4
+			zoneA := newZoneMachineDeployment(d, &d.Spec.ZoneSpread.Zones[0], 2)
+			zoneA.Status = machinev1.MachineDeploymentStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}
+			zoneA.Status.Conditions = []machinev1.MachineDeploymentCondition{
+				*NewMachineDeploymentCondition(machinev1.MachineDeploymentAvailable, machinev1.ConditionTrue, MinimumReplicasAvailable, ""),
+			}
+			zoneB := zoneA.DeepCopy()
+			zoneB.Name = "md-b"
+			zoneB.Labels[ZoneSpreadZoneLabelKey] = "b"
+			zoneB.Spec = newZoneMachineDeployment(d, &d.Spec.ZoneSpread.Zones[1], 2).Spec
+			removedC := newZoneMachineDeployment(d, &machinev1.MachineDeploymentZone{Name: "c"}, 1)
+			removedC.Status = machinev1.MachineDeploymentStatus{Replicas: 1, AvailableReplicas: 1}
+			removedD := newZoneMachineDeployment(d, &machinev1.MachineDeploymentZone{Name: "d"}, 0)
+
+			c, trackers := createController(stop, testNamespace, []runtime.Object{d, zoneA, zoneB, removedC, removedD}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+			c.recorder = record.NewFakeRecorder(10)
+
+			Expect(c.syncZoneSpread(context.TODO(), d)).To(Succeed())
+
+			actualC, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), "md-c", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actualC.Spec.Replicas).To(Equal(int32(0)))
+			_, err = c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), "md-d", metav1.GetOptions{})
+			Expect(err).To(HaveOccurred())
+
+			actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), d.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.Status.Replicas).To(Equal(int32(4)))
+			Expect(actual.Status.AvailableReplicas).To(Equal(int32(4)))
+			Expect(GetMachineDeploymentCondition(actual.Status, machinev1.MachineDeploymentAvailable).Status).To(Equal(machinev1.ConditionTrue))
+			Expect(GetMachineDeploymentCondition(actual.Status, machinev1.MachineDeploymentProgressing).Reason).To(Equal(ZonesAvailableReason))
+		})
+
+		DescribeTable("##migration of machine sets created before the zone spread",
+			func(zoneAAvailable int32, maintenanceSchedule string, expectedZoneReplicas []int32, expectedOldReplicas int32) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+				d := newDeployment()
+				d.Spec.Replicas = 4
+				d.Spec.Strategy = machinev1.MachineDeploymentStrategy{
+					Type: machinev1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &machinev1.RollingUpdateMachineDeployment{
+						MaxSurge:       ptr.To(intstr.FromInt32(1)),
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					},
+				}
+				if maintenanceSchedule != "" {
+					d.Spec.MaintenanceWindow = &machinev1.MachineDeploymentMaintenanceWindow{
+						Windows: []machinev1.MaintenanceWindow{{Schedule: maintenanceSchedule, Duration: metav1.Duration{Duration: 2 * time.Hour}}},
+					}
+				}
+				controllerRef := []metav1.OwnerReference{*metav1.NewControllerRef(d, controllerKind)}
+				old := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "md-old", Namespace: testNamespace, UID: "old", OwnerReferences: controllerRef},
+					Spec:       machinev1.MachineSetSpec{Replicas: 4},
+					Status:     machinev1.MachineSetStatus{Replicas: 4, AvailableReplicas: 4},
+				}
+				empty := &machinev1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "md-empty", Namespace: testNamespace, UID: "empty", OwnerReferences: controllerRef},
+				}
+				zoneA := newZoneMachineDeployment(d, &d.Spec.ZoneSpread.Zones[0], 1)
+				zoneA.Status = machinev1.MachineDeploymentStatus{Replicas: 1, AvailableReplicas: zoneAAvailable}
+
+				c, trackers := createController(stop, testNamespace, []runtime.Object{d, zoneA, old, empty}, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				waitForCacheSync(stop, c)
+				c.recorder = record.NewFakeRecorder(10)
+
+				Expect(c.syncZoneSpread(context.TODO(), d)).To(Succeed())
+
+				for i, name := range []string{"md-a", "md-b"} {
+					zd, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(zd.Spec.Replicas).To(Equal(expectedZoneReplicas[i]))
+				}
+				actualOld, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), old.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actualOld.Spec.Replicas).To(Equal(expectedOldReplicas))
+				_, err = c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), empty.Name, metav1.GetOptions{})
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("should only surge while the new machines are unavailable", int32(0), "", []int32{1, 0}, int32(4)),
+			Entry("should scale down the old machine set by the available new machines", int32(1), "", []int32{1, 0}, int32(3)),
+			Entry("should not scale down the old machine set outside of the maintenance window", int32(1), "0 22 * * *", []int32{1, 0}, int32(4)),
+			Entry("should scale down the old machine set within the maintenance window", int32(1), "0 11 * * *", []int32{1, 0}, int32(3)),
+		)
+
+		It("should not adopt a deployment taking the name of a zone deployment", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			d := newDeployment()
+			foreign := &machinev1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "md-b", Namespace: testNamespace}}
+
+			c, trackers := createController(stop, testNamespace, []runtime.Object{d, foreign}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+			recorder := record.NewFakeRecorder(10)
+			c.recorder = recorder
+
+			Expect(c.syncZoneSpread(context.TODO(), d)).To(Succeed())
+
+			actual, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), "md-b", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual.OwnerReferences).To(BeEmpty())
+			Expect(actual.Spec.Replicas).To(Equal(int32(0)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(ZoneMachineDeploymentConflictReason)))
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/cache"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
 
 	"k8s.io/klog/v2"
 )
@@ -25,9 +26,29 @@ const (
 	// MachineDeploymentStateSync freeze reason when machineDeployment was found with inconsistent state
 	MachineDeploymentStateSync = "MachineDeploymentStateSync"
 	// UnfreezeAnnotation indicates the controllers to unfreeze this object
-	UnfreezeAnnotation = "safety.machine.sapcloud.io/unfreeze"
+	//
+	// Deprecated: Use machineutils.UnfreezeAnnotation instead.
+	UnfreezeAnnotation = machineutils.UnfreezeAnnotation
+	// FreezeLabel is the legacy label freezing a machineSet/machineDeployment if set to FreezeLabelValue.
+	//
+	// Deprecated: Use machineutils.FreezeLabel instead.
+	FreezeLabel = machineutils.FreezeLabel
+	// FreezeLabelValue is the value of the legacy freeze label of a frozen machineSet/machineDeployment
+	//
+	// Deprecated: Use machineutils.FreezeLabelValue instead.
+	FreezeLabelValue = machineutils.FreezeLabelValue
 )
 
+// IsMachineSetFrozen returns true if the machineSet is frozen by its spec or by the legacy freeze label
+func IsMachineSetFrozen(machineSet *v1alpha1.MachineSet) bool {
+	return machineSet.Spec.Frozen || machineSet.Labels[machineutils.FreezeLabel] == machineutils.FreezeLabelValue
+}
+
+// IsMachineDeploymentFrozen returns true if the machineDeployment is frozen by its spec or by the legacy freeze label
+func IsMachineDeploymentFrozen(machineDeployment *v1alpha1.MachineDeployment) bool {
+	return machineDeployment.Spec.Frozen || machineDeployment.Labels[machineutils.FreezeLabel] == machineutils.FreezeLabelValue
+}
+
 // reconcileClusterMachineSafetyOvershooting checks all machineSet/machineDeployment
 // if the number of machine objects backing them is way beyond its desired replicas
 func (c *controller) reconcileClusterMachineSafetyOvershooting(_ string) error {
@@ -76,7 +97,7 @@ func (c *controller) unfreezeMachineDeploymentsWithUnfreezeAnnotation(ctx contex
 	}
 
 	for _, machineDeployment := range machineDeployments {
-		if _, exists := machineDeployment.Annotations[UnfreezeAnnotation]; exists {
+		if _, exists := machineDeployment.Annotations[machineutils.UnfreezeAnnotation]; exists {
 			klog.V(2).Infof("SafetyController: UnFreezing MachineDeployment %q due to setting unfreeze annotation", machineDeployment.Name)
 
 			err := c.unfreezeMachineDeployment(ctx, machineDeployment, "UnfreezeAnnotation")
@@ -84,7 +105,7 @@ func (c *controller) unfreezeMachineDeploymentsWithUnfreezeAnnotation(ctx contex
 				return err
 			}
 
-			// Apply UnfreezeAnnotation on all machineSets backed by the machineDeployment
+			// Apply the unfreeze annotation on all machineSets backed by the machineDeployment
 			machineSets, err := c.getMachineSetsForMachineDeployment(ctx, machineDeployment)
 			if err == nil {
 				for _, machineSet := range machineSets {
@@ -99,7 +120,7 @@ func (c *controller) unfreezeMachineDeploymentsWithUnfreezeAnnotation(ctx contex
 					if clone.Annotations == nil {
 						clone.Annotations = make(map[string]string)
 					}
-					clone.Annotations[UnfreezeAnnotation] = "True"
+					clone.Annotations[machineutils.UnfreezeAnnotation] = "True"
 					machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).Update(ctx, clone, metav1.UpdateOptions{})
 					if err != nil {
 						klog.Errorf("SafetyController: MachineSet %s UPDATE failed. Error: %s", machineSet.Name, err)
@@ -122,7 +143,7 @@ func (c *controller) unfreezeMachineSetsWithUnfreezeAnnotation(ctx context.Conte
 	}
 
 	for _, machineSet := range machineSets {
-		if _, exists := machineSet.Annotations[UnfreezeAnnotation]; exists {
+		if _, exists := machineSet.Annotations[machineutils.UnfreezeAnnotation]; exists {
 			klog.V(2).Infof("SafetyController: UnFreezing MachineSet %q due to setting unfreeze annotation", machineSet.Name)
 
 			err := c.unfreezeMachineSet(ctx, machineSet)
@@ -145,14 +166,14 @@ func (c *controller) syncMachineDeploymentFreezeState(ctx context.Context) error
 
 	for _, machineDeployment := range machineDeployments {
 
-		machineDeploymentFreezeLabelPresent := (machineDeployment.Labels["freeze"] == "True")
+		machineDeploymentFreezeLabelPresent := IsMachineDeploymentFrozen(machineDeployment)
 		machineDeploymentFrozenConditionPresent := (GetMachineDeploymentCondition(machineDeployment.Status, v1alpha1.MachineDeploymentFrozen) != nil)
 
 		machineDeploymentHasFrozenMachineSet := false
 		machineSets, err := c.getMachineSetsForMachineDeployment(ctx, machineDeployment)
 		if err == nil {
 			for _, machineSet := range machineSets {
-				machineSetFreezeLabelPresent := (machineSet.Labels["freeze"] == "True")
+				machineSetFreezeLabelPresent := IsMachineSetFrozen(machineSet)
 				machineSetFrozenConditionPresent := (GetCondition(&machineSet.Status, v1alpha1.MachineSetFrozen) != nil)
 
 				if machineSetFreezeLabelPresent || machineSetFrozenConditionPresent {
@@ -218,8 +239,11 @@ func (c *controller) checkAndFreezeORUnfreezeMachineSets(ctx context.Context) er
 			}
 		}
 
+		// Machines of the warm pool are counted against the desired replicas plus the size of the warm pool
+		warmPool := int32(warmPoolSize(machineSet)) // #nosec G115 (CWE-190) -- value already validated
+
 		// Freeze machinesets when replica count exceeds by SafetyUP
-		higherThreshold := 2*machineSet.Spec.Replicas + c.safetyOptions.SafetyUp
+		higherThreshold := 2*(machineSet.Spec.Replicas+warmPool) + c.safetyOptions.SafetyUp
 		// Unfreeze machineset when replica count reaches higherThreshold - SafetyDown
 		lowerThreshold := higherThreshold - c.safetyOptions.SafetyDown
 
@@ -238,7 +262,7 @@ func (c *controller) checkAndFreezeORUnfreezeMachineSets(ctx context.Context) er
 					klog.Error("SafetyController: Error while trying to GET surge value - ", err)
 					return err
 				}
-				higherThreshold = machineDeployment.Spec.Replicas + int32(surge) + c.safetyOptions.SafetyUp // #nosec G115 (CWE-190) -- value already validated
+				higherThreshold = machineDeployment.Spec.Replicas + int32(surge) + warmPool + c.safetyOptions.SafetyUp // #nosec G115 (CWE-190) -- value already validated
 				lowerThreshold = higherThreshold - c.safetyOptions.SafetyDown
 			}
 		}
@@ -253,7 +277,7 @@ func (c *controller) checkAndFreezeORUnfreezeMachineSets(ctx context.Context) er
 
 		machineSetFrozenCondition := GetCondition(&machineSet.Status, v1alpha1.MachineSetFrozen)
 
-		if machineSet.Labels["freeze"] != "True" &&
+		if !IsMachineSetFrozen(machineSet) &&
 			fullyLabeledReplicasCount >= higherThreshold {
 			message := fmt.Sprintf(
 				"The number of machines backing MachineSet: %s is %d >= %d which is the Max-ScaleUp-Limit",
@@ -264,7 +288,7 @@ func (c *controller) checkAndFreezeORUnfreezeMachineSets(ctx context.Context) er
 			return c.freezeMachineSetAndDeployment(ctx, machineSet, OverShootingReplicaCount, message)
 
 		} else if fullyLabeledReplicasCount <= lowerThreshold &&
-			(machineSet.Labels["freeze"] == "True" || machineSetFrozenCondition != nil) {
+			(IsMachineSetFrozen(machineSet) || machineSetFrozenCondition != nil) {
 			// Unfreeze if number of replicas is less than or equal to lowerThreshold
 			// and freeze label or condition exists on machineSet
 			return c.unfreezeMachineSetAndDeployment(ctx, machineSet)
@@ -302,17 +326,14 @@ func (c *controller) freezeMachineSetAndDeployment(ctx context.Context, machineS
 	mscond := NewMachineSetCondition(v1alpha1.MachineSetFrozen, v1alpha1.ConditionTrue, reason, message)
 	SetCondition(&newStatus, mscond)
 	clone.Status = newStatus
-	machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: MachineSafetyFieldManager})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineSet/status UPDATE failed. Error: %s", err)
 		return err
 	}
 
 	clone = machineSet.DeepCopy()
-	if clone.Labels == nil {
-		clone.Labels = make(map[string]string)
-	}
-	clone.Labels["freeze"] = "True"
+	clone.Spec.Frozen = true
 	_, err = c.controlMachineClient.MachineSets(clone.Namespace).Update(ctx, clone, metav1.UpdateOptions{})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineSet UPDATE failed. Error: %s", err)
@@ -377,7 +398,7 @@ func (c *controller) unfreezeMachineSet(ctx context.Context, machineSet *v1alpha
 	newStatus := clone.Status
 	RemoveCondition(&newStatus, v1alpha1.MachineSetFrozen)
 	clone.Status = newStatus
-	machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: MachineSafetyFieldManager})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineSet/status UPDATE failed. Error: %s", err)
 		return err
@@ -387,11 +408,12 @@ func (c *controller) unfreezeMachineSet(ctx context.Context, machineSet *v1alpha
 	if clone.Annotations == nil {
 		clone.Annotations = make(map[string]string)
 	}
-	delete(clone.Annotations, UnfreezeAnnotation)
+	delete(clone.Annotations, machineutils.UnfreezeAnnotation)
 	if clone.Labels == nil {
 		clone.Labels = make(map[string]string)
 	}
-	delete(clone.Labels, "freeze")
+	delete(clone.Labels, machineutils.FreezeLabel)
+	clone.Spec.Frozen = false
 	machineSet, err = c.controlMachineClient.MachineSets(clone.Namespace).Update(ctx, clone, metav1.UpdateOptions{})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineSet UPDATE failed. Error: %s", err)
@@ -416,17 +438,14 @@ func (c *controller) freezeMachineDeployment(ctx context.Context, machineDeploym
 	mdcond := NewMachineDeploymentCondition(v1alpha1.MachineDeploymentFrozen, v1alpha1.ConditionTrue, reason, message)
 	SetMachineDeploymentCondition(&newStatus, *mdcond)
 	clone.Status = newStatus
-	machineDeployment, err = c.controlMachineClient.MachineDeployments(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	machineDeployment, err = c.controlMachineClient.MachineDeployments(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: MachineSafetyFieldManager})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineDeployment/status UPDATE failed. Error: %s", err)
 		return err
 	}
 
 	clone = machineDeployment.DeepCopy()
-	if clone.Labels == nil {
-		clone.Labels = make(map[string]string)
-	}
-	clone.Labels["freeze"] = "True"
+	clone.Spec.Frozen = true
 	_, err = c.controlMachineClient.MachineDeployments(clone.Namespace).Update(ctx, clone, metav1.UpdateOptions{})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineDeployment UPDATE failed. Error: %s", err)
@@ -458,7 +477,7 @@ func (c *controller) unfreezeMachineDeployment(ctx context.Context, machineDeplo
 	newStatus := clone.Status
 	RemoveMachineDeploymentCondition(&newStatus, v1alpha1.MachineDeploymentFrozen)
 	clone.Status = newStatus
-	machineDeployment, err = c.controlMachineClient.MachineDeployments(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	machineDeployment, err = c.controlMachineClient.MachineDeployments(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: MachineSafetyFieldManager})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineDeployment/status UPDATE failed. Error: %s", err)
 		return err
@@ -468,11 +487,12 @@ func (c *controller) unfreezeMachineDeployment(ctx context.Context, machineDeplo
 	if clone.Annotations == nil {
 		clone.Annotations = make(map[string]string)
 	}
-	delete(clone.Annotations, UnfreezeAnnotation)
+	delete(clone.Annotations, machineutils.UnfreezeAnnotation)
 	if clone.Labels == nil {
 		clone.Labels = make(map[string]string)
 	}
-	delete(clone.Labels, "freeze")
+	delete(clone.Labels, machineutils.FreezeLabel)
+	clone.Spec.Frozen = false
 	machineDeployment, err = c.controlMachineClient.MachineDeployments(clone.Namespace).Update(ctx, clone, metav1.UpdateOptions{})
 	if err != nil {
 		klog.Errorf("SafetyController: MachineDeployment UPDATE failed. Error: %s", err)
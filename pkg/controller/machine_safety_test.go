@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	faketyped "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1alpha1/fake"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("machine_safety", func() {
+	template := &v1alpha1.MachineTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"test-label": "test-label"},
+		},
+	}
+
+	// setup creates a machine deployment with the given annotations owning one machine set and returns the
+	// controller together with a function returning the latest machine deployment and machine set
+	setup := func(stop chan struct{}, annotations map[string]string, frozen bool) (*controller, func() (*v1alpha1.MachineDeployment, *v1alpha1.MachineSet)) {
+		machineDeployment := newMachineDeployment(template, 1, 0, 1, 1, nil, nil, annotations, template.Labels)
+		machineDeployment.UID = "machinedeployment-uid"
+		machineDeployment.Spec.Frozen = frozen
+		machineSet := newMachineSet(template, "machineset-0", 1, 0, nil, &metav1.OwnerReference{
+			APIVersion: "machine.sapcloud.io/v1alpha1",
+			Kind:       "MachineDeployment",
+			Name:       machineDeployment.Name,
+			UID:        machineDeployment.UID,
+			Controller: ptr.To(true),
+		}, nil, template.Labels)
+		machineSet.Spec.Frozen = frozen
+
+		c, trackers := createController(stop, testNamespace, []runtime.Object{machineDeployment, machineSet}, nil, nil)
+		DeferCleanup(trackers.Stop)
+		waitForCacheSync(stop, c)
+
+		return c, func() (*v1alpha1.MachineDeployment, *v1alpha1.MachineSet) {
+			md, err := c.controlMachineClient.MachineDeployments(testNamespace).Get(context.TODO(), machineDeployment.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			ms, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), machineSet.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return md, ms
+		}
+	}
+
+	// statusFieldManagers returns the field managers of the status updates sent by the controller
+	statusFieldManagers := func(c *controller) []string {
+		var fieldManagers []string
+		for _, action := range c.controlMachineClient.(*faketyped.FakeMachineV1alpha1).Actions() {
+			if update, ok := action.(k8stesting.UpdateActionImpl); ok && update.GetSubresource() == "status" {
+				fieldManagers = append(fieldManagers, update.UpdateOptions.FieldManager)
+			}
+		}
+		return fieldManagers
+	}
+
+	Describe("#checkAndFreezeORUnfreezeMachineSets", func() {
+		It("should unfreeze a machine set without machines", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+			c, get := setup(stop, nil, true)
+
+			Expect(c.checkAndFreezeORUnfreezeMachineSets(context.TODO())).To(Succeed())
+
+			machineDeployment, machineSet := get()
+			Expect(machineDeployment.Spec.Frozen).To(BeFalse())
+			Expect(machineSet.Spec.Frozen).To(BeFalse())
+		})
+
+		DescribeTable("should count machines of the warm pool against the replicas and the size of the warm pool",
+			func(warmPoolSize int32, expectFrozen bool) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				machineSet := newMachineSet(template, "machineset-0", 1, 0, nil, nil, nil, template.Labels)
+				machineSet.Spec.WarmPool = &v1alpha1.MachineWarmPool{Size: warmPoolSize}
+				objects := []runtime.Object{machineSet}
+				for i := 0; i < 5; i++ {
+					machine := &v1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            fmt.Sprintf("machine-%d", i),
+							Namespace:       testNamespace,
+							Labels:          template.Labels,
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(machineSet, controllerKindMachineSet)},
+						},
+					}
+					if i > 0 {
+						machine.Annotations = map[string]string{machineutils.MachineStandby: "true"}
+					}
+					objects = append(objects, machine)
+				}
+				c, trackers := createController(stop, testNamespace, objects, nil, nil)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+
+				Expect(c.checkAndFreezeORUnfreezeMachineSets(context.TODO())).To(Succeed())
+
+				machineSet, err := c.controlMachineClient.MachineSets(testNamespace).Get(context.TODO(), machineSet.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(machineSet.Spec.Frozen).To(Equal(expectFrozen))
+			},
+			Entry("should not freeze a machine set with its warm pool filled", int32(2), false),
+			Entry("should freeze a machine set with too many machines of the warm pool", int32(0), true),
+		)
+	})
+
+	Describe("#unfreezeMachineDeployment", func() {
+		It("should remove the unfreeze annotation", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+			c, get := setup(stop, map[string]string{machineutils.UnfreezeAnnotation: "True"}, true)
+
+			machineDeployment, _ := get()
+			Expect(c.unfreezeMachineDeployment(context.TODO(), machineDeployment, "UnfreezeAnnotation")).To(Succeed())
+
+			machineDeployment, _ = get()
+			Expect(machineDeployment.Spec.Frozen).To(BeFalse())
+			Expect(machineDeployment.Annotations).ToNot(HaveKey(machineutils.UnfreezeAnnotation))
+			Expect(statusFieldManagers(c)).To(ConsistOf(MachineSafetyFieldManager))
+		})
+	})
+})
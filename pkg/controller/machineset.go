@@ -27,7 +27,6 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"sort"
 	"sync"
 	"time"
 
@@ -336,9 +335,13 @@ func (c *controller) manageReplicas(ctx context.Context, allMachines []*v1alpha1
 		return nil
 	}
 
-	var activeMachines, staleMachines []*v1alpha1.Machine
+	var activeMachines, standbyMachines, staleMachines []*v1alpha1.Machine
 	for _, machine := range allMachines {
-		if IsMachineActive(machine) {
+		if isAbandonedByClassFallback(machineSet, machine) {
+			staleMachines = append(staleMachines, machine)
+		} else if IsMachineActive(machine) && isStandbyMachine(machine) {
+			standbyMachines = append(standbyMachines, machine)
+		} else if IsMachineActive(machine) {
 			// klog.Info("Active machine: ", machine.Name)
 			activeMachines = append(activeMachines, machine)
 		} else if IsMachineFailed(machine) {
@@ -359,7 +362,7 @@ func (c *controller) manageReplicas(ctx context.Context, allMachines []*v1alpha1
 
 	if diff < 0 {
 		// If MachineSet is frozen and no deletion timestamp, don't process it
-		if machineSet.Labels["freeze"] == "True" && machineSet.DeletionTimestamp == nil {
+		if IsMachineSetFrozen(machineSet) && machineSet.DeletionTimestamp == nil {
 			klog.V(2).Infof("MachineSet %q is frozen, and hence not processing", machineSet.Name)
 			return nil
 		}
@@ -368,6 +371,23 @@ func (c *controller) manageReplicas(ctx context.Context, allMachines []*v1alpha1
 		if diff > BurstReplicas {
 			diff = BurstReplicas
 		}
+
+		// Machines of the warm pool are taken into service first, as starting their VMs is faster than creating new ones
+		promoted, remaining, err := c.promoteStandbyMachines(ctx, machineSet, standbyMachines, diff)
+		if err != nil {
+			return err
+		}
+		diff -= len(promoted)
+		// The warm pool is refilled in the background while the promoted machines start. It is refilled
+		// last, so its creations are added to the expectations of the creations of the replicas.
+		defer func() {
+			if err := c.manageWarmPool(ctx, machineSet, remaining); err != nil {
+				klog.Errorf("failed to manage warm pool for machineset %s: %v", machineSet.Name, err)
+			}
+		}()
+		if diff == 0 {
+			return nil
+		}
 		// TODO: Track UIDs of creates just like deletes. The problem currently
 		// is we'd need to wait on the result of a create to record the machine's
 		// UID, which would require locking *across* the create, which will turn
@@ -396,7 +416,7 @@ func (c *controller) manageReplicas(ctx context.Context, allMachines []*v1alpha1
 				BlockOwnerDeletion: boolPtr(true),
 				Controller:         boolPtr(true),
 			}
-			err := c.machineControl.CreateMachinesWithControllerRef(ctx, machineSet.Namespace, &machineSet.Spec.Template, machineSet, controllerRef)
+			err := c.machineControl.CreateMachinesWithControllerRef(ctx, machineSet.Namespace, machineCreationTemplate(machineSet), machineSet, controllerRef)
 			if err != nil && apierrors.IsTimeout(err) {
 				// Machine is created but its initialization has timed out.
 				// If the initialization is successful eventually, the
@@ -422,35 +442,85 @@ func (c *controller) manageReplicas(ctx context.Context, allMachines []*v1alpha1
 		}
 		return err
 	} else if diff > 0 {
+		deletableMachines := activeMachines
+		if !c.isInMaintenanceWindowForScaleDown(machineSet, diff) {
+			deletableMachines = filterMachinesNotServing(activeMachines)
+			if diff > len(deletableMachines) {
+				diff = len(deletableMachines)
+			}
+		}
 		if diff > BurstReplicas {
 			diff = BurstReplicas
 		}
-		klog.V(2).Infof("Too many replicas for %v %s/%s, need %d, deleting %d", machineSet.Kind, machineSet.Namespace, machineSet.Name, (machineSet.Spec.Replicas), diff)
+		if diff > 0 {
+			klog.V(2).Infof("Too many replicas for %v %s/%s, need %d, deleting %d", machineSet.Kind, machineSet.Namespace, machineSet.Name, (machineSet.Spec.Replicas), diff)
 
-		logMachinesWithPriority1(activeMachines)
-		machinesToDelete := getMachinesToDelete(activeMachines, diff)
-		logMachinesToDelete(machinesToDelete)
+			logMachinesWithPriority1(deletableMachines)
+			machinesToDelete := c.getMachinesToDelete(ctx, machineSet, deletableMachines, diff)
+			logMachinesToDelete(machinesToDelete)
 
-		// Snapshot the UIDs (ns/name) of the machines we're expecting to see
-		// deleted, so we know to record their expectations exactly once either
-		// when we see it as an update of the deletion timestamp, or as a delete.
-		// Note that if the labels on a machine/rs change in a way that the machine gets
-		// orphaned, the rs will only wake up after the expectations have
-		// expired even if other machines are deleted.
-		if err := c.expectations.ExpectDeletions(machineSetKey, getMachineKeys(machinesToDelete)); err != nil {
-			// TODO: proper error handling needs to happen here
-			klog.Errorf("failed expect deletions for machineset %s: %v", machineSet.Name, err)
-		}
+			// Snapshot the UIDs (ns/name) of the machines we're expecting to see
+			// deleted, so we know to record their expectations exactly once either
+			// when we see it as an update of the deletion timestamp, or as a delete.
+			// Note that if the labels on a machine/rs change in a way that the machine gets
+			// orphaned, the rs will only wake up after the expectations have
+			// expired even if other machines are deleted.
+			if err := c.expectations.ExpectDeletions(machineSetKey, getMachineKeys(machinesToDelete)); err != nil {
+				// TODO: proper error handling needs to happen here
+				klog.Errorf("failed expect deletions for machineset %s: %v", machineSet.Name, err)
+			}
 
-		if err := c.terminateMachines(ctx, machinesToDelete, machineSet); err != nil {
-			// TODO: proper error handling needs to happen here
-			klog.Errorf("failed to terminate machines for machineset %s: %v", machineSet.Name, err)
+			if err := c.terminateMachines(ctx, machinesToDelete, machineSet); err != nil {
+				// TODO: proper error handling needs to happen here
+				klog.Errorf("failed to terminate machines for machineset %s: %v", machineSet.Name, err)
+			}
 		}
 	}
 
+	if err := c.manageWarmPool(ctx, machineSet, standbyMachines); err != nil {
+		klog.Errorf("failed to manage warm pool for machineset %s: %v", machineSet.Name, err)
+	}
 	return nil
 }
 
+// isInMaintenanceWindowForScaleDown returns true if the machine set may delete the given number of active machines.
+// Deleting serving machines drains their nodes, so it only happens within the maintenance window of the
+// MachineDeployment controlling the machine set. Outside of it, only machines which don't serve workloads yet are
+// deleted, and the machine set is requeued for when the window opens.
+func (c *controller) isInMaintenanceWindowForScaleDown(machineSet *v1alpha1.MachineSet, diff int) bool {
+	controllerRef := metav1.GetControllerOf(machineSet)
+	if controllerRef == nil {
+		return true
+	}
+	d := c.resolveDeploymentControllerRef(machineSet.Namespace, controllerRef)
+	if d == nil {
+		return true
+	}
+	inWindow, untilOpen := IsInMaintenanceWindow(d, c.clock.Now())
+	if !inWindow {
+		klog.V(2).Infof("Too many replicas for %v %s/%s, but MachineDeployment %q is outside of its maintenance window, postponing deletion of running machines out of %d", machineSet.Kind, machineSet.Namespace, machineSet.Name, d.Name, diff)
+		if untilOpen > 0 {
+			c.enqueueMachineSetAfter(machineSet, untilOpen)
+		}
+	}
+	return inWindow
+}
+
+// filterMachinesNotServing returns the machines which don't serve workloads, e.g. because they are still Pending or in
+// CrashLoopBackOff. Deleting them doesn't disrupt any workload. Machines in Maintenance still serve workloads until
+// their nodes are drained.
+func filterMachinesNotServing(machines []*v1alpha1.Machine) []*v1alpha1.Machine {
+	var filtered []*v1alpha1.Machine
+	for _, machine := range machines {
+		switch machine.Status.CurrentStatus.Phase {
+		case v1alpha1.MachineRunning, v1alpha1.MachineAvailable, v1alpha1.MachineMaintenance:
+		default:
+			filtered = append(filtered, machine)
+		}
+	}
+	return filtered
+}
+
 // syncMachineSet will sync the MachineSet with the given key if it has had its expectations fulfilled,
 // meaning it did not expect to see any more of its machines created or deleted. This function is not meant to be
 // invoked concurrently with the same key.
@@ -541,11 +611,17 @@ func (c *controller) reconcileClusterMachineSet(key string) error {
 	// TODO: Fix working of expectations to reflect correct behaviour
 	// machineSetNeedsSync := c.expectations.SatisfiedExpectations(key)
 	var manageReplicasErr error
+	classFallback := machineSet.Status.ClassFallback
 
 	if machineSet.DeletionTimestamp == nil {
+		// syncClassFallback chooses the machine class new machines are created from
+		classFallback = c.syncClassFallback(machineSet, filteredMachines)
+		machineSetWithClassFallback := machineSet.DeepCopy()
+		machineSetWithClassFallback.Status.ClassFallback = classFallback
+
 		// manageReplicas is the core machineSet method where scale up/down occurs
 		// It is not called when deletion timestamp is set
-		manageReplicasErr = c.manageReplicas(ctx, filteredMachines, machineSet)
+		manageReplicasErr = c.manageReplicas(ctx, filteredMachines, machineSetWithClassFallback)
 
 	} else if machineSet.DeletionTimestamp != nil {
 		// When machineSet if triggered for deletion
@@ -568,6 +644,7 @@ func (c *controller) reconcileClusterMachineSet(key string) error {
 
 	machineSet = machineSet.DeepCopy()
 	newStatus := calculateMachineSetStatus(machineSet, filteredMachines, manageReplicasErr)
+	newStatus.ClassFallback = classFallback
 
 	// Always updates status as machines come up or die.
 	updatedMachineSet, err := updateMachineSetStatus(ctx, c.controlMachineClient, machineSet, newStatus)
@@ -642,18 +719,6 @@ func slowStartBatch(count int, initialBatchSize int, fn func() error) (int, erro
 	return successes, nil
 }
 
-func getMachinesToDelete(filteredMachines []*v1alpha1.Machine, diff int) []*v1alpha1.Machine {
-	// No need to sort machines if we are about to delete all of them.
-	// diff will always be <= len(filteredMachines), so not need to handle > case.
-	if diff < len(filteredMachines) {
-		// Sort the machines in the order such that not-ready < ready, unscheduled
-		// < scheduled, and pending < running. This ensures that we delete machines
-		// in the earlier stages whenever possible.
-		sort.Sort(ActiveMachines(filteredMachines))
-	}
-	return filteredMachines[:diff]
-}
-
 func getMachineKeys(machines []*v1alpha1.Machine) []string {
 	machineKeys := make([]string, 0, len(machines))
 	for _, machine := range machines {
@@ -812,7 +877,7 @@ func (c *controller) updateMachineStatus(
 		return machine, nil
 	}
 
-	clone, err = c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	clone, err = c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: MachineSetFieldManager})
 	if err != nil {
 		// Keep retrying until update goes through
 		klog.V(3).Infof("Warning: Updated failed, retrying, error: %q", err)
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ClassFallbackReason is the reason of the event emitted when new machines of a machine set are created
+	// from a fallback class.
+	ClassFallbackReason = "ClassFallback"
+	// ClassFallbackPreferredReason is the reason of the event emitted when new machines of a machine set are
+	// created from the class of the template again.
+	ClassFallbackPreferredReason = "ClassFallbackPreferred"
+
+	defaultCapacityErrorThreshold    = 1
+	defaultPreferredClassRetryPeriod = 30 * time.Minute
+)
+
+// syncClassFallback returns the class fallback status of the machine set, i.e. the machine class new machines
+// are created from. New machines are created from the next class once the threshold of machines failing to be
+// created for lack of capacity is reached, and from the class of the template again after the retry period.
+func (c *controller) syncClassFallback(machineSet *v1alpha1.MachineSet, machines []*v1alpha1.Machine) *v1alpha1.ClassFallbackStatus {
+	classFallback := machineSet.Spec.ClassFallback
+	if classFallback == nil {
+		return nil
+	}
+	classes := append([]v1alpha1.ClassSpec{machineSet.Spec.Template.Spec.Class}, classFallback.Classes...)
+	threshold := int32(defaultCapacityErrorThreshold)
+	if classFallback.CapacityErrorThreshold != nil {
+		threshold = *classFallback.CapacityErrorThreshold
+	}
+	retryPeriod := defaultPreferredClassRetryPeriod
+	if classFallback.PreferredClassRetryPeriod != nil {
+		retryPeriod = classFallback.PreferredClassRetryPeriod.Duration
+	}
+
+	current, status := 0, machineSet.Status.ClassFallback
+	if status != nil {
+		current = indexOfClass(classes, status.Class)
+	}
+	if status == nil || current < 0 {
+		current = 0
+		status = &v1alpha1.ClassFallbackStatus{Class: classes[0], LastTransitionTime: metav1.NewTime(c.clock.Now())}
+	} else {
+		status = status.DeepCopy()
+	}
+
+	capacityErrors := make(map[int]int32)
+	for _, machine := range machines {
+		if isCapacityErrorMachine(machine) {
+			if i := indexOfClass(classes, machine.Spec.Class); i >= 0 {
+				capacityErrors[i]++
+			}
+		}
+	}
+
+	next := current
+	for next < len(classes)-1 && capacityErrors[next] >= threshold {
+		next++
+	}
+	switch {
+	case next != current:
+		klog.V(2).Infof("Machine class %q of MachineSet %q has no capacity, creating new machines from machine class %q", classes[current].Name, machineSet.Name, classes[next].Name)
+		c.recorder.Eventf(machineSet, v1.EventTypeWarning, ClassFallbackReason, "Machine class %q has no capacity, creating new machines from machine class %q", classes[current].Name, classes[next].Name)
+		status = &v1alpha1.ClassFallbackStatus{Class: classes[next], LastTransitionTime: metav1.NewTime(c.clock.Now())}
+	case current > 0:
+		if retryAfter := status.LastTransitionTime.Add(retryPeriod).Sub(c.clock.Now()); retryAfter > 0 {
+			c.enqueueMachineSetAfter(machineSet, retryAfter)
+			break
+		}
+		klog.V(2).Infof("Retrying machine class %q of MachineSet %q for new machines", classes[0].Name, machineSet.Name)
+		c.recorder.Eventf(machineSet, v1.EventTypeNormal, ClassFallbackPreferredReason, "Creating new machines from machine class %q again", classes[0].Name)
+		status = &v1alpha1.ClassFallbackStatus{Class: classes[0], LastTransitionTime: metav1.NewTime(c.clock.Now())}
+	}
+	return status
+}
+
+// machineCreationTemplate returns the template new machines of the machine set are created from, which uses
+// the class of the class fallback status if set.
+func machineCreationTemplate(machineSet *v1alpha1.MachineSet) *v1alpha1.MachineTemplateSpec {
+	status := machineSet.Status.ClassFallback
+	if machineSet.Spec.ClassFallback == nil || status == nil || isSameClass(status.Class, machineSet.Spec.Template.Spec.Class) {
+		return &machineSet.Spec.Template
+	}
+	template := machineSet.Spec.Template.DeepCopy()
+	template.Spec.Class = status.Class
+	return template
+}
+
+// isAbandonedByClassFallback returns true if the machine failed to be created for lack of capacity from a
+// class other than the one new machines of the machine set are created from. Such machines never got a VM
+// and are replaced by machines of the current class instead of waiting for their creation timeout.
+func isAbandonedByClassFallback(machineSet *v1alpha1.MachineSet, machine *v1alpha1.Machine) bool {
+	status := machineSet.Status.ClassFallback
+	if machineSet.Spec.ClassFallback == nil || status == nil || !isCapacityErrorMachine(machine) {
+		return false
+	}
+	return !isSameClass(machine.Spec.Class, status.Class)
+}
+
+// isCapacityErrorMachine returns true if the creation of the machine failed because the provider has no capacity.
+func isCapacityErrorMachine(machine *v1alpha1.Machine) bool {
+	lastOperation := machine.Status.LastOperation
+	return machine.DeletionTimestamp == nil &&
+		IsMachineActive(machine) &&
+		lastOperation.Type == v1alpha1.MachineOperationCreate &&
+		lastOperation.State == v1alpha1.MachineStateFailed &&
+		lastOperation.ErrorCode == codes.ResourceExhausted.String()
+}
+
+func indexOfClass(classes []v1alpha1.ClassSpec, class v1alpha1.ClassSpec) int {
+	for i := range classes {
+		if isSameClass(classes[i], class) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isSameClass(a, b v1alpha1.ClassSpec) bool {
+	return a.Kind == b.Kind && a.Name == b.Name
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("machineset_class_fallback", func() {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	large := machinev1.ClassSpec{Kind: "MachineClass", Name: "large"}
+	medium := machinev1.ClassSpec{Kind: "MachineClass", Name: "medium"}
+	small := machinev1.ClassSpec{Kind: "MachineClass", Name: "small"}
+
+	newMachineSet := func(status *machinev1.ClassFallbackStatus) *machinev1.MachineSet {
+		labels := map[string]string{"test-label": "test-label"}
+		return &machinev1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "machineset", Namespace: testNamespace, UID: "machineset-uid", Labels: labels},
+			TypeMeta:   metav1.TypeMeta{Kind: "MachineSet", APIVersion: "machine.sapcloud.io/v1alpha1"},
+			Spec: machinev1.MachineSetSpec{
+				Replicas: 2,
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: machinev1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec:       machinev1.MachineSpec{Class: large},
+				},
+				ClassFallback: &machinev1.ClassFallback{
+					Classes:                []machinev1.ClassSpec{medium, small},
+					CapacityErrorThreshold: ptr.To[int32](2),
+				},
+			},
+			Status: machinev1.MachineSetStatus{ClassFallback: status},
+		}
+	}
+	newMachine := func(name string, class machinev1.ClassSpec, capacityError bool) *machinev1.Machine {
+		machine := &machinev1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Labels: map[string]string{"test-label": "test-label"}},
+			Spec:       machinev1.MachineSpec{Class: class},
+			Status: machinev1.MachineStatus{
+				CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineRunning},
+			},
+		}
+		if capacityError {
+			machine.Status.CurrentStatus.Phase = machinev1.MachineCrashLoopBackOff
+			machine.Status.LastOperation = machinev1.LastOperation{
+				Type:      machinev1.MachineOperationCreate,
+				State:     machinev1.MachineStateFailed,
+				ErrorCode: codes.ResourceExhausted.String(),
+			}
+		}
+		return machine
+	}
+
+	Describe("#syncClassFallback", func() {
+		DescribeTable("##table",
+			func(status *machinev1.ClassFallbackStatus, machines []*machinev1.Machine, expectedClass machinev1.ClassSpec, expectedTransition bool) {
+				stop := make(chan struct{})
+				defer close(stop)
+				machineSet := newMachineSet(status)
+				c, trackers := createController(stop, testNamespace, nil, nil, nil)
+				defer trackers.Stop()
+				c.clock = testingclock.NewFakePassiveClock(now)
+				c.recorder = record.NewFakeRecorder(10)
+
+				newStatus := c.syncClassFallback(machineSet, machines)
+				Expect(newStatus.Class).To(Equal(expectedClass))
+				if expectedTransition {
+					Expect(newStatus.LastTransitionTime.Time).To(Equal(now))
+				} else {
+					Expect(newStatus.LastTransitionTime).To(Equal(status.LastTransitionTime))
+				}
+			},
+			Entry("should start with the class of the template", nil, nil, large, true),
+			Entry("should keep the class below the threshold",
+				&machinev1.ClassFallbackStatus{Class: large, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+				[]*machinev1.Machine{newMachine("m1", large, true), newMachine("m2", large, false)},
+				large, false),
+			Entry("should fall back to the next class at the threshold",
+				&machinev1.ClassFallbackStatus{Class: large, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+				[]*machinev1.Machine{newMachine("m1", large, true), newMachine("m2", large, true)},
+				medium, true),
+			Entry("should skip fallback classes without capacity",
+				&machinev1.ClassFallbackStatus{Class: large, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+				[]*machinev1.Machine{newMachine("m1", large, true), newMachine("m2", large, true), newMachine("m3", medium, true), newMachine("m4", medium, true)},
+				small, true),
+			Entry("should keep the fallback class within the retry period",
+				&machinev1.ClassFallbackStatus{Class: medium, LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute))},
+				nil,
+				medium, false),
+			Entry("should retry the class of the template after the retry period",
+				&machinev1.ClassFallbackStatus{Class: medium, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+				nil,
+				large, true),
+			Entry("should reset to the class of the template if the class was removed from the fallback classes",
+				&machinev1.ClassFallbackStatus{Class: machinev1.ClassSpec{Kind: "MachineClass", Name: "removed"}},
+				nil,
+				large, true),
+		)
+	})
+
+	Describe("#manageReplicas with class fallback", func() {
+		It("should replace machines without capacity by machines of the fallback class", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machineSet := newMachineSet(&machinev1.ClassFallbackStatus{Class: medium})
+			running := newMachine("running", large, false)
+			abandoned := newMachine("abandoned", large, true)
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machineSet, running, abandoned}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			Expect(c.manageReplicas(context.TODO(), []*machinev1.Machine{running, abandoned}, machineSet)).To(Succeed())
+
+			machines, err := c.controlMachineClient.Machines(testNamespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			classes := map[string]string{}
+			for _, machine := range machines.Items {
+				classes[machine.Name] = machine.Spec.Class.Name
+			}
+			Expect(classes).To(HaveLen(2))
+			Expect(classes).To(HaveKeyWithValue("running", "large"))
+			Expect(classes).ToNot(HaveKey("abandoned"))
+			delete(classes, "running")
+			for _, class := range classes {
+				Expect(class).To(Equal("medium"))
+			}
+		})
+	})
+})
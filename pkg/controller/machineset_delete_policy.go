@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// getMachinesToDelete returns diff machines to delete on a scale-down of the machine set, chosen by
+// its delete policy. The priority annotation and the phase of the machines take precedence over the policy.
+func (c *controller) getMachinesToDelete(ctx context.Context, machineSet *v1alpha1.MachineSet, filteredMachines []*v1alpha1.Machine, diff int) []*v1alpha1.Machine {
+	// No need to sort machines if we are about to delete all of them.
+	// diff will always be <= len(filteredMachines), so not need to handle > case.
+	if diff >= len(filteredMachines) {
+		return filteredMachines[:diff]
+	}
+
+	switch machineSet.Spec.DeletePolicy {
+	case v1alpha1.NewestMachineSetDeletePolicy:
+		sortMachinesForDeletion(filteredMachines, func(a, b *v1alpha1.Machine) bool {
+			return b.CreationTimestamp.Before(&a.CreationTimestamp)
+		})
+	case v1alpha1.RandomMachineSetDeletePolicy:
+		// #nosec G404 -- no cryptographic randomness required to choose machines
+		rand.Shuffle(len(filteredMachines), func(i, j int) {
+			filteredMachines[i], filteredMachines[j] = filteredMachines[j], filteredMachines[i]
+		})
+		sortMachinesForDeletion(filteredMachines, nil)
+	case v1alpha1.ZoneBalancedMachineSetDeletePolicy:
+		return getZoneBalancedMachinesToDelete(filteredMachines, diff, c.getMachineZone)
+	case v1alpha1.LeastPodsMachineSetDeletePolicy:
+		podCounts := c.countMachinePods(ctx, filteredMachines)
+		sortMachinesForDeletion(filteredMachines, func(a, b *v1alpha1.Machine) bool {
+			if podCounts[a.Name] != podCounts[b.Name] {
+				return podCounts[a.Name] < podCounts[b.Name]
+			}
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		})
+	default:
+		// Sort the machines in the order such that not-ready < ready, unscheduled
+		// < scheduled, and pending < running. This ensures that we delete machines
+		// in the earlier stages whenever possible.
+		sort.Sort(ActiveMachines(filteredMachines))
+	}
+	return filteredMachines[:diff]
+}
+
+// sortMachinesForDeletion sorts the machines by their priority annotation and phase like ActiveMachines,
+// and machines of the same priority and phase by less. The sort is stable if less is nil.
+func sortMachinesForDeletion(machines []*v1alpha1.Machine, less func(a, b *v1alpha1.Machine) bool) {
+	sort.SliceStable(machines, func(i, j int) bool {
+		return lessForDeletion(machines[i], machines[j], less)
+	})
+}
+
+func lessForDeletion(a, b *v1alpha1.Machine, less func(a, b *v1alpha1.Machine) bool) bool {
+	if aPriority, bPriority := machineDeletionPriority(a), machineDeletionPriority(b); aPriority != bPriority {
+		return aPriority < bPriority
+	}
+	if aPhase, bPhase := machinePhaseDeletionPriority[a.Status.CurrentStatus.Phase], machinePhaseDeletionPriority[b.Status.CurrentStatus.Phase]; aPhase != bPhase {
+		return aPhase < bPhase
+	}
+	return less != nil && less(a, b)
+}
+
+// getZoneBalancedMachinesToDelete picks the machines to delete one by one, each time from the zone
+// which has the most remaining machines. Machines without a known zone count as one zone.
+func getZoneBalancedMachinesToDelete(machines []*v1alpha1.Machine, diff int, getZone func(*v1alpha1.Machine) string) []*v1alpha1.Machine {
+	zones := make(map[string]string, len(machines))
+	zoneCounts := make(map[string]int)
+	for _, machine := range machines {
+		zone := getZone(machine)
+		zones[machine.Name] = zone
+		zoneCounts[zone]++
+	}
+	lessZoneBalanced := func(a, b *v1alpha1.Machine) bool {
+		if aCount, bCount := zoneCounts[zones[a.Name]], zoneCounts[zones[b.Name]]; aCount != bCount {
+			return aCount > bCount
+		}
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+
+	remaining := append([]*v1alpha1.Machine(nil), machines...)
+	machinesToDelete := make([]*v1alpha1.Machine, 0, diff)
+	for len(machinesToDelete) < diff {
+		next := 0
+		for i := 1; i < len(remaining); i++ {
+			if lessForDeletion(remaining[i], remaining[next], lessZoneBalanced) {
+				next = i
+			}
+		}
+		machinesToDelete = append(machinesToDelete, remaining[next])
+		zoneCounts[zones[remaining[next].Name]]--
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return machinesToDelete
+}
+
+// getMachineZone returns the zone of the node backing the machine, or an empty string if it is not known.
+func (c *controller) getMachineZone(machine *v1alpha1.Machine) string {
+	nodeName := machine.Labels[v1alpha1.NodeLabelKey]
+	if nodeName == "" {
+		return ""
+	}
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		klog.V(4).Infof("Unable to get node %q of machine %q to determine its zone: %v", nodeName, machine.Name, err)
+		return ""
+	}
+	if zone, ok := node.Labels[corev1.LabelTopologyZone]; ok {
+		return zone
+	}
+	return node.Labels[corev1.LabelFailureDomainBetaZone]
+}
+
+// countMachinePods returns the number of non-DaemonSet pods running on the nodes of the machines by machine name.
+// The pods are listed once for all machines and served from the cache of the API server. If they cannot be
+// listed, all machines are counted as running the most pods.
+func (c *controller) countMachinePods(ctx context.Context, machines []*v1alpha1.Machine) map[string]int {
+	podCounts := make(map[string]int, len(machines))
+	nodeMachines := make(map[string]string, len(machines))
+	for _, machine := range machines {
+		if nodeName := machine.Labels[v1alpha1.NodeLabelKey]; nodeName != "" {
+			nodeMachines[nodeName] = machine.Name
+		}
+	}
+	if len(nodeMachines) == 0 {
+		return podCounts
+	}
+
+	pods, err := c.targetCoreClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		klog.Warningf("Unable to list pods to count the pods of the machines: %v", err)
+		for _, machineName := range nodeMachines {
+			podCounts[machineName] = math.MaxInt32
+		}
+		return podCounts
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if machineName, ok := nodeMachines[pod.Spec.NodeName]; ok && isDeletionRelevantPod(pod) {
+			podCounts[machineName]++
+		}
+	}
+	return podCounts
+}
+
+// isDeletionRelevantPod returns false for pods which are not affected by the deletion of their node,
+// i.e. DaemonSet pods, mirror pods and terminated pods.
+func isDeletionRelevantPod(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
@@ -7,15 +7,21 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	testingclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 
 	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	customfake "github.com/gardener/machine-controller-manager/pkg/fakeclient"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
 	k8sError "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -879,6 +885,90 @@ var _ = Describe("machineset", func() {
 			Expect(Err).Should(BeNil())
 		})
 
+		// TestCase: ActiveMachines > DesiredMachines outside of the maintenance window
+		// Testcase: It should not return error and should not delete extra running machine.
+		It("should not return error and should not delete extra running machine outside of the maintenance window.", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			testMachineDeployment := &machinev1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "MachineDeployment-test",
+					Namespace: testNamespace,
+					UID:       "1234566",
+				},
+				Spec: machinev1.MachineDeploymentSpec{
+					MaintenanceWindow: &machinev1.MachineDeploymentMaintenanceWindow{
+						// Opens at midnight on the 1st of January, closes a minute later
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}},
+					},
+				},
+			}
+			testMachineSet.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(testMachineDeployment, controllerKind),
+			}
+			testMachineSet.Spec.Replicas = 1
+
+			objects := []runtime.Object{}
+			objects = append(objects, testMachineDeployment, testMachineSet, testActiveMachine1, testActiveMachine2)
+			c, trackers := createController(stop, testNamespace, objects, nil, nil)
+			defer trackers.Stop()
+			c.clock = testingclock.NewFakePassiveClock(time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC))
+			waitForCacheSync(stop, c)
+
+			activeMachines := []*machinev1.Machine{testActiveMachine1, testActiveMachine2}
+			Err := c.manageReplicas(context.Background(), activeMachines, testMachineSet)
+			waitForCacheSync(stop, c)
+			machines, _ := c.controlMachineClient.Machines(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(len(machines.Items)).To(Equal(len(activeMachines)))
+			Expect(Err).Should(BeNil())
+		})
+
+		// TestCase: ActiveMachines > DesiredMachines outside of the maintenance window with a pending machine
+		// Testcase: It should not return error and should delete the pending machine only.
+		It("should not return error and should delete extra pending machine outside of the maintenance window.", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			testMachineDeployment := &machinev1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "MachineDeployment-test",
+					Namespace: testNamespace,
+					UID:       "1234566",
+				},
+				Spec: machinev1.MachineDeploymentSpec{
+					MaintenanceWindow: &machinev1.MachineDeploymentMaintenanceWindow{
+						// Opens at midnight on the 1st of January, closes a minute later
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}},
+					},
+				},
+			}
+			testMachineSet.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(testMachineDeployment, controllerKind),
+			}
+			testMachineSet.Spec.Replicas = 1
+			testActiveMachine2.Status.CurrentStatus.Phase = machinev1.MachinePending
+			testActiveMachine3 := testActiveMachine1.DeepCopy()
+			testActiveMachine3.Name, testActiveMachine3.UID = "machine-3", "1234570"
+
+			objects := []runtime.Object{}
+			objects = append(objects, testMachineDeployment, testMachineSet, testActiveMachine1, testActiveMachine2, testActiveMachine3)
+			c, trackers := createController(stop, testNamespace, objects, nil, nil)
+			defer trackers.Stop()
+			c.clock = testingclock.NewFakePassiveClock(time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC))
+			waitForCacheSync(stop, c)
+
+			activeMachines := []*machinev1.Machine{testActiveMachine1, testActiveMachine2, testActiveMachine3}
+			Err := c.manageReplicas(context.Background(), activeMachines, testMachineSet)
+			waitForCacheSync(stop, c)
+			machines, _ := c.controlMachineClient.Machines(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(machines.Items).To(HaveLen(2))
+			for _, machine := range machines.Items {
+				Expect(machine.Status.CurrentStatus.Phase).To(Equal(machinev1.MachineRunning))
+			}
+			Expect(Err).Should(BeNil())
+		})
+
 	})
 
 	// TODO: This method has dependency on generic-machineclass. Implement later.
@@ -1215,13 +1305,115 @@ var _ = Describe("machineset", func() {
 			defer close(stop)
 			diff = 1
 			filteredMachines := []*machinev1.Machine{testActiveMachine1, testFailedMachine1}
-			machinesToDelete := getMachinesToDelete(filteredMachines, diff)
+			c := &controller{}
+			machinesToDelete := c.getMachinesToDelete(context.TODO(), &machinev1.MachineSet{}, filteredMachines, diff)
 
 			Expect(len(machinesToDelete)).To(Equal(len(filteredMachines) - diff))
 			Expect(machinesToDelete[0].Name).To(Equal(testFailedMachine1.Name))
 		})
 	})
 
+	Describe("#getMachinesToDelete with delete policies", func() {
+		created := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		// machines are created an hour apart in this order, each with its own node
+		machineSetup := []struct {
+			name string
+			zone string
+			pods int
+		}{
+			{"machine-1", "zone-a", 3},
+			{"machine-2", "zone-b", 2},
+			{"machine-3", "zone-b", 0},
+			{"machine-4", "zone-c", 1},
+		}
+
+		DescribeTable("##table",
+			func(deletePolicy machinev1.MachineSetDeletePolicy, priorities map[string]string, failed string, expected []string) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				var (
+					machines          []*machinev1.Machine
+					targetCoreObjects []runtime.Object
+				)
+				for i, m := range machineSetup {
+					nodeName := "node-" + m.name
+					machine := &machinev1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              m.name,
+							Namespace:         testNamespace,
+							CreationTimestamp: metav1.NewTime(created.Add(time.Duration(i) * time.Hour)),
+							Labels:            map[string]string{machinev1.NodeLabelKey: nodeName},
+						},
+						Status: machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: MachineRunning}},
+					}
+					if priority, ok := priorities[m.name]; ok {
+						machine.Annotations = map[string]string{machineutils.MachinePriority: priority}
+					}
+					if m.name == failed {
+						machine.Status.CurrentStatus.Phase = MachineFailed
+					}
+					machines = append(machines, machine)
+
+					targetCoreObjects = append(targetCoreObjects, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: map[string]string{corev1.LabelTopologyZone: m.zone}},
+					})
+					for j := 0; j < m.pods; j++ {
+						targetCoreObjects = append(targetCoreObjects, &corev1.Pod{
+							ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-pod-%d", nodeName, j), Namespace: "default"},
+							Spec:       corev1.PodSpec{NodeName: nodeName},
+						})
+					}
+					// pods which are not affected by the deletion of the node
+					targetCoreObjects = append(targetCoreObjects,
+						&corev1.Pod{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:            nodeName + "-daemonset-pod",
+								Namespace:       "kube-system",
+								OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: ptr.To(true)}},
+							},
+							Spec: corev1.PodSpec{NodeName: nodeName},
+						},
+						&corev1.Pod{
+							ObjectMeta: metav1.ObjectMeta{Name: nodeName + "-completed-pod", Namespace: "default"},
+							Spec:       corev1.PodSpec{NodeName: nodeName},
+							Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+						},
+					)
+				}
+
+				c, trackers := createController(stop, testNamespace, nil, nil, targetCoreObjects)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+
+				machineSet := &machinev1.MachineSet{Spec: machinev1.MachineSetSpec{DeletePolicy: deletePolicy}}
+				machinesToDelete := c.getMachinesToDelete(context.TODO(), machineSet, machines, len(expected))
+				names := make([]string, 0, len(machinesToDelete))
+				for _, machine := range machinesToDelete {
+					names = append(names, machine.Name)
+				}
+				Expect(names).To(Equal(expected))
+
+				// the pods of all machines are listed at most once
+				podLists := 0
+				for _, action := range c.targetCoreClient.(*customfake.Clientset).Actions() {
+					if action.Matches("list", "pods") {
+						podLists++
+					}
+				}
+				Expect(podLists).To(BeNumerically("<=", 1))
+			},
+			Entry("default deletes the oldest machines", machinev1.MachineSetDeletePolicy(""), nil, "", []string{"machine-1", "machine-2"}),
+			Entry("Oldest deletes the oldest machines", machinev1.OldestMachineSetDeletePolicy, nil, "", []string{"machine-1", "machine-2"}),
+			Entry("Newest deletes the newest machines", machinev1.NewestMachineSetDeletePolicy, nil, "", []string{"machine-4", "machine-3"}),
+			Entry("ZoneBalanced deletes from the zone with the most machines", machinev1.ZoneBalancedMachineSetDeletePolicy, nil, "", []string{"machine-2", "machine-1"}),
+			Entry("LeastPods deletes the machines running the fewest pods", machinev1.LeastPodsMachineSetDeletePolicy, nil, "", []string{"machine-3", "machine-4"}),
+			Entry("priority overrides the delete policy", machinev1.ZoneBalancedMachineSetDeletePolicy, map[string]string{"machine-4": "1"}, "", []string{"machine-4", "machine-2"}),
+			Entry("failed machines are deleted before the delete policy applies", machinev1.NewestMachineSetDeletePolicy, nil, "machine-1", []string{"machine-1", "machine-4"}),
+			Entry("Random respects priority and phase", machinev1.RandomMachineSetDeletePolicy, map[string]string{"machine-4": "1"}, "machine-1", []string{"machine-4", "machine-1"}),
+		)
+	})
+
 	Describe("#getMachineKeys", func() {
 		var (
 			testMachine1 *machinev1.Machine
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// isStandbyMachine returns true if the machine is part of the warm pool of its machine set.
+func isStandbyMachine(machine *v1alpha1.Machine) bool {
+	return machine.Annotations[machineutils.MachineStandby] == "true"
+}
+
+// warmPoolSize returns the number of machines to be kept in the warm pool of the machine set.
+func warmPoolSize(machineSet *v1alpha1.MachineSet) int {
+	if machineSet.Spec.WarmPool == nil {
+		return 0
+	}
+	return int(machineSet.Spec.WarmPool.Size)
+}
+
+// standbyMachineRank orders machines of the warm pool by how fast they are taken into service. Machines still
+// running come first, followed by machines whose VM is stopped and machines which are still being created.
+func standbyMachineRank(machine *v1alpha1.Machine) int {
+	switch machine.Status.CurrentStatus.Phase {
+	case v1alpha1.MachineRunning:
+		return 0
+	case v1alpha1.MachineStandby:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// sortStandbyMachines sorts the machines of the warm pool such that the ones taken into service fastest come first.
+func sortStandbyMachines(machines []*v1alpha1.Machine) {
+	sort.SliceStable(machines, func(i, j int) bool {
+		if ri, rj := standbyMachineRank(machines[i]), standbyMachineRank(machines[j]); ri != rj {
+			return ri < rj
+		}
+		return machines[i].CreationTimestamp.Before(&machines[j].CreationTimestamp)
+	})
+}
+
+// promoteStandbyMachines takes up to count machines of the warm pool into service by removing their standby
+// annotation, upon which the machine controller starts their VMs. It returns the promoted and the remaining
+// machines of the warm pool.
+func (c *controller) promoteStandbyMachines(ctx context.Context, machineSet *v1alpha1.MachineSet, standbyMachines []*v1alpha1.Machine, count int) ([]*v1alpha1.Machine, []*v1alpha1.Machine, error) {
+	if count <= 0 || len(standbyMachines) == 0 {
+		return nil, standbyMachines, nil
+	}
+	sortStandbyMachines(standbyMachines)
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{machineutils.MachineStandby: nil},
+		},
+	})
+	if err != nil {
+		return nil, standbyMachines, err
+	}
+
+	var promoted []*v1alpha1.Machine
+	for i, machine := range standbyMachines {
+		if len(promoted) == count {
+			return promoted, standbyMachines[i:], nil
+		}
+		if err := c.machineControl.PatchMachine(ctx, machine.Namespace, machine.Name, patch); err != nil {
+			return promoted, standbyMachines[i:], fmt.Errorf("failed to take machine %q of warm pool into service: %v", machine.Name, err)
+		}
+		klog.V(2).Infof("Taking machine %q of warm pool of MachineSet %q into service", machine.Name, machineSet.Name)
+		promoted = append(promoted, machine)
+	}
+	return promoted, nil, nil
+}
+
+// manageWarmPool creates or deletes machines of the warm pool of the machine set to match the size of the
+// warm pool. New machines of the warm pool are annotated for standby, so the machine controller stops
+// their VMs once their nodes have joined the cluster.
+func (c *controller) manageWarmPool(ctx context.Context, machineSet *v1alpha1.MachineSet, standbyMachines []*v1alpha1.Machine) error {
+	// If MachineSet is frozen and no deletion timestamp, don't process its warm pool
+	if IsMachineSetFrozen(machineSet) && machineSet.DeletionTimestamp == nil {
+		klog.V(2).Infof("MachineSet %q is frozen, and hence not processing its warm pool", machineSet.Name)
+		return nil
+	}
+
+	machineSetKey, err := KeyFunc(machineSet)
+	if err != nil {
+		return err
+	}
+
+	diff := len(standbyMachines) - warmPoolSize(machineSet)
+	if diff > BurstReplicas {
+		diff = BurstReplicas
+	} else if diff < -BurstReplicas {
+		diff = -BurstReplicas
+	}
+
+	if diff > 0 {
+		klog.V(2).Infof("Too many machines in warm pool of MachineSet %s/%s, need %d, deleting %d", machineSet.Namespace, machineSet.Name, warmPoolSize(machineSet), diff)
+		sortStandbyMachines(standbyMachines)
+		return c.terminateMachines(ctx, standbyMachines[len(standbyMachines)-diff:], machineSet)
+	}
+
+	if diff < 0 {
+		klog.V(2).Infof("Too few machines in warm pool of MachineSet %s/%s, need %d, creating %d", machineSet.Namespace, machineSet.Name, warmPoolSize(machineSet), -diff)
+		template := machineCreationTemplate(machineSet).DeepCopy()
+		if template.Annotations == nil {
+			template.Annotations = make(map[string]string)
+		}
+		template.Annotations[machineutils.MachineStandby] = "true"
+
+		boolPtr := func(b bool) *bool { return &b }
+		controllerRef := &metav1.OwnerReference{
+			APIVersion:         controllerKindMachineSet.GroupVersion().String(),
+			Kind:               controllerKindMachineSet.Kind,
+			Name:               machineSet.Name,
+			UID:                machineSet.UID,
+			BlockOwnerDeletion: boolPtr(true),
+			Controller:         boolPtr(true),
+		}
+		diff *= -1
+		// Expectations may already have been set for the replicas of the machine set in the same sync
+		if exp, exists, err := c.expectations.GetExpectations(machineSetKey); err == nil && exists && !exp.Fulfilled() && !exp.isExpired() {
+			c.expectations.RaiseExpectations(machineSetKey, diff, 0)
+		} else if err := c.expectations.ExpectCreations(machineSetKey, diff); err != nil {
+			klog.Errorf("failed expect creations for warm pool of machineset %s: %v", machineSet.Name, err)
+		}
+		successfulCreations, err := slowStartBatch(diff, SlowStartInitialBatchSize, func() error {
+			return c.machineControl.CreateMachinesWithControllerRef(ctx, machineSet.Namespace, template, machineSet, controllerRef)
+		})
+
+		// Any skipped machines that we never attempted to start shouldn't be expected
+		for i := successfulCreations; i < diff; i++ {
+			c.expectations.CreationObserved(machineSetKey)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// clearWarmPoolOfOldMachineSets removes the warm pool from the given old machine sets of a machine deployment,
+// upon which the machine set controller deletes their machines of the warm pool. It returns the updated machine sets.
+func (dc *controller) clearWarmPoolOfOldMachineSets(ctx context.Context, oldISs []*v1alpha1.MachineSet) ([]*v1alpha1.MachineSet, error) {
+	updatedISs := make([]*v1alpha1.MachineSet, 0, len(oldISs))
+	for _, is := range oldISs {
+		if is.Spec.WarmPool == nil {
+			updatedISs = append(updatedISs, is)
+			continue
+		}
+		isCopy := is.DeepCopy()
+		isCopy.Spec.WarmPool = nil
+		updatedIS, err := dc.controlMachineClient.MachineSets(isCopy.Namespace).Update(ctx, isCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove warm pool of old MachineSet %q: %v", is.Name, err)
+		}
+		klog.V(2).Infof("Removed warm pool of old MachineSet %q", is.Name)
+		updatedISs = append(updatedISs, updatedIS)
+	}
+	return updatedISs, nil
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("machineset_warm_pool", func() {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	labels := map[string]string{"test-label": "test-label"}
+
+	newMachineSet := func(replicas, warmPoolSize int32) *machinev1.MachineSet {
+		return &machinev1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "machineset", Namespace: testNamespace, UID: "machineset-uid", Labels: labels},
+			TypeMeta:   metav1.TypeMeta{Kind: "MachineSet", APIVersion: "machine.sapcloud.io/v1alpha1"},
+			Spec: machinev1.MachineSetSpec{
+				Replicas: replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: machinev1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec:       machinev1.MachineSpec{Class: machinev1.ClassSpec{Kind: "MachineClass", Name: "class"}},
+				},
+				WarmPool: &machinev1.MachineWarmPool{Size: warmPoolSize},
+			},
+		}
+	}
+	newMachine := func(name string, phase machinev1.MachinePhase, standby bool, age time.Duration) *machinev1.Machine {
+		machine := &machinev1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				Labels:            labels,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: phase}},
+		}
+		if standby {
+			machine.Annotations = map[string]string{machineutils.MachineStandby: "true"}
+		}
+		return machine
+	}
+	listMachines := func(c *controller) (active, standby []string) {
+		machines, err := c.controlMachineClient.Machines(testNamespace).List(context.TODO(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, machine := range machines.Items {
+			if isStandbyMachine(&machine) {
+				standby = append(standby, machine.Name)
+			} else {
+				active = append(active, machine.Name)
+			}
+		}
+		return active, standby
+	}
+
+	Describe("#sortStandbyMachines", func() {
+		It("should sort running machines before stopped machines before machines being created", func() {
+			machines := []*machinev1.Machine{
+				newMachine("pending", machinev1.MachinePending, true, time.Hour),
+				newMachine("standby-new", machinev1.MachineStandby, true, time.Minute),
+				newMachine("running", machinev1.MachineRunning, true, time.Minute),
+				newMachine("standby-old", machinev1.MachineStandby, true, time.Hour),
+			}
+			sortStandbyMachines(machines)
+			Expect(getMachineKeys(machines)).To(Equal([]string{"running", "standby-old", "standby-new", "pending"}))
+		})
+	})
+
+	Describe("#manageReplicas with warm pool", func() {
+		DescribeTable("##table",
+			func(machineSet *machinev1.MachineSet, machines []*machinev1.Machine, expectedActive, expectedStandby int, expectedPromoted []string) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				objects := []runtime.Object{machineSet}
+				for _, machine := range machines {
+					objects = append(objects, machine)
+				}
+				c, trackers := createController(stop, testNamespace, objects, nil, nil)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+
+				Expect(c.manageReplicas(context.TODO(), machines, machineSet)).To(Succeed())
+
+				active, standby := listMachines(c)
+				Expect(active).To(HaveLen(expectedActive))
+				Expect(standby).To(HaveLen(expectedStandby))
+				for _, name := range expectedPromoted {
+					Expect(active).To(ContainElement(name))
+				}
+			},
+			Entry("should fill the warm pool", newMachineSet(1, 2),
+				[]*machinev1.Machine{newMachine("running", machinev1.MachineRunning, false, time.Hour)},
+				1, 2, nil),
+			Entry("should take stopped machines into service on a scale-up and refill the warm pool", newMachineSet(2, 2),
+				[]*machinev1.Machine{
+					newMachine("running", machinev1.MachineRunning, false, time.Hour),
+					newMachine("standby", machinev1.MachineStandby, true, time.Hour),
+					newMachine("pending", machinev1.MachinePending, true, time.Hour),
+				},
+				2, 2, []string{"standby"}),
+			Entry("should create new machines if the warm pool is exhausted", newMachineSet(3, 0),
+				[]*machinev1.Machine{
+					newMachine("running", machinev1.MachineRunning, false, time.Hour),
+					newMachine("standby", machinev1.MachineStandby, true, time.Hour),
+				},
+				3, 0, []string{"standby"}),
+			Entry("should delete excess machines of the warm pool", newMachineSet(1, 1),
+				[]*machinev1.Machine{
+					newMachine("running", machinev1.MachineRunning, false, time.Hour),
+					newMachine("standby", machinev1.MachineStandby, true, time.Hour),
+					newMachine("pending", machinev1.MachinePending, true, time.Hour),
+				},
+				1, 1, nil),
+		)
+
+		It("should expect the creations of the replicas and of the warm pool", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machineSet := newMachineSet(3, 1)
+			machines := []*machinev1.Machine{newMachine("running", machinev1.MachineRunning, false, time.Hour)}
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machineSet, machines[0]}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			Expect(c.manageReplicas(context.TODO(), machines, machineSet)).To(Succeed())
+
+			machineSetKey, err := KeyFunc(machineSet)
+			Expect(err).ToNot(HaveOccurred())
+			exp, exists, err := c.expectations.GetExpectations(machineSetKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			add, _ := exp.GetExpectations()
+			Expect(add).To(Equal(int64(3)))
+		})
+
+		It("should not fill the warm pool of a frozen machine set", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machineSet := newMachineSet(1, 2)
+			machineSet.Spec.Frozen = true
+			machines := []*machinev1.Machine{newMachine("running", machinev1.MachineRunning, false, time.Hour)}
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machineSet, machines[0]}, nil, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			Expect(c.manageReplicas(context.TODO(), machines, machineSet)).To(Succeed())
+
+			active, standby := listMachines(c)
+			Expect(active).To(ConsistOf("running"))
+			Expect(standby).To(BeEmpty())
+		})
+
+		It("should fill the warm pool while the scale-down is postponed to the maintenance window", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			d := &machinev1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: testNamespace, UID: "deployment-uid"},
+				Spec: machinev1.MachineDeploymentSpec{
+					MaintenanceWindow: &machinev1.MachineDeploymentMaintenanceWindow{
+						Windows: []machinev1.MaintenanceWindow{{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}},
+					},
+				},
+			}
+			machineSet := newMachineSet(1, 1)
+			machineSet.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(d, controllerKind)}
+			machines := []*machinev1.Machine{
+				newMachine("running-1", machinev1.MachineRunning, false, time.Hour),
+				newMachine("running-2", machinev1.MachineRunning, false, time.Hour),
+			}
+
+			c, trackers := createController(stop, testNamespace, []runtime.Object{d, machineSet, machines[0], machines[1]}, nil, nil)
+			defer trackers.Stop()
+			c.clock = testingclock.NewFakePassiveClock(now)
+			waitForCacheSync(stop, c)
+
+			Expect(c.manageReplicas(context.TODO(), machines, machineSet)).To(Succeed())
+
+			active, standby := listMachines(c)
+			Expect(active).To(ConsistOf("running-1", "running-2"))
+			Expect(standby).To(HaveLen(1))
+		})
+	})
+
+	Describe("#calculateMachineSetStatus with warm pool", func() {
+		It("should count machines of the warm pool as standby replicas only", func() {
+			machineSet := newMachineSet(1, 2)
+			machines := []*machinev1.Machine{
+				newMachine("running", machinev1.MachineRunning, false, time.Hour),
+				newMachine("standby", machinev1.MachineStandby, true, time.Hour),
+				newMachine("pending", machinev1.MachinePending, true, time.Hour),
+			}
+
+			status := calculateMachineSetStatus(machineSet, machines, nil)
+			Expect(status.Replicas).To(Equal(int32(1)))
+			Expect(status.FullyLabeledReplicas).To(Equal(int32(1)))
+			Expect(status.StandbyReplicas).To(Equal(int32(2)))
+		})
+	})
+})
@@ -13,40 +13,111 @@ import (
 	v1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	common "k8s.io/kube-openapi/pkg/common"
 	spec "k8s.io/kube-openapi/pkg/validation/spec"
 )
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec":                      schema_pkg_apis_machine_v1alpha1_ClassSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus":                  schema_pkg_apis_machine_v1alpha1_CurrentStatus(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation":                  schema_pkg_apis_machine_v1alpha1_LastOperation(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine":                        schema_pkg_apis_machine_v1alpha1_Machine(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass":                   schema_pkg_apis_machine_v1alpha1_MachineClass(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassList":               schema_pkg_apis_machine_v1alpha1_MachineClassList(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineConfiguration":           schema_pkg_apis_machine_v1alpha1_MachineConfiguration(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment":              schema_pkg_apis_machine_v1alpha1_MachineDeployment(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition":     schema_pkg_apis_machine_v1alpha1_MachineDeploymentCondition(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentList":          schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec":          schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus":        schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy":      schema_pkg_apis_machine_v1alpha1_MachineDeploymentStrategy(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineList":                    schema_pkg_apis_machine_v1alpha1_MachineList(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet":                     schema_pkg_apis_machine_v1alpha1_MachineSet(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition":            schema_pkg_apis_machine_v1alpha1_MachineSetCondition(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetList":                 schema_pkg_apis_machine_v1alpha1_MachineSetList(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec":                 schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus":               schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec":                    schema_pkg_apis_machine_v1alpha1_MachineSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineStatus":                  schema_pkg_apis_machine_v1alpha1_MachineStatus(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary":                 schema_pkg_apis_machine_v1alpha1_MachineSummary(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec":            schema_pkg_apis_machine_v1alpha1_MachineTemplateSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplate":                   schema_pkg_apis_machine_v1alpha1_NodeTemplate(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec":               schema_pkg_apis_machine_v1alpha1_NodeTemplateSpec(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig":                 schema_pkg_apis_machine_v1alpha1_RollbackConfig(ref),
-		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment": schema_pkg_apis_machine_v1alpha1_RollingUpdateMachineDeployment(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                     schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.AutoRollbackPolicy":                 schema_pkg_apis_machine_v1alpha1_AutoRollbackPolicy(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenMachineDeployment":         schema_pkg_apis_machine_v1alpha1_BlueGreenMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenStatus":                    schema_pkg_apis_machine_v1alpha1_BlueGreenStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryMachineDeployment":            schema_pkg_apis_machine_v1alpha1_CanaryMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryPause":                        schema_pkg_apis_machine_v1alpha1_CanaryPause(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStatus":                       schema_pkg_apis_machine_v1alpha1_CanaryStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStep":                         schema_pkg_apis_machine_v1alpha1_CanaryStep(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallback":                      schema_pkg_apis_machine_v1alpha1_ClassFallback(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallbackStatus":                schema_pkg_apis_machine_v1alpha1_ClassFallbackStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec":                          schema_pkg_apis_machine_v1alpha1_ClassSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus":                      schema_pkg_apis_machine_v1alpha1_CurrentStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation":                      schema_pkg_apis_machine_v1alpha1_LastOperation(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine":                            schema_pkg_apis_machine_v1alpha1_Machine(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass":                       schema_pkg_apis_machine_v1alpha1_MachineClass(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassList":                   schema_pkg_apis_machine_v1alpha1_MachineClassList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassStatus":                 schema_pkg_apis_machine_v1alpha1_MachineClassStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassValidation":             schema_pkg_apis_machine_v1alpha1_MachineClassValidation(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineConfiguration":               schema_pkg_apis_machine_v1alpha1_MachineConfiguration(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment":                  schema_pkg_apis_machine_v1alpha1_MachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition":         schema_pkg_apis_machine_v1alpha1_MachineDeploymentCondition(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentList":              schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentMaintenanceWindow": schema_pkg_apis_machine_v1alpha1_MachineDeploymentMaintenanceWindow(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentScheduledScaling":  schema_pkg_apis_machine_v1alpha1_MachineDeploymentScheduledScaling(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec":              schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus":            schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy":          schema_pkg_apis_machine_v1alpha1_MachineDeploymentStrategy(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZone":              schema_pkg_apis_machine_v1alpha1_MachineDeploymentZone(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneSpread":        schema_pkg_apis_machine_v1alpha1_MachineDeploymentZoneSpread(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneStatus":        schema_pkg_apis_machine_v1alpha1_MachineDeploymentZoneStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineList":                        schema_pkg_apis_machine_v1alpha1_MachineList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet":                         schema_pkg_apis_machine_v1alpha1_MachineSet(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition":                schema_pkg_apis_machine_v1alpha1_MachineSetCondition(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetList":                     schema_pkg_apis_machine_v1alpha1_MachineSetList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec":                     schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus":                   schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec":                        schema_pkg_apis_machine_v1alpha1_MachineSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineStatus":                      schema_pkg_apis_machine_v1alpha1_MachineStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary":                     schema_pkg_apis_machine_v1alpha1_MachineSummary(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec":                schema_pkg_apis_machine_v1alpha1_MachineTemplateSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineWarmPool":                    schema_pkg_apis_machine_v1alpha1_MachineWarmPool(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MaintenanceWindow":                  schema_pkg_apis_machine_v1alpha1_MaintenanceWindow(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplate":                       schema_pkg_apis_machine_v1alpha1_NodeTemplate(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec":                   schema_pkg_apis_machine_v1alpha1_NodeTemplateSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.OrphanVM":                           schema_pkg_apis_machine_v1alpha1_OrphanVM(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig":                     schema_pkg_apis_machine_v1alpha1_RollbackConfig(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment":     schema_pkg_apis_machine_v1alpha1_RollingUpdateMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingSchedule":                    schema_pkg_apis_machine_v1alpha1_ScalingSchedule(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingScheduleStatus":              schema_pkg_apis_machine_v1alpha1_ScalingScheduleStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.AutoRollbackPolicy":                  schema_pkg_apis_machine_v1beta1_AutoRollbackPolicy(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenMachineDeployment":          schema_pkg_apis_machine_v1beta1_BlueGreenMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenStatus":                     schema_pkg_apis_machine_v1beta1_BlueGreenStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryMachineDeployment":             schema_pkg_apis_machine_v1beta1_CanaryMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryPause":                         schema_pkg_apis_machine_v1beta1_CanaryPause(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStatus":                        schema_pkg_apis_machine_v1beta1_CanaryStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStep":                          schema_pkg_apis_machine_v1beta1_CanaryStep(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallback":                       schema_pkg_apis_machine_v1beta1_ClassFallback(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallbackStatus":                 schema_pkg_apis_machine_v1beta1_ClassFallbackStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec":                           schema_pkg_apis_machine_v1beta1_ClassSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CurrentStatus":                       schema_pkg_apis_machine_v1beta1_CurrentStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation":                       schema_pkg_apis_machine_v1beta1_LastOperation(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.Machine":                             schema_pkg_apis_machine_v1beta1_Machine(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClass":                        schema_pkg_apis_machine_v1beta1_MachineClass(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassList":                    schema_pkg_apis_machine_v1beta1_MachineClassList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassProvider":                schema_pkg_apis_machine_v1beta1_MachineClassProvider(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassStatus":                  schema_pkg_apis_machine_v1beta1_MachineClassStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassValidation":              schema_pkg_apis_machine_v1beta1_MachineClassValidation(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineConfiguration":                schema_pkg_apis_machine_v1beta1_MachineConfiguration(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeployment":                   schema_pkg_apis_machine_v1beta1_MachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentCondition":          schema_pkg_apis_machine_v1beta1_MachineDeploymentCondition(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentList":               schema_pkg_apis_machine_v1beta1_MachineDeploymentList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentMaintenanceWindow":  schema_pkg_apis_machine_v1beta1_MachineDeploymentMaintenanceWindow(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentScheduledScaling":   schema_pkg_apis_machine_v1beta1_MachineDeploymentScheduledScaling(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentSpec":               schema_pkg_apis_machine_v1beta1_MachineDeploymentSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStatus":             schema_pkg_apis_machine_v1beta1_MachineDeploymentStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStrategy":           schema_pkg_apis_machine_v1beta1_MachineDeploymentStrategy(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZone":               schema_pkg_apis_machine_v1beta1_MachineDeploymentZone(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneSpread":         schema_pkg_apis_machine_v1beta1_MachineDeploymentZoneSpread(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneStatus":         schema_pkg_apis_machine_v1beta1_MachineDeploymentZoneStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineList":                         schema_pkg_apis_machine_v1beta1_MachineList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSet":                          schema_pkg_apis_machine_v1beta1_MachineSet(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetCondition":                 schema_pkg_apis_machine_v1beta1_MachineSetCondition(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetList":                      schema_pkg_apis_machine_v1beta1_MachineSetList(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetSpec":                      schema_pkg_apis_machine_v1beta1_MachineSetSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetStatus":                    schema_pkg_apis_machine_v1beta1_MachineSetStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSpec":                         schema_pkg_apis_machine_v1beta1_MachineSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineStatus":                       schema_pkg_apis_machine_v1beta1_MachineStatus(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSummary":                      schema_pkg_apis_machine_v1beta1_MachineSummary(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineTemplateSpec":                 schema_pkg_apis_machine_v1beta1_MachineTemplateSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineWarmPool":                     schema_pkg_apis_machine_v1beta1_MachineWarmPool(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MaintenanceWindow":                   schema_pkg_apis_machine_v1beta1_MaintenanceWindow(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplate":                        schema_pkg_apis_machine_v1beta1_NodeTemplate(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplateSpec":                    schema_pkg_apis_machine_v1beta1_NodeTemplateSpec(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.OrphanVM":                            schema_pkg_apis_machine_v1beta1_OrphanVM(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollbackConfig":                      schema_pkg_apis_machine_v1beta1_RollbackConfig(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollingUpdateMachineDeployment":      schema_pkg_apis_machine_v1beta1_RollingUpdateMachineDeployment(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingSchedule":                     schema_pkg_apis_machine_v1beta1_ScalingSchedule(ref),
+		"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingScheduleStatus":               schema_pkg_apis_machine_v1beta1_ScalingScheduleStatus(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                         schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AppArmorProfile":                             schema_k8sio_api_core_v1_AppArmorProfile(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
@@ -329,10 +400,306 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"k8s.io/apimachinery/pkg/runtime.RawExtension":                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
 		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
 		"k8s.io/apimachinery/pkg/runtime.Unknown":                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                schema_apimachinery_pkg_util_intstr_IntOrString(ref),
 		"k8s.io/apimachinery/pkg/version.Info":                           schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
+func schema_pkg_apis_machine_v1alpha1_AutoRollbackPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoRollbackPolicy describes when a rollout of a MachineDeployment is rolled back automatically.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxFailedMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailedMachines is the number of failed machines of the new machine set which is tolerated. The rollout is rolled back once more machines of the new machine set have failed. If not set, the rollout is only rolled back once it exceeds its progress deadline, which requires progressDeadlineSeconds to be set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_BlueGreenMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueGreenMachineDeployment is the spec to control the desired behavior of blue/green update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are the names of checks which have to pass before the machines are switched to the new machine set. A check passes once the MachineDeployment is annotated with bluegreen-hook.deployment.machine.sapcloud.io/<name> set to the machine-template-hash of the new machine set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"confirmationWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfirmationWindow is the time for which the cordoned machines of the old machine sets are kept after the switch, so that the switch can be reverted instantly. If not set, they are kept until the switch is confirmed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_BlueGreenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueGreenStatus is the most recently observed status of a blue/green update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineTemplateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the blue/green update.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"switchTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwitchTime is the time at which the machines of the old machine sets have been cordoned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_CanaryMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryMachineDeployment is the spec to control the desired behavior of canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are executed in order to roll out a new machine template. Once all steps are completed, all machines are moved to the new machine template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStep"),
+									},
+								},
+							},
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be unavailable while the machines of a step are replaced and while an aborted canary update moves the machines back to the old machine template. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding down. Defaults to 0.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be scheduled above the desired number of machines while the machines of a step are replaced and while an aborted canary update moves the machines back to the old machine template. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding up. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStep", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_CanaryPause(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryPause describes a pause of a canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration for which all new machines have to be available before the rollout continues. If not set, the rollout continues only once it is promoted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_CanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryStatus is the most recently observed status of a canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineTemplateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentStep is the index of the step which is currently executed. It is equal to the number of steps once all steps are completed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseStartTime is the time since which all new machines are available during a pause step.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"aborted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aborted is true if the rollout was aborted and the machines were moved back to the old machine template.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_CanaryStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryStep is a single step of a canary update. Exactly one of its fields has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of machines which should run the new machine template after this step. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding up. The old machine sets are scaled down within maxSurge and maxUnavailable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pause holds the rollout until all new machines are available for the given duration.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryPause"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryPause", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_ClassFallback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClassFallback describes the machine classes to fall back to when the provider has no capacity for a machine class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"classes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Classes is the ordered list of machine classes to fall back to. They have to be of the same kind as the class of the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+									},
+								},
+							},
+						},
+					},
+					"capacityErrorThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityErrorThreshold is the number of machines failing to be created from a class for lack of capacity, after which new machines are created from the next class. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"preferredClassRetryPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredClassRetryPeriod is the time after which new machines are created from the class of the template again after falling back to another class. Defaults to 30 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"classes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_ClassFallbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClassFallbackStatus describes the machine class new machines of a MachineSet are created from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the machine class new machines are created from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time new machines were switched to another class.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"class"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_machine_v1alpha1_ClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -557,65 +924,2449 @@ func schema_pkg_apis_machine_v1alpha1_MachineClass(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the most recently observed status of the MachineClass.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassStatus"),
+						},
+					},
+				},
+				Required: []string{"providerSpec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplate", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassList contains a list of MachineClasses",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineClassStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassStatus holds the most recently observed status of the MachineClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineCount is the number of machines referencing the MachineClass.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastValidation is the result of the last validation of the MachineClass by the machine controller.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassValidation"),
+						},
+					},
+					"lastSuccessfulDriverCallTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulDriverCallTime is the time of the last successful call to the driver with the MachineClass.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"orphanVMs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrphanVMs are the VMs of the MachineClass without a machine that were found in the last orphan VM sweep of the safety controller.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.OrphanVM"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClassValidation", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.OrphanVM", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineClassValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassValidation is the result of a validation of a MachineClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"valid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Valid tells whether machines can be reconciled with the MachineClass.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes why the MachineClass is not valid.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time at which the result of the validation last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"valid"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineConfiguration describes the configurations useful for the machine-controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"drainTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineDraintimeout is the timeout after which machine is forcefully deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"healthTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineHealthTimeout is the timeout after which machine is declared unhealhty/failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"creationTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineCreationTimeout is the timeout after which machinie creation is declared failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxEvictRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEvictRetries is the number of retries that will be attempted while draining the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeConditions are the set of conditions if set to true for MachineHealthTimeOut, machine will be declared failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeployment enables declarative updates for machines and MachineSets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired behavior of the MachineDeployment.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the MachineDeployment.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentCondition describes the state of a MachineDeployment at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of MachineDeployment condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time this condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentList is a list of MachineDeployments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of MachineDeployments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentMaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentMaintenanceWindow describes when disruptive operations are allowed for a MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows is the list of windows during which disruptive operations are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the window schedules are evaluated (ex: Europe/Berlin). Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MaintenanceWindow"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentScheduledScaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentScheduledScaling describes when and how the replicas of a MachineDeployment are changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules is the list of schedules changing the replicas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the schedules are evaluated (ex: Europe/Berlin). Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedules"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingSchedule"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentSpec is the specification of the desired behavior of the MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of desired machines. This is a pointer to distinguish between explicit zero and not specified. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector for machines. Existing MachineSets whose machines are selected by this will be the ones affected by this MachineDeployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the machines that will be created.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec"),
+						},
+					},
+					"strategy": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-strategy": "retainKeys",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The MachineDeployment strategy to use to replace existing machines with new ones.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy"),
+						},
+					},
+					"minReadySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum number of seconds for which a newly created machine should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (machine will be considered available as soon as it is ready)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of old MachineSets to retain to allow rollback. This is a pointer to distinguish between explicit zero and not specified.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the MachineDeployment is paused and will not be processed by the MachineDeployment controller.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rollbackTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DEPRECATED. The config this MachineDeployment is rolling back to. Will be cleared after rollback is done.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig"),
+						},
+					},
+					"progressDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum time in seconds for a MachineDeployment to make progress before it is considered to be failed. The MachineDeployment controller will continue to process failed MachineDeployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the MachineDeployment status. Note that progress will not be estimated during the time a MachineDeployment is paused. This is not set by default, which is treated as infinite deadline.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts disruptive operations, i.e. the scale-down of old machine sets during a rollout and the deletion of surplus machines, to the given windows. Replacement of failed machines is not restricted. If not set, disruptive operations are allowed at any time.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentMaintenanceWindow"),
+						},
+					},
+					"autoRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRollback enables the automatic rollback of a failing rollout to the last revision which has been rolled out completely. If not set, failing rollouts are not rolled back.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.AutoRollbackPolicy"),
+						},
+					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy defines which machines of the machine sets are deleted first on a scale-down. Machines are still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneSpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneSpread spreads the machines across several zones, each with its own machine class. The MachineDeployment manages one MachineDeployment per zone, which rolls out changes with the strategy of this MachineDeployment. If not set, all machines use the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneSpread"),
+						},
+					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback lists the machine classes new machines are created from when the provider has no capacity for the machine class of the template. If not set, machines are always created from the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallback"),
+						},
+					},
+					"warmPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up, machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineWarmPool"),
+						},
+					},
+					"scheduledScaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledScaling changes the replicas of the MachineDeployment on recurring schedules. The replicas may still be changed in between, e.g. by the cluster-autoscaler. If not set, the replicas are only changed by clients.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentScheduledScaling"),
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen is set by the safety controller when the machine sets of the MachineDeployment have more machines than allowed, which stops them from creating machines. It is reset once the number of machines decreased. The freeze label is still accepted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"template"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.AutoRollbackPolicy", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallback", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentMaintenanceWindow", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentScheduledScaling", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneSpread", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineWarmPool", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentStatus is the most recently observed status of the MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The generation observed by the MachineDeployment controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of non-terminated machines targeted by this MachineDeployment (their labels match the selector).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of non-terminated machines targeted by this MachineDeployment that have the desired template spec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of ready machines targeted by this MachineDeployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of available machines (ready for at least minReadySeconds) targeted by this MachineDeployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unavailableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of unavailable machines targeted by this MachineDeployment. This is the total number of machines that are still required for the MachineDeployment to have 100% available capacity. They may either be machines that are running but not yet available or machines that still have not been created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a MachineDeployment's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition"),
+									},
+								},
+							},
+						},
+					},
+					"collisionCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count of hash collisions for the MachineDeployment. The MachineDeployment controller uses this field as a collision avoidance mechanism when it needs to create the name for the newest MachineSet.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedMachines has summary of machines on which lastOperation Failed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"),
+									},
+								},
+							},
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the status of the ongoing canary update. Present only if MachineDeploymentStrategyType = Canary.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStatus"),
+						},
+					},
+					"blueGreen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueGreen is the status of the ongoing blue/green update. Present only if MachineDeploymentStrategyType = BlueGreen.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenStatus"),
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is the status of the zones of a MachineDeployment spreading its machines across zones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneStatus"),
+									},
+								},
+							},
+						},
+					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of machines of the warm pool targeted by this MachineDeployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scheduledScaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledScaling is the status of the schedules of a MachineDeployment with scheduled scaling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingScheduleStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZoneStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ScalingScheduleStatus"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentStrategy describes how to replace existing machines with new ones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of MachineDeployment. Can be \"Recreate\", \"RollingUpdate\", \"Canary\" or \"BlueGreen\". Default is RollingUpdate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rolling update config params. Present only if MachineDeploymentStrategyType = RollingUpdate.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment"),
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary update config params. Present only if MachineDeploymentStrategyType = Canary.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryMachineDeployment"),
+						},
+					},
+					"blueGreen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blue/green update config params. Present only if MachineDeploymentStrategyType = BlueGreen.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenMachineDeployment"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.BlueGreenMachineDeployment", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CanaryMachineDeployment", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentZone is a zone of a MachineDeployment with its machine class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the zone. It is part of the name of the MachineDeployment managing the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the machine class used for the machines in the zone.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the share of the replicas of the zone for the Weighted policy. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "class"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentZoneSpread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentZoneSpread describes how the machines of a MachineDeployment are spread across zones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones lists the zones to spread the machines across.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZone"),
+									},
+								},
+							},
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines how the replicas are distributed across the zones. Defaults to Even.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkew is the maximum difference between the replicas of any two zones up to which the MaxSkew policy moves replicas out of failing zones. Unlike the maxSkew of topology spread constraints, it does not rebalance the replicas of the other zones. Required for the MaxSkew policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentZone"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineDeploymentZoneStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentZoneStatus is the status of a zone of a MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineDeployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineDeployment is the name of the MachineDeployment managing the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of desired replicas of the zone.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of machines of the zone that have the desired template spec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyReplicas is the number of ready machines of the zone.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableReplicas is the number of available machines of the zone.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "machineDeployment", "replicas"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineList is a collection of Machines.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TypeMeta for MachineList object",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items contains the list of machines",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSet TODO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSetCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetCondition describes the state of a machine set at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of machine set condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetList is a collection of MachineSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetSpec is the specification of a MachineSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"machineClass": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec"),
+						},
+					},
+					"minReadySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy defines which machines are deleted first on a scale-down. Machines are still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback lists the machine classes new machines are created from when the provider has no capacity for the machine class of the template. If not set, machines are always created from the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallback"),
+						},
+					},
+					"warmPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up, machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineWarmPool"),
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen stops the MachineSet from creating machines. It is set by the safety controller when the MachineSet has more machines than allowed and is reset once the number of machines decreased. The freeze label is still accepted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallback", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineWarmPool", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetStatus holds the most recently observed status of MachineSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of actual replicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"fullyLabeledReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of pods that have labels matching the labels of the pod template of the replicaset.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of ready replicas for this replica set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of available replicas (ready for at least minReadySeconds) for this replica set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"machineSetCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a replica set's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition"),
+									},
+								},
+							},
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperation performed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+						},
+					},
+					"failedMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedMachines has summary of machines on which lastOperation Failed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"),
+									},
+								},
+							},
+						},
+					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback is the machine class new machines are created from, if the MachineSet has class fallback.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallbackStatus"),
+						},
+					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StandbyReplicas is the number of machines of the warm pool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassFallbackStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSpec is the specification of a Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class contains the machineclass attributes of a machine",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+						},
+					},
+					"providerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderID represents the provider's unique ID given to a machine",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTemplateSpec describes the data a node should have when created from a template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec"),
+						},
+					},
+					"drainTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineDraintimeout is the timeout after which machine is forcefully deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"healthTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineHealthTimeout is the timeout after which machine is declared unhealhty/failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"creationTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineCreationTimeout is the timeout after which machinie creation is declared failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxEvictRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEvictRetries is the number of retries that will be attempted while draining the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeConditions are the set of conditions if set to true for MachineHealthTimeOut, machine will be declared failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPriority is the priority of the machine on a scale-down of its machine set. Machines with a lower priority are deleted first. If the machinepriority.machine.sapcloud.io annotation, which is still accepted, holds a lower priority, that one is taken. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineStatus holds the most recently observed status of Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions of this machine, same as node",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.NodeCondition"),
+									},
+								},
+							},
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last operation refers to the status of the last operation performed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+						},
+					},
+					"currentStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current status of the machine object",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus"),
+						},
+					},
+					"lastKnownState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastKnownState can store details of the last known state of the VM by the plugins. It can be used by future operation calls to determine current infrastucture state",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation", "k8s.io/api/core/v1.NodeCondition"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSummary store the summary of machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the machine object",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderID represents the provider's unique ID given to a machine",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last operation refers to the status of the last operation performed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+						},
+					},
+					"ownerRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OwnerRef",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineTemplateSpec describes the data a machine should have when created from a template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the desired behavior of the machine. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MachineWarmPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineWarmPool describes the machines kept created ahead of time with their VMs stopped.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the number of machines kept in the warm pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"size"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring window of time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week) at which the window opens (ex: \"0 22 * * 1-5\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of time for which the window stays open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_NodeTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains subfields to track all node resources required to scale nodegroup from zero",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"instanceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instance type of the node belonging to nodeGroup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region of the expected node belonging to nodeGroup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone of the expected node belonging to nodeGroup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"architecture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU Architecture of the node belonging to nodeGroup",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"capacity", "instanceType", "region", "zone"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_NodeTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeTemplateSpec describes the data a node should have when created from a template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSpec describes the attributes that a node is created with.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.NodeSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.NodeSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_OrphanVM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OrphanVM is a VM at the provider without a machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"providerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderID is the ID of the VM at the provider.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineName is the name of the machine the VM was created for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"providerID", "machineName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_RollbackConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackConfig is the config to rollback a MachineDeployment",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The revision to rollback to. If set to 0, rollback to the last revision.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_RollingUpdateMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollingUpdateMachineDeployment is the spec to control the desired behavior of rolling update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Example: when this is set to 30%, the old machine set can be scaled down to 70% of desired machines immediately when the rolling update starts. Once new machines are ready, old machine set can be scaled down further, followed by scaling up the new machine set, ensuring that the total number of machines available at all times during the update is at least 70% of desired machines.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be scheduled above the desired number of machines. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Example: when this is set to 30%, the new machine set can be scaled up immediately when the rolling update starts, such that the total number of old and new machines does not exceed 130% of desired machines. Once old machines have been killed, new machine set can be scaled up further, ensuring that total number of machines running at any time during the update is utmost 130% of desired machines.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_ScalingSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingSchedule is a recurring change of the replicas of a MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the schedule. It is unique among the schedules of the MachineDeployment.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week) at which the replicas are changed (ex: \"0 7 * * 1-5\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of replicas the MachineDeployment is scaled to. If not set, the replicas are only kept within minReplicas and maxReplicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of replicas the MachineDeployment is scaled to.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of replicas the MachineDeployment is scaled to.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "schedule"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1alpha1_ScalingScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingScheduleStatus is the status of a schedule of a MachineDeployment with scheduled scaling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the schedule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last time the replicas have been changed by the schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextScheduleTime is the next time the replicas are changed by the schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_AutoRollbackPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoRollbackPolicy describes when a rollout of a MachineDeployment is rolled back automatically.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxFailedMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailedMachines is the number of failed machines of the new machine set which is tolerated. The rollout is rolled back once more machines of the new machine set have failed. If not set, the rollout is only rolled back once it exceeds its progress deadline, which requires progressDeadlineSeconds to be set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_BlueGreenMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueGreenMachineDeployment is the spec to control the desired behavior of blue/green update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are the names of checks which have to pass before the machines are switched to the new machine set. A check passes once the MachineDeployment is annotated with bluegreen-hook.deployment.machine.sapcloud.io/<name> set to the machine-template-hash of the new machine set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"confirmationWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfirmationWindow is the time for which the cordoned machines of the old machine sets are kept after the switch, so that the switch can be reverted instantly. If not set, they are kept until the switch is confirmed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_BlueGreenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueGreenStatus is the most recently observed status of a blue/green update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineTemplateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the blue/green update.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"switchTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwitchTime is the time at which the machines of the old machine sets have been cordoned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_CanaryMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryMachineDeployment is the spec to control the desired behavior of canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are executed in order to roll out a new machine template. Once all steps are completed, all machines are moved to the new machine template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStep"),
+									},
+								},
+							},
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be unavailable while the machines of a step are replaced and while an aborted canary update moves the machines back to the old machine template. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding down. Defaults to 0.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of machines that can be scheduled above the desired number of machines while the machines of a step are replaced and while an aborted canary update moves the machines back to the old machine template. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding up. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStep", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_CanaryPause(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryPause describes a pause of a canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration for which all new machines have to be available before the rollout continues. If not set, the rollout continues only once it is promoted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_CanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryStatus is the most recently observed status of a canary update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineTemplateHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTemplateHash is the machine-template-hash of the machine set which is rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentStep is the index of the step which is currently executed. It is equal to the number of steps once all steps are completed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseStartTime is the time since which all new machines are available during a pause step.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"aborted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aborted is true if the rollout was aborted and the machines were moved back to the old machine template.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_CanaryStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryStep is a single step of a canary update. Exactly one of its fields has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of machines which should run the new machine template after this step. Value can be an absolute number (ex: 5) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding up. The old machine sets are scaled down within maxSurge and maxUnavailable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pause holds the rollout until all new machines are available for the given duration.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryPause"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryPause", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_ClassFallback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClassFallback describes the machine classes to fall back to when the provider has no capacity for a machine class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"classes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Classes is the ordered list of machine classes to fall back to. They have to be of the same kind as the class of the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"),
+									},
+								},
+							},
+						},
+					},
+					"capacityErrorThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityErrorThreshold is the number of machines failing to be created from a class for lack of capacity, after which new machines are created from the next class. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"preferredClassRetryPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredClassRetryPeriod is the time after which new machines are created from the class of the template again after falling back to another class. Defaults to 30 minutes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"classes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_ClassFallbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClassFallbackStatus describes the machine class new machines of a MachineSet are created from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the machine class new machines are created from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time new machines were switched to another class.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"class"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_ClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClassSpec is the class specification of machine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API group to which it belongs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind for machine class",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of machine class",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_CurrentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CurrentStatus contains information about the current status of Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the machine",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last update time of current status",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_LastOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LastOperation suggests the last operation performed on the object",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description of the current operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorCode of the current operation if any",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last update time of current operation",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Machine is the representation of a physical or virtual machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectMeta for machine object",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of the machine",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains fields depicting the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClass can be used to templatize and re-use provider configuration across multiple Machines / MachineSets / MachineDeployments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"nodeTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTemplate contains subfields to track all node resources and other node info required to scale nodegroup from zero",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplate"),
+						},
+					},
+					"credentialsSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretRef can optionally store the credentials (in this case the SecretRef does not need to store them). This might be useful if multiple machine classes with the same credentials but different user-datas are used.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider describes the cloud-specific driver and its configuration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassProvider"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef stores the necessary secrets such as credentials or userdata.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the most recently observed status of the MachineClass.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassStatus"),
+						},
+					},
+				},
+				Required: []string{"provider"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassProvider", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplate", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassList contains a list of MachineClasses",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClass"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineClassProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassProvider describes the cloud-specific driver of a MachineClass and its configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the combination of name and location of cloud-specific drivers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the provider-specific configuration to use during node creation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineClassStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineClassStatus holds the most recently observed status of the MachineClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineCount is the number of machines referencing the MachineClass.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastValidation is the result of the last validation of the MachineClass by the machine controller.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassValidation"),
+						},
+					},
+					"lastSuccessfulDriverCallTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulDriverCallTime is the time of the last successful call to the driver with the MachineClass.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"orphanVMs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrphanVMs are the VMs of the MachineClass without a machine that were found in the last orphan VM sweep of the safety controller.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.OrphanVM"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"providerSpec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplate", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineClassValidation", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.OrphanVM", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineClassValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineClassList contains a list of MachineClasses",
+				Description: "MachineClassValidation is the result of a validation of a MachineClass.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"valid": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
+							Description: "Valid tells whether machines can be reconciled with the MachineClass.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Message describes why the MachineClass is not valid.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass"),
-									},
-								},
-							},
+							Description: "LastUpdateTime is the time at which the result of the validation last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"valid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -662,7 +3413,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineConfiguration(ref common.ReferenceC
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -694,25 +3445,25 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeployment(ref common.ReferenceCall
 						SchemaProps: spec.SchemaProps{
 							Description: "Specification of the desired behavior of the MachineDeployment.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Most recently observed status of the MachineDeployment.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeploymentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -770,7 +3521,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentCondition(ref common.Refe
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -806,7 +3557,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref common.Reference
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeployment"),
 									},
 								},
 							},
@@ -817,11 +3568,85 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentList(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeployment", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeployment", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentMaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentMaintenanceWindow describes when disruptive operations are allowed for a MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows is the list of windows during which disruptive operations are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the window schedules are evaluated (ex: Europe/Berlin). Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MaintenanceWindow"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentScheduledScaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentScheduledScaling describes when and how the replicas of a MachineDeployment are changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules is the list of schedules changing the replicas.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the schedules are evaluated (ex: Europe/Berlin). Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedules"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingSchedule"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -845,7 +3670,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.Reference
 						SchemaProps: spec.SchemaProps{
 							Description: "Template describes the machines that will be created.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineTemplateSpec"),
 						},
 					},
 					"strategy": {
@@ -857,7 +3682,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.Reference
 						SchemaProps: spec.SchemaProps{
 							Description: "The MachineDeployment strategy to use to replace existing machines with new ones.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStrategy"),
 						},
 					},
 					"minReadySeconds": {
@@ -884,7 +3709,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.Reference
 					"rollbackTo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DEPRECATED. The config this MachineDeployment is rolling back to. Will be cleared after rollback is done.",
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollbackConfig"),
 						},
 					},
 					"progressDeadlineSeconds": {
@@ -894,16 +3719,66 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentSpec(ref common.Reference
 							Format:      "int32",
 						},
 					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts disruptive operations, i.e. the scale-down of old machine sets during a rollout and the deletion of surplus machines, to the given windows. Replacement of failed machines is not restricted. If not set, disruptive operations are allowed at any time.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentMaintenanceWindow"),
+						},
+					},
+					"autoRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRollback enables the automatic rollback of a failing rollout to the last revision which has been rolled out completely. If not set, failing rollouts are not rolled back.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.AutoRollbackPolicy"),
+						},
+					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy defines which machines of the machine sets are deleted first on a scale-down. Machines are still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneSpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneSpread spreads the machines across several zones, each with its own machine class. The MachineDeployment manages one MachineDeployment per zone, which rolls out changes with the strategy of this MachineDeployment. If not set, all machines use the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneSpread"),
+						},
+					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback lists the machine classes new machines are created from when the provider has no capacity for the machine class of the template. If not set, machines are always created from the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallback"),
+						},
+					},
+					"warmPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up, machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineWarmPool"),
+						},
+					},
+					"scheduledScaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledScaling changes the replicas of the MachineDeployment on recurring schedules. The replicas may still be changed in between, e.g. by the cluster-autoscaler. If not set, the replicas are only changed by clients.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentScheduledScaling"),
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen is set by the safety controller when the machine sets of the MachineDeployment have more machines than allowed, which stops them from creating machines. It is reset once the number of machines decreased. The freeze label is still accepted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentStrategy", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollbackConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.AutoRollbackPolicy", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallback", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentMaintenanceWindow", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentScheduledScaling", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentStrategy", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneSpread", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineTemplateSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineWarmPool", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollbackConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -966,7 +3841,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref common.Referen
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentCondition"),
 									},
 								},
 							},
@@ -986,49 +3861,249 @@ func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStatus(ref common.Referen
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"),
+										Ref: ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSummary"),
+									},
+								},
+							},
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the status of the ongoing canary update. Present only if MachineDeploymentStrategyType = Canary.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStatus"),
+						},
+					},
+					"blueGreen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlueGreen is the status of the ongoing blue/green update. Present only if MachineDeploymentStrategyType = BlueGreen.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenStatus"),
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is the status of the zones of a MachineDeployment spreading its machines across zones.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneStatus"),
+									},
+								},
+							},
+						},
+					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of machines of the warm pool targeted by this MachineDeployment.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scheduledScaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledScaling is the status of the schedules of a MachineDeployment with scheduled scaling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingScheduleStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZoneStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSummary", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ScalingScheduleStatus"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentStrategy describes how to replace existing machines with new ones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of MachineDeployment. Can be \"Recreate\", \"RollingUpdate\", \"Canary\" or \"BlueGreen\". Default is RollingUpdate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rolling update config params. Present only if MachineDeploymentStrategyType = RollingUpdate.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollingUpdateMachineDeployment"),
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary update config params. Present only if MachineDeploymentStrategyType = Canary.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryMachineDeployment"),
+						},
+					},
+					"blueGreen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blue/green update config params. Present only if MachineDeploymentStrategyType = BlueGreen.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenMachineDeployment"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.BlueGreenMachineDeployment", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CanaryMachineDeployment", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.RollingUpdateMachineDeployment"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentZone is a zone of a MachineDeployment with its machine class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the zone. It is part of the name of the MachineDeployment managing the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the machine class used for the machines in the zone.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"),
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the share of the replicas of the zone for the Weighted policy. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "class"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentZoneSpread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentZoneSpread describes how the machines of a MachineDeployment are spread across zones.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones lists the zones to spread the machines across.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZone"),
 									},
 								},
 							},
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines how the replicas are distributed across the zones. Defaults to Even.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkew is the maximum difference between the replicas of any two zones up to which the MaxSkew policy moves replicas out of failing zones. Unlike the maxSkew of topology spread constraints, it does not rebalance the replicas of the other zones. Required for the MaxSkew policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineDeploymentCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineDeploymentZone"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineDeploymentStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineDeploymentZoneStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineDeploymentStrategy describes how to replace existing machines with new ones.",
+				Description: "MachineDeploymentZoneStatus is the status of a zone of a MachineDeployment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of MachineDeployment. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.",
+							Description: "Name is the name of the zone.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"rollingUpdate": {
+					"machineDeployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rolling update config params. Present only if MachineDeploymentStrategyType = RollingUpdate.",
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment"),
+							Description: "MachineDeployment is the name of the MachineDeployment managing the zone.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of desired replicas of the zone.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedReplicas is the number of machines of the zone that have the desired template spec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyReplicas is the number of ready machines of the zone.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availableReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableReplicas is the number of available machines of the zone.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"name", "machineDeployment", "replicas"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.RollingUpdateMachineDeployment"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1064,7 +4139,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.Machine"),
 									},
 								},
 							},
@@ -1075,11 +4150,11 @@ func schema_pkg_apis_machine_v1alpha1_MachineList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.Machine", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.Machine", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1109,24 +4184,24 @@ func schema_pkg_apis_machine_v1alpha1_MachineSet(ref common.ReferenceCallback) c
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec"),
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus"),
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSetCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSetCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1178,7 +4253,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetCondition(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1212,7 +4287,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetList(ref common.ReferenceCallbac
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSet"),
 									},
 								},
 							},
@@ -1222,11 +4297,11 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetList(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSet", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1247,13 +4322,13 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref common.ReferenceCallbac
 					"machineClass": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"),
 						},
 					},
 					"template": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec"),
+							Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineTemplateSpec"),
 						},
 					},
 					"minReadySeconds": {
@@ -1262,15 +4337,41 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetSpec(ref common.ReferenceCallbac
 							Format: "int32",
 						},
 					},
+					"deletePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletePolicy defines which machines are deleted first on a scale-down. Machines are still deleted by their deletion priority first and unhealthy machines before healthy ones. Defaults to Oldest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback lists the machine classes new machines are created from when the provider has no capacity for the machine class of the template. If not set, machines are always created from the class of the template.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallback"),
+						},
+					},
+					"warmPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarmPool keeps additional machines created ahead of time with their VMs stopped. On a scale-up, machines of the warm pool are started instead of creating new ones. If not set, there is no warm pool.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineWarmPool"),
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen stops the MachineSet from creating machines. It is set by the safety controller when the MachineSet has more machines than allowed and is reset once the number of machines decreased. The freeze label is still accepted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallback", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineTemplateSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineWarmPool", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1320,7 +4421,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetCondition"),
 									},
 								},
 							},
@@ -1330,7 +4431,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref common.ReferenceCallb
 						SchemaProps: spec.SchemaProps{
 							Description: "LastOperation performed",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation"),
 						},
 					},
 					"failedMachines": {
@@ -1341,21 +4442,34 @@ func schema_pkg_apis_machine_v1alpha1_MachineSetStatus(ref common.ReferenceCallb
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"),
+										Ref:     ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSummary"),
 									},
 								},
 							},
 						},
 					},
+					"classFallback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassFallback is the machine class new machines are created from, if the MachineSet has class fallback.",
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallbackStatus"),
+						},
+					},
+					"standbyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StandbyReplicas is the number of machines of the warm pool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSetCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSummary"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassFallbackStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSetCondition", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSummary"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1366,7 +4480,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSpec(ref common.ReferenceCallback)
 						SchemaProps: spec.SchemaProps{
 							Description: "Class contains the machineclass attributes of a machine",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec"),
 						},
 					},
 					"providerID": {
@@ -1380,7 +4494,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSpec(ref common.ReferenceCallback)
 						SchemaProps: spec.SchemaProps{
 							Description: "NodeTemplateSpec describes the data a node should have when created from a template",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplateSpec"),
 						},
 					},
 					"drainTimeout": {
@@ -1415,15 +4529,22 @@ func schema_pkg_apis_machine_v1alpha1_MachineSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"deletionPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPriority is the priority of the machine on a scale-down of its machine set. Machines with a lower priority are deleted first. If the machinepriority.machine.sapcloud.io annotation, which is still accepted, holds a lower priority, that one is taken. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.NodeTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.ClassSpec", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.NodeTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1448,14 +4569,14 @@ func schema_pkg_apis_machine_v1alpha1_MachineStatus(ref common.ReferenceCallback
 						SchemaProps: spec.SchemaProps{
 							Description: "Last operation refers to the status of the last operation performed",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation"),
 						},
 					},
 					"currentStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Current status of the machine object",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CurrentStatus"),
 						},
 					},
 					"lastKnownState": {
@@ -1469,11 +4590,11 @@ func schema_pkg_apis_machine_v1alpha1_MachineStatus(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.CurrentStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation", "k8s.io/api/core/v1.NodeCondition"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.CurrentStatus", "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation", "k8s.io/api/core/v1.NodeCondition"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1498,7 +4619,7 @@ func schema_pkg_apis_machine_v1alpha1_MachineSummary(ref common.ReferenceCallbac
 						SchemaProps: spec.SchemaProps{
 							Description: "Last operation refers to the status of the last operation performed",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation"),
 						},
 					},
 					"ownerRef": {
@@ -1512,11 +4633,11 @@ func schema_pkg_apis_machine_v1alpha1_MachineSummary(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.LastOperation"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.LastOperation"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_MachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1534,18 +4655,70 @@ func schema_pkg_apis_machine_v1alpha1_MachineTemplateSpec(ref common.ReferenceCa
 						SchemaProps: spec.SchemaProps{
 							Description: "Specification of the desired behavior of the machine. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec"),
+							Ref:         ref("github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1.MachineSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1.MachineSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_NodeTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_MachineWarmPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineWarmPool describes the machines kept created ahead of time with their VMs stopped.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the number of machines kept in the warm pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"size"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring window of time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week) at which the window opens (ex: \"0 22 * * 1-5\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of time for which the window stays open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_NodeTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1606,7 +4779,7 @@ func schema_pkg_apis_machine_v1alpha1_NodeTemplate(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_NodeTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_NodeTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1634,7 +4807,37 @@ func schema_pkg_apis_machine_v1alpha1_NodeTemplateSpec(ref common.ReferenceCallb
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_RollbackConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_OrphanVM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OrphanVM is a VM at the provider without a machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"providerID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderID is the ID of the VM at the provider.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineName is the name of the machine the VM was created for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"providerID", "machineName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_RollbackConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1654,7 +4857,7 @@ func schema_pkg_apis_machine_v1alpha1_RollbackConfig(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_machine_v1alpha1_RollingUpdateMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_machine_v1beta1_RollingUpdateMachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1681,6 +4884,93 @@ func schema_pkg_apis_machine_v1alpha1_RollingUpdateMachineDeployment(ref common.
 	}
 }
 
+func schema_pkg_apis_machine_v1beta1_ScalingSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingSchedule is a recurring change of the replicas of a MachineDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the schedule. It is unique among the schedules of the MachineDeployment.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a standard 5-field cron expression (minute hour day-of-month month day-of-week) at which the replicas are changed (ex: \"0 7 * * 1-5\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of replicas the MachineDeployment is scaled to. If not set, the replicas are only kept within minReplicas and maxReplicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of replicas the MachineDeployment is scaled to.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of replicas the MachineDeployment is scaled to.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "schedule"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_machine_v1beta1_ScalingScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingScheduleStatus is the status of a schedule of a MachineDeployment with scheduled scaling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the schedule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last time the replicas have been changed by the schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextScheduleTime is the next time the replicas are changed by the schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17637,6 +20927,26 @@ func schema_k8sio_apimachinery_pkg_runtime_Unknown(ref common.ReferenceCallback)
 	}
 }
 
+func schema_apimachinery_pkg_util_intstr_IntOrString(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.EmbedOpenAPIDefinitionIntoV2Extension(common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IntOrString is a type that can hold an int32 or a string.  When used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type.  This allows you to have, for example, a JSON field that can accept a name or number.",
+				OneOf:       common.GenerateOpenAPIV3OneOfSchema(intstr.IntOrString{}.OpenAPIV3OneOfTypes()),
+				Format:      intstr.IntOrString{}.OpenAPISchemaFormat(),
+			},
+		},
+	}, common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IntOrString is a type that can hold an int32 or a string.  When used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type.  This allows you to have, for example, a JSON field that can accept a name or number.",
+				Type:        intstr.IntOrString{}.OpenAPISchemaType(),
+				Format:      intstr.IntOrString{}.OpenAPISchemaFormat(),
+			},
+		},
+	})
+}
+
 func schema_k8sio_apimachinery_pkg_version_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
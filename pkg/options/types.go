@@ -6,6 +6,8 @@
 package options
 
 import (
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -46,6 +48,8 @@ type MachineControllerManagerConfiguration struct {
 	EnableProfiling bool
 	// enableContentionProfiling enables lock contention profiling, if enableProfiling is true.
 	EnableContentionProfiling bool
+	// DebugServer is the configuration of the TLS server of the /debug/mcm endpoint.
+	DebugServer debug.ServerOptions
 	// contentType is contentType of requests sent to apiserver.
 	ContentType string
 	// kubeAPIQPS is the QPS to use while talking with kubernetes apiserver.
@@ -64,6 +68,31 @@ type MachineControllerManagerConfiguration struct {
 	// AutoscalerScaleDownAnnotationDuringRollout is an option to disable annotating the node-objects during roll-out.
 	// The cluster autoscaler native annotation is "cluster-autoscaler.kubernetes.io/scale-down-disabled".
 	AutoscalerScaleDownAnnotationDuringRollout bool
+	// MaxConcurrentRollouts is the maximum number of machine deployments which roll out a new machine set
+	// at the same time. Further rollouts wait until a rollout is complete. Zero means no limit.
+	MaxConcurrentRollouts int32
+	// WebhookServer is the configuration of the admission webhook server for machine.sapcloud.io resources.
+	WebhookServer WebhookServerOptions
+	// Chaos are the faults injected into the requests of the controllers to the API servers, for debugging only.
+	Chaos chaos.Faults
+}
+
+// WebhookServerOptions are used to configure the admission webhook server, which validates and defaults
+// machine.sapcloud.io resources
+type WebhookServerOptions struct {
+	// Port is the port the webhook server serves on. The webhook server is disabled if it is zero.
+	Port int32
+	// CertDir is the directory containing the serving certificate tls.crt and its key tls.key.
+	CertDir string
+	// DefaultMachineCreationTimeout is the creation timeout set on machines which do not specify one.
+	// Zero leaves it unset.
+	DefaultMachineCreationTimeout metav1.Duration
+	// DefaultMachineHealthTimeout is the health timeout set on machines which do not specify one.
+	// Zero leaves it unset.
+	DefaultMachineHealthTimeout metav1.Duration
+	// DefaultMachineDrainTimeout is the drain timeout set on machines which do not specify one.
+	// Zero leaves it unset.
+	DefaultMachineDrainTimeout metav1.Duration
 }
 
 // SafetyOptions are used to configure the upper-limit and lower-limit
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// VM is a VM of the FakeDriver.
+type VM struct {
+	// ProviderID is the provider ID of the VM.
+	ProviderID string
+	// MachineName is the name of the machine the VM was created for.
+	MachineName string
+	// MachineClassName is the name of the machine class the VM was created with.
+	MachineClassName string
+	// Stopped is true if the VM is stopped.
+	Stopped bool
+}
+
+// FakeDriver is a driver.Driver keeping its VMs in memory. It simulates the kubelet by registering a ready node with
+// the provider ID of the VM in the target cluster whenever it creates a VM.
+type FakeDriver struct {
+	targetCoreClient kubernetes.Interface
+
+	mutex sync.Mutex
+	vms   map[string]*VM
+}
+
+var _ driver.Driver = &FakeDriver{}
+var _ driver.MachineStopper = &FakeDriver{}
+
+// NewFakeDriver returns a FakeDriver registering the nodes of its VMs through the given client.
+func NewFakeDriver(targetCoreClient kubernetes.Interface) *FakeDriver {
+	return &FakeDriver{
+		targetCoreClient: targetCoreClient,
+		vms:              map[string]*VM{},
+	}
+}
+
+// ProviderID returns the provider ID of the VM of the machine with the given namespace and name.
+func ProviderID(namespace, machineName string) string {
+	return fmt.Sprintf("fake:///%s/%s", namespace, machineName)
+}
+
+// AddVM adds a VM without creating a node for it, e.g. to simulate an orphan VM.
+func (d *FakeDriver) AddVM(vm VM) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.vms[vm.ProviderID] = &vm
+}
+
+// VMs returns a copy of the VMs of the driver.
+func (d *FakeDriver) VMs() []VM {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	vms := make([]VM, 0, len(d.vms))
+	for _, vm := range d.vms {
+		vms = append(vms, *vm)
+	}
+	return vms
+}
+
+// CreateMachine creates a VM and registers its node.
+func (d *FakeDriver) CreateMachine(ctx context.Context, req *driver.CreateMachineRequest) (*driver.CreateMachineResponse, error) {
+	vm := VM{
+		ProviderID:       ProviderID(req.Machine.Namespace, req.Machine.Name),
+		MachineName:      req.Machine.Name,
+		MachineClassName: req.MachineClass.Name,
+	}
+	d.AddVM(vm)
+	if err := d.registerNode(ctx, vm.MachineName, vm.ProviderID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &driver.CreateMachineResponse{
+		ProviderID: vm.ProviderID,
+		NodeName:   vm.MachineName,
+	}, nil
+}
+
+// InitializeMachine is not implemented, as the VMs need no initialization.
+func (d *FakeDriver) InitializeMachine(_ context.Context, _ *driver.InitializeMachineRequest) (*driver.InitializeMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "fake driver does not initialize VMs")
+}
+
+// DeleteMachine deletes the VM of the machine. Deleting a VM which does not exist succeeds.
+func (d *FakeDriver) DeleteMachine(_ context.Context, req *driver.DeleteMachineRequest) (*driver.DeleteMachineResponse, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if vm := d.getVM(req.Machine.Spec.ProviderID, req.Machine.Name); vm != nil {
+		delete(d.vms, vm.ProviderID)
+	}
+	return &driver.DeleteMachineResponse{}, nil
+}
+
+// GetMachineStatus returns the VM of the machine.
+func (d *FakeDriver) GetMachineStatus(_ context.Context, req *driver.GetMachineStatusRequest) (*driver.GetMachineStatusResponse, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	vm := d.getVM(req.Machine.Spec.ProviderID, req.Machine.Name)
+	if vm == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no VM found for machine %q", req.Machine.Name))
+	}
+	return &driver.GetMachineStatusResponse{
+		ProviderID: vm.ProviderID,
+		NodeName:   vm.MachineName,
+	}, nil
+}
+
+// ListMachines lists the VMs created with the machine class.
+func (d *FakeDriver) ListMachines(_ context.Context, req *driver.ListMachinesRequest) (*driver.ListMachinesResponse, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	machineList := map[string]string{}
+	for _, vm := range d.vms {
+		if vm.MachineClassName == req.MachineClass.Name {
+			machineList[vm.ProviderID] = vm.MachineName
+		}
+	}
+	return &driver.ListMachinesResponse{MachineList: machineList}, nil
+}
+
+// GetVolumeIDs returns no volume IDs, as the VMs have no volumes.
+func (d *FakeDriver) GetVolumeIDs(_ context.Context, _ *driver.GetVolumeIDsRequest) (*driver.GetVolumeIDsResponse, error) {
+	return &driver.GetVolumeIDsResponse{}, nil
+}
+
+// StopMachine stops the VM of the machine.
+func (d *FakeDriver) StopMachine(_ context.Context, req *driver.StopMachineRequest) (*driver.StopMachineResponse, error) {
+	if err := d.setStopped(req.Machine.Spec.ProviderID, req.Machine.Name, true); err != nil {
+		return nil, err
+	}
+	return &driver.StopMachineResponse{}, nil
+}
+
+// StartMachine starts the VM of the machine.
+func (d *FakeDriver) StartMachine(_ context.Context, req *driver.StartMachineRequest) (*driver.StartMachineResponse, error) {
+	if err := d.setStopped(req.Machine.Spec.ProviderID, req.Machine.Name, false); err != nil {
+		return nil, err
+	}
+	return &driver.StartMachineResponse{}, nil
+}
+
+func (d *FakeDriver) setStopped(providerID, machineName string, stopped bool) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	vm := d.getVM(providerID, machineName)
+	if vm == nil {
+		return status.Error(codes.NotFound, fmt.Sprintf("no VM found for machine %q", machineName))
+	}
+	vm.Stopped = stopped
+	return nil
+}
+
+// getVM returns the VM with the provider ID, or the VM of the machine if the provider ID is not known yet.
+// The caller must hold the mutex.
+func (d *FakeDriver) getVM(providerID, machineName string) *VM {
+	if providerID != "" {
+		return d.vms[providerID]
+	}
+	for _, vm := range d.vms {
+		if vm.MachineName == machineName {
+			return vm
+		}
+	}
+	return nil
+}
+
+// registerNode does what the kubelet does when the VM joins the cluster: it registers the node and reports it ready.
+func (d *FakeDriver) registerNode(ctx context.Context, name, providerID string) error {
+	node, err := d.targetCoreClient.CoreV1().Nodes().Create(ctx, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelHostname: name},
+		},
+		Spec: corev1.NodeSpec{ProviderID: providerID},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		node, err = d.targetCoreClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	node.Status.Conditions = []corev1.NodeCondition{{
+		Type:               corev1.NodeReady,
+		Status:             corev1.ConditionTrue,
+		Reason:             "KubeletReady",
+		Message:            "kubelet is posting ready status",
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+	}}
+	_, err = d.targetCoreClient.CoreV1().Nodes().UpdateStatus(ctx, node, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package envtest runs the machine-controller-manager and the machine controller in-process against a kube-apiserver
+// and etcd started by controller-runtime's envtest. The machines are backed by a FakeDriver, so no cloud provider and no
+// network access beyond the local kube-apiserver is needed.
+package envtest
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	machineclientset "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned"
+	machineapi "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/typed/machine/v1alpha1"
+	machineinformers "github.com/gardener/machine-controller-manager/pkg/client/informers/externalversions"
+	mcmcontroller "github.com/gardener/machine-controller-manager/pkg/controller"
+	machineconfig "github.com/gardener/machine-controller-manager/pkg/options"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/drain"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	machinecontroller "github.com/gardener/machine-controller-manager/pkg/util/provider/machinecontroller"
+	provideroptions "github.com/gardener/machine-controller-manager/pkg/util/provider/options"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrlenvtest "sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+const (
+	// DefaultNamespace is the namespace the controllers watch if Environment.Namespace is not set.
+	DefaultNamespace = "default"
+	// DefaultNodeConditions are the node conditions marking a machine unhealthy, as defaulted by the machine controller.
+	DefaultNodeConditions = "KernelDeadlock,ReadonlyFilesystem,DiskPressure,NetworkUnavailable"
+
+	controllerManagerAgentName = "machine-controller-manager-envtest"
+	resyncPeriod               = 30 * time.Second
+	workers                    = 5
+)
+
+// Environment is a kube-apiserver and etcd with the machine CRDs installed, on which the machine-controller-manager and
+// the machine controller run in-process. The kube-apiserver serves as both the control and the target cluster.
+type Environment struct {
+	// Namespace is the namespace the controllers watch. Defaults to DefaultNamespace.
+	Namespace string
+	// Driver is the driver of the machine controller. Defaults to a FakeDriver registering the nodes of its VMs in the
+	// kube-apiserver of the environment.
+	Driver driver.Driver
+	// SafetyOptions are the safety options of the machine-controller-manager. The overshooting period defaults to a
+	// second and the safety limits to the ones of the machine-controller-manager.
+	SafetyOptions machineconfig.SafetyOptions
+	// ProviderSafetyOptions are the safety options of the machine controller. The health timeout defaults to ten
+	// seconds, the orphan VM period to two seconds, the API server status check period to a second and its timeout
+	// to three seconds, all others to the ones of the machine controller.
+	ProviderSafetyOptions provideroptions.SafetyOptions
+	// Chaos injects faults into the requests of the controllers to the kube-apiserver and into their calls to the
+	// driver, if set. The clients of the environment and the driver itself do not see the faults.
+	Chaos *chaos.Injector
+
+	// RestConfig is the config of the kube-apiserver, set by Start.
+	RestConfig *rest.Config
+	// ControlMachineClient is a client for the machine resources, set by Start.
+	ControlMachineClient machineapi.MachineV1alpha1Interface
+	// CoreClient is a client for the core resources, set by Start.
+	CoreClient kubernetes.Interface
+
+	testEnv *ctrlenvtest.Environment
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// Start starts the kube-apiserver and etcd, installs the CRDs and runs the controllers. The binaries of the
+// kube-apiserver and etcd are looked up in the directory set by the KUBEBUILDER_ASSETS environment variable.
+func (e *Environment) Start() error {
+	e.setDefaults()
+
+	e.testEnv = &ctrlenvtest.Environment{
+		CRDDirectoryPaths:     []string{crdDirectory()},
+		ErrorIfCRDPathMissing: true,
+	}
+	config, err := e.testEnv.Start()
+	if err != nil {
+		return fmt.Errorf("failed to start the test environment: %w", err)
+	}
+	e.RestConfig = config
+
+	machineClient, err := machineclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	e.ControlMachineClient = machineClient.MachineV1alpha1()
+	if e.CoreClient, err = kubernetes.NewForConfig(config); err != nil {
+		return err
+	}
+	if e.Driver == nil {
+		e.Driver = NewFakeDriver(e.CoreClient)
+	}
+
+	e.stop = make(chan struct{})
+	if err := e.runControllers(); err != nil {
+		close(e.stop)
+		return err
+	}
+	return nil
+}
+
+// Stop stops the controllers, the kube-apiserver and etcd.
+func (e *Environment) Stop() error {
+	if e.stop != nil {
+		close(e.stop)
+		e.wg.Wait()
+		e.stop = nil
+	}
+	if e.testEnv == nil {
+		return nil
+	}
+	return e.testEnv.Stop()
+}
+
+func (e *Environment) setDefaults() {
+	if e.Namespace == "" {
+		e.Namespace = DefaultNamespace
+	}
+	if e.SafetyOptions == (machineconfig.SafetyOptions{}) {
+		e.SafetyOptions = machineconfig.SafetyOptions{
+			SafetyUp:                        2,
+			SafetyDown:                      1,
+			MachineSafetyOvershootingPeriod: metav1.Duration{Duration: time.Second},
+		}
+	}
+	if e.ProviderSafetyOptions == (provideroptions.SafetyOptions{}) {
+		e.ProviderSafetyOptions = provideroptions.SafetyOptions{
+			MachineCreationTimeout:                   metav1.Duration{Duration: 20 * time.Minute},
+			MachineHealthTimeout:                     metav1.Duration{Duration: 10 * time.Second},
+			MachineDrainTimeout:                      metav1.Duration{Duration: drain.DefaultMachineDrainTimeout},
+			MaxEvictRetries:                          drain.DefaultMaxEvictRetries,
+			PvDetachTimeout:                          metav1.Duration{Duration: 2 * time.Minute},
+			PvReattachTimeout:                        metav1.Duration{Duration: 90 * time.Second},
+			MachineSafetyOrphanVMsPeriod:             metav1.Duration{Duration: 2 * time.Second},
+			MachineSafetyAPIServerStatusCheckPeriod:  metav1.Duration{Duration: time.Second},
+			MachineSafetyAPIServerStatusCheckTimeout: metav1.Duration{Duration: 3 * time.Second},
+		}
+	}
+}
+
+// runControllers wires the controllers the same way the machine-controller-manager and the machine controller do, with
+// the control and the target cluster both being the kube-apiserver of the environment.
+func (e *Environment) runControllers() error {
+	config, d := e.RestConfig, e.Driver
+	if e.Chaos != nil {
+		config, d = e.Chaos.WrapConfig(config), e.Chaos.WrapDriver(d)
+	}
+	machineClient, err := machineclientset.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	coreClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	serverVersion, err := e.CoreClient.Discovery().ServerVersion()
+	if err != nil {
+		return err
+	}
+	targetKubernetesVersion, err := semver.NewVersion(serverVersion.GitVersion)
+	if err != nil {
+		return err
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: e.CoreClient.CoreV1().Events("")})
+	recorder := eventBroadcaster.NewRecorder(kubescheme.Scheme, corev1.EventSource{Component: controllerManagerAgentName})
+	go func() {
+		<-e.stop
+		eventBroadcaster.Shutdown()
+	}()
+
+	controlMachineInformerFactory := machineinformers.NewFilteredSharedInformerFactory(machineClient, resyncPeriod, e.Namespace, nil)
+	controlCoreInformerFactory := coreinformers.NewFilteredSharedInformerFactory(coreClient, resyncPeriod, e.Namespace, nil)
+	targetCoreInformerFactory := coreinformers.NewSharedInformerFactory(coreClient, resyncPeriod)
+	machineSharedInformers := controlMachineInformerFactory.Machine().V1alpha1()
+
+	mcmController, err := mcmcontroller.NewController(
+		e.Namespace,
+		machineClient.MachineV1alpha1(),
+		coreClient,
+		coreClient,
+		targetCoreInformerFactory.Core().V1().Nodes(),
+		machineSharedInformers.Machines(),
+		machineSharedInformers.MachineSets(),
+		machineSharedInformers.MachineDeployments(),
+		recorder,
+		e.SafetyOptions,
+		true,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+
+	machineController, err := machinecontroller.NewController(
+		e.Namespace,
+		machineClient.MachineV1alpha1(),
+		coreClient,
+		coreClient,
+		d,
+		targetCoreInformerFactory.Core().V1().PersistentVolumeClaims(),
+		targetCoreInformerFactory.Core().V1().PersistentVolumes(),
+		controlCoreInformerFactory.Core().V1().Secrets(),
+		targetCoreInformerFactory.Core().V1().Nodes(),
+		targetCoreInformerFactory.Core().V1().Pods(),
+		targetCoreInformerFactory.Policy().V1().PodDisruptionBudgets(),
+		targetCoreInformerFactory.Storage().V1().VolumeAttachments(),
+		machineSharedInformers.MachineClasses(),
+		machineSharedInformers.Machines(),
+		recorder,
+		e.ProviderSafetyOptions,
+		DefaultNodeConditions,
+		"",
+		targetKubernetesVersion,
+	)
+	if err != nil {
+		return err
+	}
+
+	controlMachineInformerFactory.Start(e.stop)
+	controlCoreInformerFactory.Start(e.stop)
+	targetCoreInformerFactory.Start(e.stop)
+
+	klog.V(1).Infof("Running the controllers in namespace %q", e.Namespace)
+	e.wg.Add(2)
+	go func() {
+		defer e.wg.Done()
+		mcmController.Run(workers, e.stop)
+	}()
+	go func() {
+		defer e.wg.Done()
+		machineController.Run(workers, e.stop)
+	}()
+	return nil
+}
+
+// crdDirectory returns the directory of the CRDs of the repository.
+func crdDirectory() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..", "kubernetes", "crds")
+}
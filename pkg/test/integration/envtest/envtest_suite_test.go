@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	mcmcontroller "github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+func TestEnvtestSuite(t *testing.T) {
+	klog.SetOutput(io.Discard)
+	flags := &flag.FlagSet{}
+	klog.InitFlags(flags)
+	if err := flags.Set("logtostderr", "false"); err != nil {
+		t.Errorf("failed to set flags: %v", err)
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Envtest Integration Suite")
+}
+
+const secretName = "machine-secret"
+
+var env *Environment
+
+var _ = BeforeSuite(func() {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		Skip("KUBEBUILDER_ASSETS is not set, run make test-envtest to run the envtest integration tests")
+	}
+	SetDefaultEventuallyTimeout(time.Minute)
+	SetDefaultEventuallyPollingInterval(250 * time.Millisecond)
+
+	env = &Environment{Chaos: chaos.NewInjector(chaos.Faults{})}
+	Expect(env.Start()).To(Succeed())
+
+	_, err := env.CoreClient.CoreV1().Secrets(env.Namespace).Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: env.Namespace},
+		Data:       map[string][]byte{"userData": []byte("#!/bin/bash")},
+	}, metav1.CreateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	if env != nil {
+		Expect(env.Stop()).To(Succeed())
+	}
+})
+
+// createMachineClass creates a machine class with the machine secret.
+func createMachineClass(name string) *v1alpha1.MachineClass {
+	machineClass, err := env.ControlMachineClient.MachineClasses(env.Namespace).Create(context.TODO(), &v1alpha1.MachineClass{
+		ObjectMeta:   metav1.ObjectMeta{Name: name, Namespace: env.Namespace},
+		ProviderSpec: runtime.RawExtension{Raw: []byte(`{}`)},
+		Provider:     "fake",
+		SecretRef:    &corev1.SecretReference{Name: secretName, Namespace: env.Namespace},
+	}, metav1.CreateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		Expect(ignoreNotFound(env.ControlMachineClient.MachineClasses(env.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}))).To(Succeed())
+	})
+	return machineClass
+}
+
+// createMachineDeployment creates a machine deployment with the given replicas of machines of the machine class and
+// deletes it including its machines when the spec is done.
+func createMachineDeployment(name, machineClassName string, replicas int32) *v1alpha1.MachineDeployment {
+	labels := map[string]string{"machinedeployment": name}
+	machineDeployment, err := env.ControlMachineClient.MachineDeployments(env.Namespace).Create(context.TODO(), &v1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: env.Namespace},
+		Spec: v1alpha1.MachineDeploymentSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Strategy: v1alpha1.MachineDeploymentStrategy{
+				Type: v1alpha1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &v1alpha1.RollingUpdateMachineDeployment{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			Template: v1alpha1.MachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1alpha1.MachineSpec{
+					Class: v1alpha1.ClassSpec{Kind: "MachineClass", Name: machineClassName},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		Expect(ignoreNotFound(env.ControlMachineClient.MachineDeployments(env.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}))).To(Succeed())
+		Eventually(func() []v1alpha1.Machine { return machines(machineDeployment) }).Should(BeEmpty())
+	})
+	return machineDeployment
+}
+
+// scaleMachineDeployment sets the replicas of the machine deployment.
+func scaleMachineDeployment(machineDeployment *v1alpha1.MachineDeployment, replicas int32) {
+	updateMachineDeployment(machineDeployment, func(md *v1alpha1.MachineDeployment) { md.Spec.Replicas = replicas })
+}
+
+// updateMachineDeployment applies the mutation to the latest version of the machine deployment.
+func updateMachineDeployment(machineDeployment *v1alpha1.MachineDeployment, mutate func(*v1alpha1.MachineDeployment)) {
+	Eventually(func() error {
+		md, err := env.ControlMachineClient.MachineDeployments(env.Namespace).Get(context.TODO(), machineDeployment.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		mutate(md)
+		_, err = env.ControlMachineClient.MachineDeployments(env.Namespace).Update(context.TODO(), md, metav1.UpdateOptions{})
+		return err
+	}).Should(Succeed())
+}
+
+// machines returns the machines of the machine deployment.
+func machines(machineDeployment *v1alpha1.MachineDeployment) []v1alpha1.Machine {
+	machineList, err := env.ControlMachineClient.Machines(env.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(machineDeployment.Spec.Selector),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return machineList.Items
+}
+
+// runningMachines returns the names of the running machines of the machine deployment created with the machine class.
+func runningMachines(machineDeployment *v1alpha1.MachineDeployment, machineClassName string) []string {
+	var names []string
+	for _, machine := range machines(machineDeployment) {
+		if machine.Status.CurrentStatus.Phase == v1alpha1.MachineRunning && machine.Spec.Class.Name == machineClassName {
+			names = append(names, machine.Name)
+		}
+	}
+	return names
+}
+
+// machineNames returns the names of the machines of the machine deployment.
+func machineNames(machineDeployment *v1alpha1.MachineDeployment) []string {
+	var names []string
+	for _, machine := range machines(machineDeployment) {
+		names = append(names, machine.Name)
+	}
+	return names
+}
+
+// setNodesReady sets the ready condition of the nodes of the machine deployment, as the kubelets or the node lifecycle
+// controller would.
+func setNodesReady(machineDeployment *v1alpha1.MachineDeployment, ready corev1.ConditionStatus) {
+	for _, machine := range machines(machineDeployment) {
+		nodeName := machine.Labels[v1alpha1.NodeLabelKey]
+		Eventually(func() error {
+			node, err := env.CoreClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == corev1.NodeReady {
+					node.Status.Conditions[i].Status = ready
+					node.Status.Conditions[i].LastTransitionTime = metav1.Now()
+				}
+			}
+			_, err = env.CoreClient.CoreV1().Nodes().UpdateStatus(context.TODO(), node, metav1.UpdateOptions{})
+			return err
+		}).Should(Succeed())
+	}
+}
+
+// machineSetsFrozen returns true if a machine set of the machine deployment is frozen.
+func machineSetsFrozen(machineDeployment *v1alpha1.MachineDeployment) bool {
+	machineSets, err := env.ControlMachineClient.MachineSets(env.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(machineDeployment.Spec.Selector),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	for _, machineSet := range machineSets.Items {
+		if mcmcontroller.IsMachineSetFrozen(&machineSet) {
+			return true
+		}
+	}
+	return false
+}
+
+// vmsOfClass returns the provider IDs of the VMs of the fake driver created with the machine class.
+func vmsOfClass(machineClassName string) []string {
+	var providerIDs []string
+	for _, vm := range fakeDriver().VMs() {
+		if vm.MachineClassName == machineClassName {
+			providerIDs = append(providerIDs, vm.ProviderID)
+		}
+	}
+	return providerIDs
+}
+
+// fakeDriver returns the driver of the environment.
+func fakeDriver() *FakeDriver {
+	return env.Driver.(*FakeDriver)
+}
+
+func ignoreNotFound(err error) error {
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
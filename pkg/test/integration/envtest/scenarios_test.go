@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("machine-controller-manager", func() {
+	Describe("scaling", func() {
+		It("should create and delete the VMs and nodes of a machine deployment", func() {
+			createMachineClass("scale-v1")
+			machineDeployment := createMachineDeployment("scale", "scale-v1", 1)
+
+			By("scaling up")
+			scaleMachineDeployment(machineDeployment, 3)
+			Eventually(func() []string { return runningMachines(machineDeployment, "scale-v1") }).Should(HaveLen(3))
+			Expect(vmsOfClass("scale-v1")).To(HaveLen(3))
+			for _, machine := range machines(machineDeployment) {
+				node, err := env.CoreClient.CoreV1().Nodes().Get(context.TODO(), machine.Labels[v1alpha1.NodeLabelKey], metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(node.Spec.ProviderID).To(Equal(machine.Spec.ProviderID))
+			}
+
+			By("scaling down")
+			scaleMachineDeployment(machineDeployment, 1)
+			Eventually(func() []v1alpha1.Machine { return machines(machineDeployment) }).Should(HaveLen(1))
+			Eventually(func() []string { return vmsOfClass("scale-v1") }).Should(HaveLen(1))
+			remaining := machines(machineDeployment)[0]
+			Eventually(func() ([]string, error) {
+				nodes, err := env.CoreClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				var names []string
+				for _, node := range nodes.Items {
+					if strings.HasPrefix(node.Spec.ProviderID, ProviderID(env.Namespace, machineDeployment.Name+"-")) {
+						names = append(names, node.Name)
+					}
+				}
+				return names, nil
+			}).Should(ConsistOf(remaining.Labels[v1alpha1.NodeLabelKey]))
+		})
+	})
+
+	Describe("rolling update", func() {
+		It("should replace the machines of the old machine class by ones of the new machine class", func() {
+			createMachineClass("rolling-v1")
+			createMachineClass("rolling-v2")
+			machineDeployment := createMachineDeployment("rolling", "rolling-v1", 2)
+			Eventually(func() []string { return runningMachines(machineDeployment, "rolling-v1") }).Should(HaveLen(2))
+
+			updateMachineDeployment(machineDeployment, func(md *v1alpha1.MachineDeployment) {
+				md.Spec.Template.Spec.Class.Name = "rolling-v2"
+			})
+
+			Eventually(func() []string { return runningMachines(machineDeployment, "rolling-v2") }).Should(HaveLen(2))
+			Eventually(func() []v1alpha1.Machine { return machines(machineDeployment) }).Should(HaveLen(2))
+			Eventually(func() []string { return vmsOfClass("rolling-v1") }).Should(BeEmpty())
+			Expect(vmsOfClass("rolling-v2")).To(HaveLen(2))
+		})
+	})
+
+	Describe("orphan collection", func() {
+		It("should delete VMs without a machine", func() {
+			createMachineClass("orphan-v1")
+			machineDeployment := createMachineDeployment("orphan", "orphan-v1", 1)
+			Eventually(func() []string { return runningMachines(machineDeployment, "orphan-v1") }).Should(HaveLen(1))
+
+			orphan := VM{
+				ProviderID:       ProviderID(env.Namespace, "orphan-vm"),
+				MachineName:      "orphan-vm",
+				MachineClassName: "orphan-v1",
+			}
+			fakeDriver().AddVM(orphan)
+
+			Eventually(func() []string { return vmsOfClass("orphan-v1") }).ShouldNot(ContainElement(orphan.ProviderID))
+			Expect(vmsOfClass("orphan-v1")).To(HaveLen(1))
+		})
+	})
+
+	Describe("freeze", func() {
+		It("should unfreeze a machine deployment frozen with the freeze label once its machines are within the limits", func() {
+			createMachineClass("freeze-v1")
+			machineDeployment := createMachineDeployment("freeze", "freeze-v1", 1)
+			Eventually(func() []string { return runningMachines(machineDeployment, "freeze-v1") }).Should(HaveLen(1))
+
+			By("freezing like mcmctl deployment freeze")
+			machineSets, err := env.ControlMachineClient.MachineSets(env.Namespace).List(context.TODO(), metav1.ListOptions{
+				LabelSelector: metav1.FormatLabelSelector(machineDeployment.Spec.Selector),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			for _, machineSet := range machineSets.Items {
+				Eventually(func() error {
+					ms, err := env.ControlMachineClient.MachineSets(env.Namespace).Get(context.TODO(), machineSet.Name, metav1.GetOptions{})
+					if err != nil {
+						return err
+					}
+					metav1.SetMetaDataLabel(&ms.ObjectMeta, machineutils.FreezeLabel, machineutils.FreezeLabelValue)
+					_, err = env.ControlMachineClient.MachineSets(env.Namespace).Update(context.TODO(), ms, metav1.UpdateOptions{})
+					return err
+				}).Should(Succeed())
+			}
+			updateMachineDeployment(machineDeployment, func(md *v1alpha1.MachineDeployment) {
+				metav1.SetMetaDataLabel(&md.ObjectMeta, machineutils.FreezeLabel, machineutils.FreezeLabelValue)
+			})
+
+			By("waiting for the safety controller to unfreeze")
+			Eventually(func() (map[string]string, error) {
+				md, err := env.ControlMachineClient.MachineDeployments(env.Namespace).Get(context.TODO(), machineDeployment.Name, metav1.GetOptions{})
+				if err != nil {
+					return nil, err
+				}
+				return md.Labels, nil
+			}).ShouldNot(HaveKey(machineutils.FreezeLabel))
+			Eventually(func() bool { return machineSetsFrozen(machineDeployment) }).Should(BeFalse())
+
+			By("scaling up the unfrozen machine deployment")
+			scaleMachineDeployment(machineDeployment, 2)
+			Eventually(func() []string { return runningMachines(machineDeployment, "freeze-v1") }).Should(HaveLen(2))
+		})
+	})
+
+	Describe("overshooting", func() {
+		It("should freeze the machine sets of a machine deployment with too many machines until the surplus is gone", func() {
+			createMachineClass("overshoot-v1")
+			machineDeployment := createMachineDeployment("overshoot", "overshoot-v1", 1)
+			Eventually(func() []string { return runningMachines(machineDeployment, "overshoot-v1") }).Should(HaveLen(1))
+			// A closed maintenance window keeps the machine set from deleting the surplus machines it adopts
+			updateMachineDeployment(machineDeployment, func(md *v1alpha1.MachineDeployment) {
+				md.Spec.MaintenanceWindow = &v1alpha1.MachineDeploymentMaintenanceWindow{
+					Windows: []v1alpha1.MaintenanceWindow{{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}},
+				}
+			})
+
+			By("adding surplus machines up to the replicas, surge and SafetyUp")
+			threshold := machineDeployment.Spec.Replicas + 1 + env.SafetyOptions.SafetyUp
+			var surplus []string
+			for i := int32(1); i < threshold; i++ {
+				machine, err := env.ControlMachineClient.Machines(env.Namespace).Create(context.TODO(), &v1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("overshoot-surplus-%d", i),
+						Namespace: env.Namespace,
+						Labels:    machineDeployment.Spec.Template.Labels,
+					},
+					Spec: machineDeployment.Spec.Template.Spec,
+				}, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				surplus = append(surplus, machine.Name)
+			}
+			Eventually(func() bool { return machineSetsFrozen(machineDeployment) }).Should(BeTrue())
+			Eventually(func() (bool, error) {
+				md, err := env.ControlMachineClient.MachineDeployments(env.Namespace).Get(context.TODO(), machineDeployment.Name, metav1.GetOptions{})
+				if err != nil {
+					return false, err
+				}
+				return md.Spec.Frozen, nil
+			}).Should(BeTrue())
+
+			By("deleting the surplus machines")
+			for _, name := range surplus {
+				Expect(ignoreNotFound(env.ControlMachineClient.Machines(env.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}))).To(Succeed())
+			}
+			Eventually(func() []v1alpha1.Machine { return machines(machineDeployment) }).Should(HaveLen(1))
+			Eventually(func() bool { return machineSetsFrozen(machineDeployment) }).Should(BeFalse())
+		})
+	})
+
+	Describe("API server outage", func() {
+		It("should not replace machines whose nodes were not ready during an API server outage", func() {
+			healthTimeout := env.ProviderSafetyOptions.MachineHealthTimeout.Duration
+			createMachineClass("outage-v1")
+			machineDeployment := createMachineDeployment("outage", "outage-v1", 2)
+			Eventually(func() []string { return runningMachines(machineDeployment, "outage-v1") }).Should(HaveLen(2))
+			names := machineNames(machineDeployment)
+
+			By("losing the nodes")
+			setNodesReady(machineDeployment, corev1.ConditionUnknown)
+			Eventually(func() []v1alpha1.MachinePhase {
+				var phases []v1alpha1.MachinePhase
+				for _, machine := range machines(machineDeployment) {
+					phases = append(phases, machine.Status.CurrentStatus.Phase)
+				}
+				return phases
+			}).Should(HaveEach(v1alpha1.MachineUnknown))
+
+			By("cutting the controllers off the kube-apiserver for longer than the health timeout")
+			env.Chaos.SetAPIServerOutage(true)
+			DeferCleanup(env.Chaos.SetAPIServerOutage, false)
+			time.Sleep(2 * healthTimeout)
+
+			By("ending the outage, after which the nodes become ready again")
+			env.Chaos.SetAPIServerOutage(false)
+			Consistently(func() []string { return machineNames(machineDeployment) }, 3*time.Second).Should(ConsistOf(names))
+			setNodesReady(machineDeployment, corev1.ConditionTrue)
+
+			Consistently(func() []string { return machineNames(machineDeployment) }, 2*healthTimeout).Should(ConsistOf(names))
+			Eventually(func() []string { return runningMachines(machineDeployment, "outage-v1") }).Should(ConsistOf(names))
+		})
+	})
+})
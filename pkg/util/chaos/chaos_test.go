@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chaos_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("chaos", func() {
+	Describe("#Validate", func() {
+		DescribeTable("##table",
+			func(faults chaos.Faults, expectErr bool) {
+				if expectErr {
+					Expect(faults.Validate()).To(HaveOccurred())
+				} else {
+					Expect(faults.Validate()).To(Succeed())
+				}
+			},
+			Entry("should accept no faults", chaos.Faults{}, false),
+			Entry("should accept rates between 0 and 1", chaos.Faults{APIServerErrorRate: 1, ConflictRate: 0.5, Latency: time.Second}, false),
+			Entry("should reject a rate above 1", chaos.Faults{TooManyRequestsRate: 1.5}, true),
+			Entry("should reject a negative rate", chaos.Faults{DriverErrorRate: -0.1}, true),
+			Entry("should reject a negative duration", chaos.Faults{DriverTimeout: -time.Second}, true),
+		)
+	})
+
+	Describe("#WrapConfig", func() {
+		var (
+			server   *httptest.Server
+			injector *chaos.Injector
+			client   kubernetes.Interface
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(&corev1.Node{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+					ObjectMeta: metav1.ObjectMeta{Name: "node-0"},
+				})
+			}))
+			DeferCleanup(server.Close)
+			injector = chaos.NewInjector(chaos.Faults{})
+			var err error
+			client, err = kubernetes.NewForConfig(injector.WrapConfig(&rest.Config{Host: server.URL}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		getNode := func() error {
+			_, err := client.CoreV1().Nodes().Get(context.TODO(), "node-0", metav1.GetOptions{})
+			return err
+		}
+		updateNode := func() error {
+			_, err := client.CoreV1().Nodes().Update(context.TODO(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0"}}, metav1.UpdateOptions{})
+			return err
+		}
+
+		It("should pass requests through without faults", func() {
+			Expect(getNode()).To(Succeed())
+			Expect(updateNode()).To(Succeed())
+		})
+
+		It("should fail requests during an API server outage until it ends", func() {
+			injector.SetAPIServerOutage(true)
+			Expect(getNode()).To(MatchError(chaos.ErrAPIServerOutage))
+
+			injector.SetAPIServerOutage(false)
+			Expect(getNode()).To(Succeed())
+		})
+
+		It("should answer requests with 429 Too Many Requests", func() {
+			injector.SetFaults(chaos.Faults{TooManyRequestsRate: 1})
+			Expect(apierrors.IsTooManyRequests(getNode())).To(BeTrue())
+		})
+
+		It("should answer only updates with 409 Conflict", func() {
+			injector.SetFaults(chaos.Faults{ConflictRate: 1})
+			Expect(getNode()).To(Succeed())
+			Expect(apierrors.IsConflict(updateNode())).To(BeTrue())
+		})
+
+		It("should delay requests", func() {
+			injector.SetFaults(chaos.Faults{LatencyRate: 1, Latency: 100 * time.Millisecond})
+			start := time.Now()
+			Expect(getNode()).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		})
+	})
+
+	Describe("#WrapDriver", func() {
+		request := &driver.GetMachineStatusRequest{Machine: &v1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-0"}}}
+
+		DescribeTable("##table",
+			func(faults chaos.Faults, expectCode codes.Code) {
+				d := chaos.NewInjector(faults).WrapDriver(driver.NewFakeDriver(true, "fake://machine-0", "node-0", "", nil, nil))
+				_, err := d.GetMachineStatus(context.TODO(), request)
+				if expectCode == codes.OK {
+					Expect(err).ToNot(HaveOccurred())
+					return
+				}
+				s, ok := status.FromError(err)
+				Expect(ok).To(BeTrue())
+				Expect(s.Code()).To(Equal(expectCode))
+			},
+			Entry("should pass calls through without faults", chaos.Faults{}, codes.OK),
+			Entry("should time out calls", chaos.Faults{DriverTimeoutRate: 1, DriverTimeout: time.Millisecond}, codes.DeadlineExceeded),
+			Entry("should fail calls", chaos.Faults{DriverErrorRate: 1}, codes.Internal),
+		)
+	})
+})
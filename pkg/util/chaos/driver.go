@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chaos
+
+import (
+	"context"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
+	"k8s.io/klog/v2"
+)
+
+// WrapDriver returns a driver injecting the driver faults into the calls to the given driver.
+func (i *Injector) WrapDriver(d driver.Driver) driver.Driver {
+	return &faultyDriver{injector: i, delegate: d}
+}
+
+// faultyDriver injects the driver faults of the injector into the calls to the delegate.
+type faultyDriver struct {
+	injector *Injector
+	delegate driver.Driver
+}
+
+var _ driver.Driver = &faultyDriver{}
+var _ driver.MachineStopper = &faultyDriver{}
+var _ driver.VolumeDetacher = &faultyDriver{}
+
+func (d *faultyDriver) CreateMachine(ctx context.Context, req *driver.CreateMachineRequest) (*driver.CreateMachineResponse, error) {
+	if err := d.fault(ctx, "CreateMachine"); err != nil {
+		return nil, err
+	}
+	return d.delegate.CreateMachine(ctx, req)
+}
+
+func (d *faultyDriver) InitializeMachine(ctx context.Context, req *driver.InitializeMachineRequest) (*driver.InitializeMachineResponse, error) {
+	if err := d.fault(ctx, "InitializeMachine"); err != nil {
+		return nil, err
+	}
+	return d.delegate.InitializeMachine(ctx, req)
+}
+
+func (d *faultyDriver) DeleteMachine(ctx context.Context, req *driver.DeleteMachineRequest) (*driver.DeleteMachineResponse, error) {
+	if err := d.fault(ctx, "DeleteMachine"); err != nil {
+		return nil, err
+	}
+	return d.delegate.DeleteMachine(ctx, req)
+}
+
+func (d *faultyDriver) GetMachineStatus(ctx context.Context, req *driver.GetMachineStatusRequest) (*driver.GetMachineStatusResponse, error) {
+	if err := d.fault(ctx, "GetMachineStatus"); err != nil {
+		return nil, err
+	}
+	return d.delegate.GetMachineStatus(ctx, req)
+}
+
+func (d *faultyDriver) ListMachines(ctx context.Context, req *driver.ListMachinesRequest) (*driver.ListMachinesResponse, error) {
+	if err := d.fault(ctx, "ListMachines"); err != nil {
+		return nil, err
+	}
+	return d.delegate.ListMachines(ctx, req)
+}
+
+func (d *faultyDriver) GetVolumeIDs(ctx context.Context, req *driver.GetVolumeIDsRequest) (*driver.GetVolumeIDsResponse, error) {
+	if err := d.fault(ctx, "GetVolumeIDs"); err != nil {
+		return nil, err
+	}
+	return d.delegate.GetVolumeIDs(ctx, req)
+}
+
+func (d *faultyDriver) DetachVolumes(ctx context.Context, req *driver.DetachVolumesRequest) (*driver.DetachVolumesResponse, error) {
+	if err := d.fault(ctx, "DetachVolumes"); err != nil {
+		return nil, err
+	}
+	return driver.DetachVolumes(ctx, d.delegate, req)
+}
+
+func (d *faultyDriver) StopMachine(ctx context.Context, req *driver.StopMachineRequest) (*driver.StopMachineResponse, error) {
+	if err := d.fault(ctx, "StopMachine"); err != nil {
+		return nil, err
+	}
+	return driver.StopMachine(ctx, d.delegate, req)
+}
+
+func (d *faultyDriver) StartMachine(ctx context.Context, req *driver.StartMachineRequest) (*driver.StartMachineResponse, error) {
+	if err := d.fault(ctx, "StartMachine"); err != nil {
+		return nil, err
+	}
+	return driver.StartMachine(ctx, d.delegate, req)
+}
+
+// fault returns the error of an injected timeout or error of the call, or nil if no fault is injected.
+func (d *faultyDriver) fault(ctx context.Context, call string) error {
+	faults := d.injector.Faults()
+	if roll(faults.DriverTimeoutRate) {
+		klog.V(4).Infof("chaos: timing out driver call %s after %v", call, faults.DriverTimeout)
+		if err := sleep(ctx, faults.DriverTimeout); err != nil {
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		return status.Error(codes.DeadlineExceeded, "chaos: injected timeout of driver call "+call)
+	}
+	if roll(faults.DriverErrorRate) {
+		klog.V(4).Infof("chaos: failing driver call %s", call)
+		return status.Error(codes.Internal, "chaos: injected error of driver call "+call)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chaos injects faults into the requests of the controllers to the API servers and into the calls to the
+// driver, to exercise the safety controllers in tests and, through the chaos flags, in test landscapes.
+package chaos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Faults are the faults to inject. Rates are fractions between 0 and 1 of the requests or calls to inject the fault
+// into.
+type Faults struct {
+	// APIServerOutage fails all requests to the API servers as if they were unreachable.
+	APIServerOutage bool
+	// APIServerErrorRate is the rate of requests to the API servers failing as if they were unreachable.
+	APIServerErrorRate float64
+	// TooManyRequestsRate is the rate of requests to the API servers answered with 429 Too Many Requests.
+	TooManyRequestsRate float64
+	// ConflictRate is the rate of update and patch requests to the API servers answered with 409 Conflict.
+	ConflictRate float64
+	// LatencyRate is the rate of requests to the API servers delayed by Latency.
+	LatencyRate float64
+	// Latency is the delay added to requests to the API servers.
+	Latency time.Duration
+	// DriverTimeoutRate is the rate of driver calls failing with codes.DeadlineExceeded after DriverTimeout.
+	DriverTimeoutRate float64
+	// DriverTimeout is the time a driver call takes before it times out.
+	DriverTimeout time.Duration
+	// DriverErrorRate is the rate of driver calls failing with codes.Internal.
+	DriverErrorRate float64
+}
+
+// AddFlags adds the flags injecting faults into the requests to the API servers to the flag set.
+func (f *Faults) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&f.APIServerOutage, "chaos-apiserver-outage", f.APIServerOutage, "Debug only: fail all requests of the controllers to the API servers as if they were unreachable.")
+	fs.Float64Var(&f.APIServerErrorRate, "chaos-apiserver-error-rate", f.APIServerErrorRate, "Debug only: rate between 0 and 1 of the requests of the controllers to the API servers failing as if they were unreachable.")
+	fs.Float64Var(&f.TooManyRequestsRate, "chaos-too-many-requests-rate", f.TooManyRequestsRate, "Debug only: rate between 0 and 1 of the requests of the controllers to the API servers answered with 429 Too Many Requests.")
+	fs.Float64Var(&f.ConflictRate, "chaos-conflict-rate", f.ConflictRate, "Debug only: rate between 0 and 1 of the update and patch requests of the controllers to the API servers answered with 409 Conflict.")
+	fs.Float64Var(&f.LatencyRate, "chaos-latency-rate", f.LatencyRate, "Debug only: rate between 0 and 1 of the requests of the controllers to the API servers delayed by --chaos-latency.")
+	fs.DurationVar(&f.Latency, "chaos-latency", f.Latency, "Debug only: delay added to the requests of the controllers to the API servers selected by --chaos-latency-rate.")
+}
+
+// AddDriverFlags adds the flags injecting faults into the calls to the driver to the flag set.
+func (f *Faults) AddDriverFlags(fs *pflag.FlagSet) {
+	fs.Float64Var(&f.DriverTimeoutRate, "chaos-driver-timeout-rate", f.DriverTimeoutRate, "Debug only: rate between 0 and 1 of the driver calls timing out after --chaos-driver-timeout.")
+	fs.DurationVar(&f.DriverTimeout, "chaos-driver-timeout", f.DriverTimeout, "Debug only: time a driver call selected by --chaos-driver-timeout-rate takes before it times out.")
+	fs.Float64Var(&f.DriverErrorRate, "chaos-driver-error-rate", f.DriverErrorRate, "Debug only: rate between 0 and 1 of the driver calls failing with an internal error.")
+}
+
+// Enabled returns true if any fault is injected.
+func (f Faults) Enabled() bool {
+	return f != Faults{}
+}
+
+// Validate returns an error if a rate is not between 0 and 1 or a duration is negative.
+func (f Faults) Validate() error {
+	var errs []error
+	for name, rate := range map[string]float64{
+		"chaos-apiserver-error-rate":   f.APIServerErrorRate,
+		"chaos-too-many-requests-rate": f.TooManyRequestsRate,
+		"chaos-conflict-rate":          f.ConflictRate,
+		"chaos-latency-rate":           f.LatencyRate,
+		"chaos-driver-timeout-rate":    f.DriverTimeoutRate,
+		"chaos-driver-error-rate":      f.DriverErrorRate,
+	} {
+		if rate < 0 || rate > 1 {
+			errs = append(errs, fmt.Errorf("%s must be between 0 and 1: %v", name, rate))
+		}
+	}
+	for name, duration := range map[string]time.Duration{
+		"chaos-latency":        f.Latency,
+		"chaos-driver-timeout": f.DriverTimeout,
+	} {
+		if duration < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative: %v", name, duration))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
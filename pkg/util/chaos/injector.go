@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chaos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// ErrAPIServerOutage is the error of requests failed by an injected API server outage.
+var ErrAPIServerOutage = errors.New("chaos: injected API server outage")
+
+// Injector injects faults into the requests of rest clients and the calls to drivers wrapped by it. The faults can be
+// changed while the clients and drivers are in use.
+type Injector struct {
+	mutex  sync.RWMutex
+	faults Faults
+}
+
+// NewInjector returns an Injector injecting the faults.
+func NewInjector(faults Faults) *Injector {
+	return &Injector{faults: faults}
+}
+
+// Faults returns the injected faults.
+func (i *Injector) Faults() Faults {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.faults
+}
+
+// SetFaults replaces the injected faults.
+func (i *Injector) SetFaults(faults Faults) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.faults = faults
+}
+
+// SetAPIServerOutage starts or ends an API server outage, keeping the other faults.
+func (i *Injector) SetAPIServerOutage(outage bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.faults.APIServerOutage = outage
+}
+
+// WrapConfig returns a copy of the config whose clients inject the API server faults into their requests.
+func (i *Injector) WrapConfig(config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &roundTripper{injector: i, delegate: rt}
+	})
+	return config
+}
+
+// roundTripper injects the API server faults of the injector into the requests sent through the delegate.
+type roundTripper struct {
+	injector *Injector
+	delegate http.RoundTripper
+}
+
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	faults := rt.injector.Faults()
+
+	// A round tripper has to close the body of the request also if it does not send it.
+	closeBody := func() {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+	}
+	if faults.Latency > 0 && roll(faults.LatencyRate) {
+		klog.V(4).Infof("chaos: delaying %s %s by %v", req.Method, req.URL.Path, faults.Latency)
+		if err := sleep(req.Context(), faults.Latency); err != nil {
+			closeBody()
+			return nil, err
+		}
+	}
+	if faults.APIServerOutage || roll(faults.APIServerErrorRate) {
+		klog.V(4).Infof("chaos: failing %s %s with an API server outage", req.Method, req.URL.Path)
+		closeBody()
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrAPIServerOutage)
+	}
+	if roll(faults.TooManyRequestsRate) {
+		klog.V(4).Infof("chaos: answering %s %s with 429 Too Many Requests", req.Method, req.URL.Path)
+		closeBody()
+		return statusResponse(req, apierrors.NewTooManyRequests("chaos: injected too many requests", 0))
+	}
+	if (req.Method == http.MethodPut || req.Method == http.MethodPatch) && roll(faults.ConflictRate) {
+		klog.V(4).Infof("chaos: answering %s %s with 409 Conflict", req.Method, req.URL.Path)
+		closeBody()
+		return statusResponse(req, apierrors.NewConflict(schema.GroupResource{}, req.URL.Path, errors.New("chaos: injected conflict")))
+	}
+	return rt.delegate.RoundTrip(req)
+}
+
+// statusResponse returns a response carrying the status of the error, as the API server would send it.
+func statusResponse(req *http.Request, err *apierrors.StatusError) (*http.Response, error) {
+	status := err.Status()
+	status.Kind = "Status"
+	status.APIVersion = "v1"
+	body, marshalErr := json.Marshal(status)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status.Code, http.StatusText(int(status.Code))),
+		StatusCode:    int(status.Code),
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// roll returns true with the probability rate.
+func roll(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate // #nosec G404 (CWE-338) -- only used to pick the requests to inject faults into
+}
+
+// sleep sleeps for the duration or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
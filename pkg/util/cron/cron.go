@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cron is used to parse and evaluate standard 5-field cron expressions
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLookAheadYears bounds the search performed by Next. Every valid schedule
+// matches at least once within this period, including the 29th of February.
+const maxLookAheadYears = 5
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Schedule is a parsed cron expression of the form
+// "<minute> <hour> <day of month> <month> <day of week>".
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day fields were unrestricted,
+	// since a day matches if either restricted day field matches.
+	domStar, dowStar bool
+}
+
+// Parse parses a standard 5-field cron expression. Each field supports
+// '*', single values, ranges ('a-b'), steps ('*/n', 'a-b/n') and comma separated lists.
+func Parse(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("expected %d fields in cron expression %q, found %d", len(fields), spec, len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", spec, err)
+		}
+		bits[i] = b
+	}
+
+	// Sunday may be written as 0 or 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	schedule := &Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: strings.HasPrefix(parts[2], "*"),
+		dowStar: strings.HasPrefix(parts[4], "*"),
+	}
+	if !schedule.occurs() {
+		return nil, fmt.Errorf("invalid cron expression %q: the days of month never occur in the months", spec)
+	}
+	return schedule, nil
+}
+
+// Matches returns true if the minute containing t is selected by the schedule.
+func (s *Schedule) Matches(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 ||
+		s.hour&(1<<uint(t.Hour())) == 0 ||
+		s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	return s.matchesDay(t)
+}
+
+// matchesDay returns true if the day containing t is selected by the day fields of the schedule.
+func (s *Schedule) matchesDay(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next returns the first minute strictly after t which is selected by the schedule.
+// The search skips months, days and hours which are not selected as a whole.
+// The zero time is returned if no such minute exists within five years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := next.Year() + maxLookAheadYears
+
+wrap:
+	if next.Year() > yearLimit {
+		return time.Time{}
+	}
+	for s.month&(1<<uint(next.Month())) == 0 {
+		next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc)
+		if next.Month() == time.January {
+			goto wrap
+		}
+	}
+	for !s.matchesDay(next) {
+		next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc)
+		if next.Day() == 1 {
+			goto wrap
+		}
+	}
+	for s.hour&(1<<uint(next.Hour())) == 0 {
+		next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, loc)
+		if next.Hour() == 0 {
+			goto wrap
+		}
+	}
+	for s.minute&(1<<uint(next.Minute())) == 0 {
+		next = next.Add(time.Minute)
+		if next.Minute() == 0 {
+			goto wrap
+		}
+	}
+	return next
+}
+
+// daysInMonth is the maximum number of days of each month.
+var daysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// occurs returns true if the schedule selects a day of month which exists in one of its months.
+func (s *Schedule) occurs() bool {
+	if s.domStar || !s.dowStar {
+		// Every week has the selected days of week
+		return true
+	}
+	for month := 1; month <= 12; month++ {
+		if s.month&(1<<uint(month)) != 0 && s.dom&(1<<uint(daysInMonth[month]+1)-1) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		b, err := parseItem(item, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseItem(item string, f field) (uint64, error) {
+	rangeSpec, stepSpec, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepSpec)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepSpec, f.name)
+		}
+	}
+
+	start, end := f.min, f.max
+	switch {
+	case rangeSpec == "*":
+	case strings.Contains(rangeSpec, "-"):
+		low, high, _ := strings.Cut(rangeSpec, "-")
+		var err error
+		if start, err = parseValue(low, f); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(high, f); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangeSpec, f.name)
+		}
+	default:
+		var err error
+		if start, err = parseValue(rangeSpec, f); err != nil {
+			return 0, err
+		}
+		if !hasStep {
+			end = start
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] in %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
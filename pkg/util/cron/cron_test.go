@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cron", func() {
+	// 2024-01-01 is a Monday
+	monday := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	Describe("#Parse", func() {
+		DescribeTable("##parsing scenarios",
+			func(spec string, expectErr bool) {
+				_, err := Parse(spec)
+				if expectErr {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			},
+			Entry("every minute", "* * * * *", false),
+			Entry("lists, ranges and steps", "0,30 1-5/2 */10 1-12 0-7", false),
+			Entry("too few fields", "* * * *", true),
+			Entry("value out of range", "60 * * * *", true),
+			Entry("inverted range", "* 5-1 * * *", true),
+			Entry("invalid step", "*/0 * * * *", true),
+			Entry("non-numeric value", "* * * JAN *", true),
+			Entry("day of month never occurring in the month", "0 0 30 2 *", true),
+			Entry("day of month never occurring in the months", "0 0 31 4,6,9,11 *", true),
+			Entry("leap day", "0 0 29 2 *", false),
+			Entry("day of month never occurring with day of week", "0 0 30 2 1", false),
+		)
+	})
+
+	Describe("#Matches", func() {
+		DescribeTable("##matching scenarios",
+			func(spec string, t time.Time, expected bool) {
+				s, err := Parse(spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s.Matches(t)).To(Equal(expected))
+			},
+			Entry("every minute matches", "* * * * *", monday.Add(17*time.Minute), true),
+			Entry("hour matches", "0 2 * * *", monday.Add(2*time.Hour), true),
+			Entry("hour does not match", "0 2 * * *", monday.Add(3*time.Hour), false),
+			Entry("day of week matches", "0 0 * * 1", monday, true),
+			Entry("day of week does not match", "0 0 * * 2", monday, false),
+			Entry("sunday as 7 matches", "0 0 * * 7", monday.AddDate(0, 0, 6), true),
+			Entry("either restricted day field matches", "0 0 15 * 1", monday, true),
+			Entry("step matches", "*/15 * * * *", monday.Add(45*time.Minute), true),
+			Entry("step does not match", "*/15 * * * *", monday.Add(50*time.Minute), false),
+		)
+	})
+
+	Describe("#Next", func() {
+		DescribeTable("##next scenarios",
+			func(spec string, t time.Time, expected time.Time) {
+				s, err := Parse(spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s.Next(t)).To(Equal(expected))
+			},
+			Entry("next minute", "* * * * *", monday.Add(30*time.Second), monday.Add(time.Minute)),
+			Entry("later the same day", "30 22 * * *", monday, monday.Add(22*time.Hour+30*time.Minute)),
+			Entry("next week", "0 0 * * 1", monday, monday.AddDate(0, 0, 7)),
+			Entry("next month", "15 3 1 * *", monday.Add(4*time.Hour), time.Date(monday.Year(), monday.Month()+1, 1, 3, 15, 0, 0, time.UTC)),
+			Entry("next year", "0 0 1 1 *", monday, time.Date(monday.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("next leap day", "0 0 29 2 *", time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+			Entry("last minute of the year", "59 23 31 12 *", time.Date(2024, time.December, 31, 23, 58, 0, 0, time.UTC), time.Date(2024, time.December, 31, 23, 59, 0, 0, time.UTC)),
+		)
+	})
+})
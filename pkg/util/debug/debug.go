@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package debug serves the /debug/mcm endpoint, which shows the internal state of the running controllers, like their
+// work queues, to find out why a controller is stuck.
+package debug
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// Path is the path of the debug endpoint.
+const Path = "/debug/mcm"
+
+var (
+	controllersGuard sync.RWMutex
+	controllers      = map[string]func() interface{}{}
+)
+
+// Register registers the function returning the state of the controller with the given name, replacing the function
+// registered before under that name.
+func Register(name string, state func() interface{}) {
+	controllersGuard.Lock()
+	defer controllersGuard.Unlock()
+	controllers[name] = state
+}
+
+// Unregister removes the controller with the given name from the debug endpoint.
+func Unregister(name string) {
+	controllersGuard.Lock()
+	defer controllersGuard.Unlock()
+	delete(controllers, name)
+}
+
+type mux interface {
+	Handle(string, http.Handler)
+}
+
+// InstallHandler installs the handler of the debug endpoint. Requests have to present the token as bearer token.
+func InstallHandler(m mux, token string) {
+	m.Handle(Path, NewHandler(token))
+}
+
+// NewHandler returns the handler of the debug endpoint, which responds with the states of the registered controllers
+// as JSON to requests presenting the token as bearer token.
+func NewHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !authenticated(r, token) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="mcm"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		controllersGuard.RLock()
+		states := make(map[string]interface{}, len(controllers))
+		for name, state := range controllers {
+			states[name] = state()
+		}
+		controllersGuard.RUnlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(states); err != nil {
+			klog.Errorf("Failed to write the response of the debug endpoint: %v", err)
+		}
+	})
+}
+
+// ReadTokenFile reads the token of the debug endpoint from the file, ignoring surrounding whitespace.
+func ReadTokenFile(file string) (string, error) {
+	data, err := os.ReadFile(file) // #nosec G304 -- the file is configured by the operator
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", file)
+	}
+	return token, nil
+}
+
+func authenticated(r *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(presented), []byte(token)) == 1
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("debug", func() {
+	Describe("#NewHandler", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			handler = debug.NewHandler("secret")
+			debug.Register("test", func() interface{} { return map[string]int{"depth": 1} })
+		})
+
+		AfterEach(func() {
+			debug.Unregister("test")
+		})
+
+		request := func(method, authorization string) *httptest.ResponseRecorder {
+			r := httptest.NewRequest(method, debug.Path, nil)
+			if authorization != "" {
+				r.Header.Set("Authorization", authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			return w
+		}
+
+		It("should respond with the states of the registered controllers", func() {
+			w := request(http.MethodGet, "Bearer secret")
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var states map[string]map[string]int
+			Expect(json.Unmarshal(w.Body.Bytes(), &states)).To(Succeed())
+			Expect(states).To(HaveKeyWithValue("test", map[string]int{"depth": 1}))
+		})
+
+		It("should reject requests without the token", func() {
+			Expect(request(http.MethodGet, "").Code).To(Equal(http.StatusUnauthorized))
+			Expect(request(http.MethodGet, "Bearer wrong").Code).To(Equal(http.StatusUnauthorized))
+			Expect(request(http.MethodGet, "Basic secret").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should reject all requests if no token is configured", func() {
+			handler = debug.NewHandler("")
+			Expect(request(http.MethodGet, "Bearer ").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should only allow GET requests", func() {
+			Expect(request(http.MethodPost, "Bearer secret").Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+
+	Describe("#ReadTokenFile", func() {
+		It("should read the token without surrounding whitespace", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(file, []byte("secret\n"), 0600)).To(Succeed())
+			Expect(debug.ReadTokenFile(file)).To(Equal("secret"))
+		})
+
+		It("should fail if the token is empty", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(file, []byte("\n"), 0600)).To(Succeed())
+			_, err := debug.ReadTokenFile(file)
+			Expect(err).To(MatchError(ContainSubstring("is empty")))
+		})
+	})
+
+	Describe("ServerOptions", func() {
+		DescribeTable("#Validate", func(options debug.ServerOptions, errorSubstrings ...string) {
+			err := options.Validate()
+			if len(errorSubstrings) == 0 {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			for _, substring := range errorSubstrings {
+				Expect(err).To(MatchError(ContainSubstring(substring)))
+			}
+		},
+			Entry("should allow the disabled server", debug.ServerOptions{}),
+			Entry("should allow the server with certificate and token", debug.ServerOptions{Port: 10260, CertDir: "/certs", TokenFile: "/token"}),
+			Entry("should reject a negative port", debug.ServerOptions{Port: -1}, "debug-port must not be negative"),
+			Entry("should require the certificate and the token if the server is enabled", debug.ServerOptions{Port: 10260}, "debug-cert-dir is required", "debug-token-file is required"),
+		)
+
+		It("should not serve without a token", func() {
+			err := debug.ListenAndServeTLS("127.0.0.1", debug.ServerOptions{Port: 10260, CertDir: GinkgoT().TempDir(), TokenFile: filepath.Join(GinkgoT().TempDir(), "missing")})
+			Expect(err).To(MatchError(ContainSubstring("failed to read the token of the debug endpoint")))
+		})
+	})
+})
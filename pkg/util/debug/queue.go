@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// QueueState is the state of a work queue.
+type QueueState struct {
+	// Name is the name of the queue.
+	Name string `json:"name"`
+	// Depth is the number of items waiting to be processed.
+	Depth int `json:"depth"`
+	// Delayed is the number of items added with a delay which did not pass yet.
+	Delayed int `json:"delayed"`
+	// Oldest is the item which waits the longest to be processed, if any.
+	Oldest *QueuedItem `json:"oldest,omitempty"`
+	// Retries are the items being retried after their reconciliation failed.
+	Retries []RetriedItem `json:"retries,omitempty"`
+}
+
+// QueuedItem is an item waiting in a work queue.
+type QueuedItem struct {
+	// Key is the key of the item.
+	Key string `json:"key"`
+	// Age is the time since the item is ready to be processed.
+	Age metav1.Duration `json:"age"`
+}
+
+// RetriedItem is an item which is being retried.
+type RetriedItem struct {
+	// Key is the key of the item.
+	Key string `json:"key"`
+	// Requeues is the number of times the item was requeued since its last successful reconciliation.
+	Requeues int `json:"requeues"`
+}
+
+// Queue is a rate limiting work queue which keeps track of the time its items wait and of the items being retried,
+// so that they can be shown on the debug endpoint.
+type Queue struct {
+	workqueue.DelayingInterface
+
+	name        string
+	rateLimiter workqueue.RateLimiter
+
+	mutex sync.Mutex
+	// queued are the times since which the items in the queue wait to be processed.
+	queued map[interface{}]time.Time
+	// delayed are the times at which the items added with a delay are added to the queue.
+	delayed map[interface{}]time.Time
+	retries map[interface{}]struct{}
+}
+
+var _ workqueue.RateLimitingInterface = &Queue{}
+
+// trackingQueue is the queue underneath the delaying queue. It records when items are actually added to the queue,
+// which for items added with a delay is only once their delay passed, and only once if the item is already queued.
+type trackingQueue struct {
+	workqueue.Interface
+	queue *Queue
+}
+
+// Add marks the item as needing processing.
+func (t *trackingQueue) Add(item interface{}) {
+	t.queue.markQueued(item)
+	t.Interface.Add(item)
+}
+
+// NewNamedRateLimitingQueue returns a rate limiting work queue with the given name, like
+// workqueue.NewNamedRateLimitingQueue does.
+func NewNamedRateLimitingQueue(rateLimiter workqueue.RateLimiter, name string) *Queue {
+	q := &Queue{
+		name:        name,
+		rateLimiter: rateLimiter,
+		queued:      make(map[interface{}]time.Time),
+		delayed:     make(map[interface{}]time.Time),
+		retries:     make(map[interface{}]struct{}),
+	}
+	q.DelayingInterface = workqueue.NewDelayingQueueWithConfig(workqueue.DelayingQueueConfig{
+		Name:  name,
+		Queue: &trackingQueue{Interface: workqueue.NewWithConfig(workqueue.QueueConfig{Name: name}), queue: q},
+	})
+	return q
+}
+
+// AddAfter adds the item to the queue after the given duration passed.
+func (q *Queue) AddAfter(item interface{}, duration time.Duration) {
+	if duration > 0 {
+		ready := time.Now().Add(duration)
+		q.mutex.Lock()
+		// The delaying queue only adds the item once, at the earliest time it was added for.
+		if previous, ok := q.delayed[item]; !ok || ready.Before(previous) {
+			q.delayed[item] = ready
+		}
+		q.mutex.Unlock()
+	}
+	q.DelayingInterface.AddAfter(item, duration)
+}
+
+// AddRateLimited adds the item to the queue after the rate limiter says it's ok.
+func (q *Queue) AddRateLimited(item interface{}) {
+	q.mutex.Lock()
+	q.retries[item] = struct{}{}
+	q.mutex.Unlock()
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Forget indicates that the item is finished being retried.
+func (q *Queue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+	q.mutex.Lock()
+	delete(q.retries, item)
+	q.mutex.Unlock()
+}
+
+// NumRequeues returns how many times the item was requeued.
+func (q *Queue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+// Get blocks until it can return an item to be processed.
+func (q *Queue) Get() (interface{}, bool) {
+	item, shutdown := q.DelayingInterface.Get()
+	if shutdown {
+		return item, shutdown
+	}
+	q.mutex.Lock()
+	delete(q.queued, item)
+	q.mutex.Unlock()
+	return item, shutdown
+}
+
+// markQueued records that the item is added to the queue, unless it is already waiting in it.
+func (q *Queue) markQueued(item interface{}) {
+	now := time.Now()
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if _, ok := q.queued[item]; !ok {
+		q.queued[item] = now
+	}
+	// An item added before its delay passed is still added again by the delaying queue later on.
+	if ready, ok := q.delayed[item]; ok && !ready.After(now) {
+		delete(q.delayed, item)
+	}
+}
+
+// State returns the state of the queue.
+func (q *Queue) State() QueueState {
+	state := QueueState{Name: q.name, Depth: q.Len()}
+	now := time.Now()
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	state.Delayed = len(q.delayed)
+	var oldest time.Time
+	for item, since := range q.queued {
+		if state.Oldest == nil || since.Before(oldest) {
+			oldest = since
+			state.Oldest = &QueuedItem{Key: fmt.Sprint(item), Age: metav1.Duration{Duration: now.Sub(since)}}
+		}
+	}
+	for item := range q.retries {
+		state.Retries = append(state.Retries, RetriedItem{Key: fmt.Sprint(item), Requeues: q.rateLimiter.NumRequeues(item)})
+	}
+	sort.Slice(state.Retries, func(i, j int) bool { return state.Retries[i].Key < state.Retries[j].Key })
+	return state
+}
+
+// QueueStateOf returns the state of the queue. Only the depth is known of queues which aren't a Queue.
+func QueueStateOf(queue workqueue.RateLimitingInterface, name string) QueueState {
+	if q, ok := queue.(*Queue); ok {
+		return q.State()
+	}
+	return QueueState{Name: name, Depth: queue.Len()}
+}
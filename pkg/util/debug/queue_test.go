@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug_test
+
+import (
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ = Describe("queue", func() {
+	var queue *debug.Queue
+
+	BeforeEach(func() {
+		queue = debug.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Hour, time.Hour), "test")
+	})
+
+	AfterEach(func() {
+		queue.ShutDown()
+	})
+
+	Describe("#State", func() {
+		It("should show the depth and the item waiting the longest", func() {
+			queue.Add("a")
+			time.Sleep(10 * time.Millisecond)
+			queue.Add("b")
+			queue.Add("a")
+
+			state := queue.State()
+			Expect(state.Name).To(Equal("test"))
+			Expect(state.Depth).To(Equal(2))
+			Expect(state.Oldest).ToNot(BeNil())
+			Expect(state.Oldest.Key).To(Equal("a"))
+			Expect(state.Oldest.Age.Duration).To(BeNumerically(">=", 10*time.Millisecond))
+		})
+
+		It("should forget the items once they are processed", func() {
+			queue.Add("a")
+			item, _ := queue.Get()
+			queue.Done(item)
+
+			state := queue.State()
+			Expect(state.Depth).To(BeZero())
+			Expect(state.Oldest).To(BeNil())
+		})
+
+		It("should count the items added with a delay which did not pass yet", func() {
+			queue.AddAfter("a", time.Hour)
+
+			state := queue.State()
+			Expect(state.Depth).To(BeZero())
+			Expect(state.Delayed).To(Equal(1))
+			Expect(state.Oldest).To(BeNil())
+		})
+
+		It("should count an item added with several delays once", func() {
+			queue.AddAfter("a", time.Hour)
+			queue.AddAfter("a", 2*time.Hour)
+
+			Expect(queue.State().Delayed).To(Equal(1))
+		})
+
+		It("should show an item once its delay passed, even if it was processed in the meantime", func() {
+			queue.AddAfter("a", 50*time.Millisecond)
+			queue.Add("a")
+			item, _ := queue.Get()
+			queue.Done(item)
+			Expect(queue.State().Delayed).To(Equal(1))
+
+			Eventually(func() int { return queue.Len() }).Should(Equal(1))
+			state := queue.State()
+			Expect(state.Delayed).To(BeZero())
+			Expect(state.Oldest).ToNot(BeNil())
+			Expect(state.Oldest.Key).To(Equal("a"))
+		})
+
+		It("should show the items being retried until they are forgotten", func() {
+			queue.AddRateLimited("a")
+			queue.AddRateLimited("a")
+			queue.AddRateLimited("b")
+
+			state := queue.State()
+			Expect(state.Retries).To(Equal([]debug.RetriedItem{{Key: "a", Requeues: 2}, {Key: "b", Requeues: 1}}))
+			Expect(queue.NumRequeues("a")).To(Equal(2))
+
+			queue.Forget("a")
+			Expect(queue.State().Retries).To(Equal([]debug.RetriedItem{{Key: "b", Requeues: 1}}))
+			Expect(queue.NumRequeues("a")).To(BeZero())
+		})
+	})
+
+	Describe("#QueueStateOf", func() {
+		It("should only show the depth of other queues", func() {
+			other := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "other")
+			defer other.ShutDown()
+			other.Add("a")
+
+			Expect(debug.QueueStateOf(other, "other")).To(Equal(debug.QueueState{Name: "other", Depth: 1}))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ServerOptions configure the server of the debug endpoint. The endpoint exposes the internal state of the
+// controllers, hence it is only served over TLS on its own port.
+type ServerOptions struct {
+	// Port is the port the debug server serves on. The debug server is disabled if it is zero.
+	Port int32
+	// CertDir is the directory containing the serving certificate tls.crt and its key tls.key.
+	CertDir string
+	// TokenFile is the path to a file containing the bearer token which authenticates the requests.
+	TokenFile string
+}
+
+// AddFlags adds the flags of the debug server to the flag set.
+func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.Int32Var(&o.Port, "debug-port", o.Port, "The port the TLS server of the "+Path+" endpoint showing the internal state of the controllers serves on. The endpoint is disabled if it is zero.")
+	fs.StringVar(&o.CertDir, "debug-cert-dir", o.CertDir, "The directory containing the serving certificate tls.crt and its key tls.key of the "+Path+" endpoint.")
+	fs.StringVar(&o.TokenFile, "debug-token-file", o.TokenFile, "Path to a file containing the bearer token which authenticates the requests to the "+Path+" endpoint.")
+}
+
+// Enabled returns true if the debug server is to be started.
+func (o ServerOptions) Enabled() bool {
+	return o.Port > 0
+}
+
+// Validate returns an error if the port is negative, or if the certificate or the token is missing while the debug
+// server is enabled.
+func (o ServerOptions) Validate() error {
+	var errs []error
+	if o.Port < 0 {
+		errs = append(errs, fmt.Errorf("debug-port must not be negative: %d", o.Port))
+	}
+	if o.Enabled() && o.CertDir == "" {
+		errs = append(errs, fmt.Errorf("debug-cert-dir is required if the debug server is enabled"))
+	}
+	if o.Enabled() && o.TokenFile == "" {
+		errs = append(errs, fmt.Errorf("debug-token-file is required if the debug server is enabled"))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// ListenAndServeTLS serves the debug endpoint over TLS on the given address and the port of the options.
+func ListenAndServeTLS(address string, options ServerOptions) error {
+	token, err := ReadTokenFile(options.TokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the token of the debug endpoint: %w", err)
+	}
+	mux := http.NewServeMux()
+	InstallHandler(mux, token)
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort(address, strconv.Itoa(int(options.Port))),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig:         &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	return server.ListenAndServeTLS(filepath.Join(options.CertDir, "tls.crt"), filepath.Join(options.CertDir, "tls.key"))
+}
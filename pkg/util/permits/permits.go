@@ -7,6 +7,7 @@
 package permits
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -19,9 +20,20 @@ type PermitGiver interface {
 	TryPermit(key string, timeout time.Duration) bool
 	ReleasePermit(key string)
 	DeletePermits(key string)
+	Permits() []Permit
 	Close()
 }
 
+// Permit is the state of the permits registered for a key
+type Permit struct {
+	// Key is the key the permits are registered for.
+	Key string `json:"key"`
+	// Permits is the number of permits registered for the key.
+	Permits int `json:"permits"`
+	// Acquired is the number of permits currently acquired.
+	Acquired int `json:"acquired"`
+}
+
 type permit struct {
 	lastAcquiredPermitTime time.Time
 	c                      chan struct{}
@@ -125,6 +137,18 @@ func (pg *permitGiver) ReleasePermit(key string) {
 	}
 }
 
+// Permits returns the state of the registered permits, sorted by their keys.
+func (pg *permitGiver) Permits() []Permit {
+	var permits []Permit
+	pg.keyPermitsMap.Range(func(key, value interface{}) bool {
+		p := value.(permit)
+		permits = append(permits, Permit{Key: key.(string), Permits: cap(p.c), Acquired: len(p.c)})
+		return true
+	})
+	sort.Slice(permits, func(i, j int) bool { return permits[i].Key < permits[j].Key })
+	return permits
+}
+
 func (pg *permitGiver) isClosed() bool {
 	select {
 	case <-pg.stopC:
@@ -125,6 +125,24 @@ var _ = Describe("permit", func() {
 		})
 	})
 
+	Describe("#Permits", func() {
+		BeforeEach(func() {
+			pg = NewPermitGiver(5*time.Second, 1*time.Second).(*permitGiver)
+			pg.RegisterPermits(key2, 2)
+			pg.RegisterPermits(key1, 1)
+		})
+		AfterEach(func() {
+			pg.Close()
+		})
+		It("should return the registered and acquired permits sorted by key", func() {
+			Expect(pg.TryPermit(key2, 1*time.Second)).To(BeTrue())
+			Expect(pg.Permits()).To(Equal([]Permit{
+				{Key: key1, Permits: 1, Acquired: 0},
+				{Key: key2, Permits: 2, Acquired: 1},
+			}))
+		})
+	})
+
 	Describe("#isClose", func() {
 		BeforeEach(func() {
 			pg = NewPermitGiver(5*time.Second, 1*time.Second).(*permitGiver)
@@ -35,6 +35,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	machineinformers "github.com/gardener/machine-controller-manager/pkg/client/informers/externalversions"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
 	coreclientbuilder "github.com/gardener/machine-controller-manager/pkg/util/clientbuilder/core"
 	machineclientbuilder "github.com/gardener/machine-controller-manager/pkg/util/clientbuilder/machine"
 	machinecontroller "github.com/gardener/machine-controller-manager/pkg/util/provider/machinecontroller"
@@ -43,6 +44,7 @@ import (
 
 	"github.com/gardener/machine-controller-manager/pkg/handlers"
 	"github.com/gardener/machine-controller-manager/pkg/util/configz"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/app/options"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
 	prometheus "github.com/prometheus/client_golang/prometheus/promhttp"
@@ -120,31 +122,48 @@ func Run(s *options.MCServer, driver driver.Driver) error {
 	klog.V(4).Info("Starting http server and mux")
 	go startHTTP(s)
 
+	if s.DebugServer.Enabled() {
+		klog.V(4).Infof("Starting debug server on port %d", s.DebugServer.Port)
+		go startDebugServer(s)
+	}
+
 	recorder := createRecorder(kubeClientControl)
 
+	// If faults are to be injected, only the clients of the controllers inject them, so that the faults do not end the
+	// leader election.
+	controllerControlKubeconfig, controllerTargetKubeconfig := controlkubeconfig, targetkubeconfig
+	controllerDriver := driver
+	if s.Chaos.Enabled() {
+		klog.Warningf("Injecting faults into the requests of the controllers to the API servers and the driver: %+v", s.Chaos)
+		injector := chaos.NewInjector(s.Chaos)
+		controllerControlKubeconfig = injector.WrapConfig(controlkubeconfig)
+		controllerTargetKubeconfig = injector.WrapConfig(targetkubeconfig)
+		controllerDriver = injector.WrapDriver(driver)
+	}
+
 	run := func(_ context.Context) {
 		var stop <-chan struct{}
 		// Control plane client used to interact with machine APIs
 		controlMachineClientBuilder := machineclientbuilder.SimpleClientBuilder{
-			ClientConfig: controlkubeconfig,
+			ClientConfig: controllerControlKubeconfig,
 		}
 		// Control plane client used to interact with core kubernetes objects
 		controlCoreClientBuilder := coreclientbuilder.SimpleControllerClientBuilder{
-			ClientConfig: controlkubeconfig,
+			ClientConfig: controllerControlKubeconfig,
 		}
 		// Target plane client used to interact with core kubernetes objects
 		targetCoreClientBuilder := coreclientbuilder.SimpleControllerClientBuilder{
-			ClientConfig: targetkubeconfig,
+			ClientConfig: controllerTargetKubeconfig,
 		}
 
 		err := StartControllers(
 			s,
-			controlkubeconfig,
-			targetkubeconfig,
+			controllerControlKubeconfig,
+			controllerTargetKubeconfig,
 			controlMachineClientBuilder,
 			controlCoreClientBuilder,
 			targetCoreClientBuilder,
-			driver,
+			controllerDriver,
 			recorder,
 			stop,
 		)
@@ -391,3 +410,7 @@ func startHTTP(s *options.MCServer) {
 	}
 	klog.Fatal(server.ListenAndServe())
 }
+
+func startDebugServer(s *options.MCServer) {
+	klog.Fatal(debug.ListenAndServeTLS(s.Address, s.DebugServer))
+}
@@ -48,6 +48,7 @@ import (
 	policyv1listers "k8s.io/client-go/listers/policy/v1"
 	"k8s.io/klog/v2"
 
+	"github.com/gardener/machine-controller-manager/pkg/util/nodeops"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
 )
 
@@ -64,6 +65,7 @@ type Options struct {
 	GracePeriodSeconds           int
 	IgnorePodsWithoutControllers bool
 	IgnoreDaemonsets             bool
+	EvictDaemonSetPods           bool
 	MaxEvictRetries              int32
 	PvDetachTimeout              time.Duration
 	PvReattachTimeout            time.Duration
@@ -149,6 +151,13 @@ const (
 	// VolumeDetachPollInterval is the interval in which to recheck if the volume is detached from the node
 	VolumeDetachPollInterval = time.Second * 5
 
+	// DaemonSetEvictionAnnotation on a DaemonSet, if set to "true", opts its pods into deletion
+	// at the end of the drain, after all other pods are gone
+	DaemonSetEvictionAnnotation = "node.machine.sapcloud.io/evict-on-drain"
+	// DaemonSetEvictionTaintKey is the key of the taint put on the node before its DaemonSet pods
+	// are deleted, so that they are not scheduled again
+	DaemonSetEvictionTaintKey = "node.machine.sapcloud.io/daemonset-pods-evicted"
+
 	daemonsetFatal      = "DaemonSet-managed pods (use --ignore-daemonsets to ignore)"
 	daemonsetWarning    = "Ignoring DaemonSet-managed pods"
 	localStorageFatal   = "pods with local storage (use --delete-local-data to override)"
@@ -245,9 +254,165 @@ func (o *Options) RunDrain(ctx context.Context) error {
 	}
 
 	err := o.deleteOrEvictPodsSimple(drainContext)
+	if err == nil && o.EvictDaemonSetPods {
+		err = o.deleteDaemonSetPods(drainContext)
+	}
 	return err
 }
 
+// deleteDaemonSetPods deletes the pods on the node belonging to DaemonSets annotated with
+// DaemonSetEvictionAnnotation and waits up to their terminationGracePeriod for them to go away.
+// The node is tainted beforehand so that the DaemonSet controller doesn't recreate them. Pods tolerating
+// the taint, e.g. with a toleration with operator Exists and no key, would be recreated anyway, so they are
+// deleted last, once the volumes are detached from the node.
+func (o *Options) deleteDaemonSetPods(ctx context.Context) error {
+	pods, err := o.getDaemonSetPodsForDeletion(ctx)
+	if err != nil || len(pods) == 0 {
+		return err
+	}
+
+	taint := &corev1.Taint{
+		Key:    DaemonSetEvictionTaintKey,
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	if err := nodeops.AddOrUpdateTaintOnNode(ctx, o.client, o.nodeName, taint); err != nil {
+		return fmt.Errorf("failed to taint node %q before deleting DaemonSet pods: %w", o.nodeName, err)
+	}
+
+	var podsHonoringTaint, podsToleratingTaint []*corev1.Pod
+	for i := range pods {
+		if toleratesTaint(&pods[i], taint) {
+			podsToleratingTaint = append(podsToleratingTaint, &pods[i])
+		} else {
+			podsHonoringTaint = append(podsHonoringTaint, &pods[i])
+		}
+	}
+	if err := o.deleteAndWaitForDaemonSetPods(ctx, podsHonoringTaint); err != nil {
+		return err
+	}
+	if len(podsToleratingTaint) == 0 {
+		return nil
+	}
+
+	klog.V(3).Infof("%d DaemonSet pods on node %q tolerate taint %q, deleting them once the volumes are detached from the node", len(podsToleratingTaint), o.nodeName, DaemonSetEvictionTaintKey)
+	if err := o.waitForNodeVolumesDetached(ctx); err != nil {
+		fmt.Fprintf(o.ErrOut, "WARNING: Deleting DaemonSet pods before all volumes are detached from node %q: %v\n", o.nodeName, err)
+	}
+	return o.deleteAndWaitForDaemonSetPods(ctx, podsToleratingTaint)
+}
+
+// deleteAndWaitForDaemonSetPods deletes the given DaemonSet pods and waits up to their terminationGracePeriod
+// for them to go away. Pods recreated by the DaemonSet controller are not waited for.
+func (o *Options) deleteAndWaitForDaemonSetPods(ctx context.Context, pods []*corev1.Pod) error {
+	var (
+		podsToWait []*corev1.Pod
+		timeout    time.Duration
+	)
+	for _, pod := range pods {
+		klog.V(3).Infof("Attempting to delete the DaemonSet pod:%q from node %q", pod.Name, o.nodeName)
+		// Pod's own terminationGracePeriod is used, giving it time to flush its state
+		err := o.client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to delete DaemonSet pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+		podsToWait = append(podsToWait, pod)
+		if tgp := o.getTerminationGracePeriod(pod); tgp > timeout {
+			timeout = tgp
+		}
+	}
+	if len(podsToWait) == 0 {
+		return nil
+	}
+
+	getPodFn := func(namespace, name string) (*corev1.Pod, error) {
+		return o.client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	pendingPods, err := o.waitForDelete(podsToWait, Interval, timeout+Interval, getPodFn)
+	if err != nil {
+		// Pods had their terminationGracePeriod, so drain is not blocked by them
+		fmt.Fprintf(o.ErrOut, "WARNING: DaemonSet pods still terminating after their terminationGracePeriod: %v\n", err)
+		for _, pendingPod := range pendingPods {
+			fmt.Fprintf(o.ErrOut, "%s/%s\n", pendingPod.Namespace, pendingPod.Name)
+		}
+	}
+	return nil
+}
+
+// waitForNodeVolumesDetached waits up to the PvDetachTimeout until no volumes are attached to the node.
+func (o *Options) waitForNodeVolumesDetached(ctx context.Context) error {
+	return wait.PollUntilContextTimeout(ctx, VolumeDetachPollInterval, o.PvDetachTimeout, true, func(ctx context.Context) (bool, error) {
+		node, err := o.client.CoreV1().Nodes().Get(ctx, o.nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		klog.V(4).Infof("Volumes attached to node %q: %s", o.nodeName, node.Status.VolumesAttached)
+		return len(node.Status.VolumesAttached) == 0, nil
+	})
+}
+
+// toleratesTaint returns true if the pod tolerates the given taint.
+func toleratesTaint(pod *corev1.Pod, taint *corev1.Taint) bool {
+	for i := range pod.Spec.Tolerations {
+		if pod.Spec.Tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// getDaemonSetPodsForDeletion returns the pods on the node managed by DaemonSets which carry the
+// DaemonSetEvictionAnnotation
+func (o *Options) getDaemonSetPodsForDeletion(ctx context.Context) ([]corev1.Pod, error) {
+	podList, err := o.podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pods     []corev1.Pod
+		optedIn  = make(map[string]bool)
+		dsFilter = func(pod corev1.Pod) (bool, error) {
+			controllerRef := o.getPodController(pod)
+			if controllerRef == nil || controllerRef.Kind != "DaemonSet" {
+				return false, nil
+			}
+			key := pod.Namespace + "/" + controllerRef.Name
+			if evict, ok := optedIn[key]; ok {
+				return evict, nil
+			}
+			ds, err := o.client.AppsV1().DaemonSets(pod.Namespace).Get(ctx, controllerRef.Name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				optedIn[key] = false
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+			optedIn[key] = ds.Annotations[DaemonSetEvictionAnnotation] == "true"
+			return optedIn[key], nil
+		}
+	)
+
+	for _, pod := range podList {
+		if pod.Spec.NodeName != o.nodeName || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if ok, _, _ := mirrorPodFilter(*pod); !ok {
+			continue
+		}
+		evict, err := dsFilter(*pod)
+		if err != nil {
+			return nil, err
+		}
+		if evict {
+			pods = append(pods, *pod)
+		}
+	}
+	return pods, nil
+}
+
 func (o *Options) deleteOrEvictPodsSimple(ctx context.Context) error {
 	pods, err := o.getPodsForDeletion()
 	if err != nil {
@@ -389,6 +554,12 @@ func (o *Options) getPodsForDeletion() (pods []corev1.Pod, err error) {
 	return pods, nil
 }
 
+// PodsToEvict returns the pods on the node which a drain evicts, without evicting them. An error is returned if
+// pods prevent the drain, e.g. pods not managed by a controller.
+func (o *Options) PodsToEvict() ([]corev1.Pod, error) {
+	return o.getPodsForDeletion()
+}
+
 func (o *Options) deletePod(ctx context.Context, pod *corev1.Pod) error {
 	deleteOptions := metav1.DeleteOptions{}
 	gracePeriodSeconds := int64(0)
@@ -1191,6 +1362,17 @@ func getPdbForPod(pdbLister policyv1listers.PodDisruptionBudgetLister, pod *core
 	return pdbs[0]
 }
 
+// PodDisruptionBudgetForPod returns the pod disruption budget guarding the pod, or nil if there is none.
+func (o *Options) PodDisruptionBudgetForPod(pod *corev1.Pod) *policyv1.PodDisruptionBudget {
+	return getPdbForPod(o.pdbLister, pod)
+}
+
+// IsMisconfiguredPodDisruptionBudget returns true if the pod disruption budget allows no disruption although all of
+// its pods are healthy, so that a drain gives up evicting its pods instead of retrying.
+func IsMisconfiguredPodDisruptionBudget(pdb *policyv1.PodDisruptionBudget) bool {
+	return isMisconfiguredPdb(pdb)
+}
+
 func isMisconfiguredPdb(pdb *policyv1.PodDisruptionBudget) bool {
 	if pdb.ObjectMeta.Generation != pdb.Status.ObservedGeneration {
 		return false
@@ -13,10 +13,12 @@ import (
 	"sync"
 	"time"
 
+	customfake "github.com/gardener/machine-controller-manager/pkg/fakeclient"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gcustom"
 	gomegatypes "github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -860,6 +862,142 @@ var _ = Describe("drain", func() {
 			}),
 	)
 
+	Describe("deleteDaemonSetPods", func() {
+		type expectation struct {
+			remainingPods []string
+			tainted       bool
+		}
+
+		newDaemonSet := func(name string, annotations map[string]string) *appsv1.DaemonSet {
+			return &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Annotations: annotations},
+			}
+		}
+		newDaemonSetPod := func(name, dsName string) *corev1.Pod {
+			pod := getPodWithoutPV(testNamespace, name, oldNodeName, terminationGracePeriodShort, nil)
+			pod.OwnerReferences[0].Kind = "DaemonSet"
+			pod.OwnerReferences[0].Name = dsName
+			return pod
+		}
+
+		DescribeTable("##table",
+			func(daemonSets []*appsv1.DaemonSet, pods []*corev1.Pod, expected *expectation) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				targetCoreObjects := appendPods(nil, pods)
+				targetCoreObjects = appendNodes(targetCoreObjects, []*corev1.Node{getNode(oldNodeName, nil)})
+				for _, ds := range daemonSets {
+					targetCoreObjects = append(targetCoreObjects, ds)
+				}
+
+				fakeTargetCoreClient, _, _, _, fakePodLister, _, _, _, podSynced, tracker := createFakeController(
+					stop, testNamespace, targetCoreObjects,
+				)
+				defer tracker.Stop()
+				Expect(cache.WaitForCacheSync(stop, podSynced)).To(BeTrue())
+
+				d := &Options{
+					client:    fakeTargetCoreClient,
+					ErrOut:    GinkgoWriter,
+					nodeName:  oldNodeName,
+					podLister: fakePodLister,
+				}
+				Expect(d.deleteDaemonSetPods(context.TODO())).To(Succeed())
+
+				podList, err := fakeTargetCoreClient.CoreV1().Pods(testNamespace).List(context.TODO(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				var remainingPods []string
+				for _, pod := range podList.Items {
+					remainingPods = append(remainingPods, pod.Name)
+				}
+				Expect(remainingPods).To(ConsistOf(expected.remainingPods))
+
+				node, err := fakeTargetCoreClient.CoreV1().Nodes().Get(context.TODO(), oldNodeName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				tainted := false
+				for _, taint := range node.Spec.Taints {
+					if taint.Key == DaemonSetEvictionTaintKey {
+						tainted = true
+					}
+				}
+				Expect(tainted).To(Equal(expected.tainted))
+			},
+			Entry("should delete pods of opted-in DaemonSets only and taint the node",
+				[]*appsv1.DaemonSet{
+					newDaemonSet("fluent-bit", map[string]string{DaemonSetEvictionAnnotation: "true"}),
+					newDaemonSet("node-exporter", nil),
+				},
+				[]*corev1.Pod{
+					newDaemonSetPod("fluent-bit-0", "fluent-bit"),
+					newDaemonSetPod("node-exporter-0", "node-exporter"),
+				},
+				&expectation{remainingPods: []string{"node-exporter-0"}, tainted: true},
+			),
+			Entry("should not taint the node if no DaemonSet is opted-in",
+				[]*appsv1.DaemonSet{
+					newDaemonSet("node-exporter", nil),
+				},
+				[]*corev1.Pod{
+					newDaemonSetPod("node-exporter-0", "node-exporter"),
+				},
+				&expectation{remainingPods: []string{"node-exporter-0"}, tainted: false},
+			),
+		)
+
+		It("should delete pods of DaemonSets tolerating all taints once the volumes are detached", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			pod := newDaemonSetPod("fluent-bit-0", "fluent-bit")
+			pod.Spec.Tolerations = []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+			node := getNode(oldNodeName, nil)
+			node.Status.VolumesAttached = []corev1.AttachedVolume{{Name: "vol"}}
+			targetCoreObjects := appendPods(nil, []*corev1.Pod{pod})
+			targetCoreObjects = appendNodes(targetCoreObjects, []*corev1.Node{node})
+			targetCoreObjects = append(targetCoreObjects, newDaemonSet("fluent-bit", map[string]string{DaemonSetEvictionAnnotation: "true"}))
+
+			fakeTargetCoreClient, _, _, _, fakePodLister, _, _, _, podSynced, tracker := createFakeController(
+				stop, testNamespace, targetCoreObjects,
+			)
+			defer tracker.Stop()
+			Expect(cache.WaitForCacheSync(stop, podSynced)).To(BeTrue())
+
+			// The DaemonSet controller recreates the pod as it tolerates the taint of the node
+			volumesAttachedOnDelete := -1
+			fakeTargetCoreClient.(*customfake.Clientset).PrependReactor("delete", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+				obj, err := tracker.Get(corev1.SchemeGroupVersion.WithResource("nodes"), "", oldNodeName)
+				Expect(err).ToNot(HaveOccurred())
+				volumesAttachedOnDelete = len(obj.(*corev1.Node).Status.VolumesAttached)
+				recreated := getPodWithoutPV(testNamespace, "fluent-bit-1", oldNodeName, terminationGracePeriodShort, nil)
+				recreated.OwnerReferences = pod.OwnerReferences
+				return false, nil, tracker.Add(recreated)
+			})
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(100 * time.Millisecond)
+				detached := node.DeepCopy()
+				detached.Status.VolumesAttached = nil
+				_, err := fakeTargetCoreClient.CoreV1().Nodes().UpdateStatus(context.TODO(), detached, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}()
+
+			d := &Options{
+				client:          fakeTargetCoreClient,
+				ErrOut:          GinkgoWriter,
+				nodeName:        oldNodeName,
+				podLister:       fakePodLister,
+				PvDetachTimeout: time.Minute,
+			}
+			Expect(d.deleteDaemonSetPods(context.TODO())).To(Succeed())
+			Expect(volumesAttachedOnDelete).To(Equal(0))
+
+			podList, err := fakeTargetCoreClient.CoreV1().Pods(testNamespace).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(podList.Items).To(ConsistOf(HaveField("Name", "fluent-bit-1")))
+		})
+	})
+
 	Describe("getPodVolumeInfos", func() {
 		var (
 			ctx context.Context
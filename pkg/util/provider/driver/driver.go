@@ -11,6 +11,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
 )
 
 // Driver is the common interface for creation/deletion of the VMs over different cloud-providers.
@@ -35,6 +37,67 @@ type Driver interface {
 	GetVolumeIDs(context.Context, *GetVolumeIDsRequest) (*GetVolumeIDsResponse, error)
 }
 
+// VolumeDetacher is an optional interface of a Driver which can detach volumes from the VMs backing machines. The
+// volumes still attached on machine termination are left to the VM deletion if the driver does not implement it.
+type VolumeDetacher interface {
+	// DetachVolumes call is responsible for detaching volumes from the VM backing the machine on the provider.
+	// This method is invoked during machine termination for volumes still attached after the PV detach timeout.
+	//
+	// In case of an error, this operation should return an error with one of the following status codes
+	//  - codes.Unimplemented if the provider does not support detaching volumes.
+	//  - codes.NotFound if VM instance was not found.
+	DetachVolumes(context.Context, *DetachVolumesRequest) (*DetachVolumesResponse, error)
+}
+
+// MachineStopper is an optional interface of a Driver which can stop and start the VMs backing machines. The
+// machines of a warm pool are kept running if the driver does not implement it.
+type MachineStopper interface {
+	// StopMachine call is responsible for stopping or hibernating the VM backing the machine on the provider,
+	// keeping its disks so that it can be started again. This method is invoked for machines of a warm pool.
+	//
+	// In case of an error, this operation should return an error with one of the following status codes
+	//  - codes.Unimplemented if the provider does not support stopping VMs.
+	//  - codes.NotFound if VM instance was not found.
+	StopMachine(context.Context, *StopMachineRequest) (*StopMachineResponse, error)
+	// StartMachine call is responsible for starting the stopped VM backing the machine on the provider.
+	// This method is invoked when a machine of a warm pool is taken into service.
+	//
+	// In case of an error, this operation should return an error with one of the following status codes
+	//  - codes.Unimplemented if the provider does not support starting VMs.
+	//  - codes.NotFound if VM instance was not found.
+	StartMachine(context.Context, *StartMachineRequest) (*StartMachineResponse, error)
+}
+
+// StopMachine stops the VM backing the machine if the driver implements MachineStopper. Otherwise, it returns
+// an error with the code codes.Unimplemented.
+func StopMachine(ctx context.Context, d Driver, req *StopMachineRequest) (*StopMachineResponse, error) {
+	stopper, ok := d.(MachineStopper)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "driver does not support stopping VMs")
+	}
+	return stopper.StopMachine(ctx, req)
+}
+
+// StartMachine starts the stopped VM backing the machine if the driver implements MachineStopper. Otherwise, it
+// returns an error with the code codes.Unimplemented.
+func StartMachine(ctx context.Context, d Driver, req *StartMachineRequest) (*StartMachineResponse, error) {
+	stopper, ok := d.(MachineStopper)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "driver does not support starting VMs")
+	}
+	return stopper.StartMachine(ctx, req)
+}
+
+// DetachVolumes detaches the volumes from the VM backing the machine if the driver implements VolumeDetacher.
+// Otherwise, it returns an error with the code codes.Unimplemented.
+func DetachVolumes(ctx context.Context, d Driver, req *DetachVolumesRequest) (*DetachVolumesResponse, error) {
+	detacher, ok := d.(VolumeDetacher)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "driver does not support detaching volumes")
+	}
+	return detacher.DetachVolumes(ctx, req)
+}
+
 // CreateMachineRequest is the create request for VM creation
 type CreateMachineRequest struct {
 	// Machine object from whom VM is to be created
@@ -123,6 +186,9 @@ type GetMachineStatusResponse struct {
 
 	// NodeName is the name of the node-object registered to kubernetes.
 	NodeName string
+
+	// Stopped is true if the VM exists, but is stopped. Drivers which cannot tell leave it false.
+	Stopped bool
 }
 
 // ListMachinesRequest is the request object to get a list of VMs belonging to a machineClass
@@ -153,6 +219,54 @@ type GetVolumeIDsResponse struct {
 	VolumeIDs []string
 }
 
+// DetachVolumesRequest is the request object to detach volumes from the VM backing the machine
+type DetachVolumesRequest struct {
+	// Machine object from whose VM the volumes are to be detached
+	Machine *v1alpha1.Machine
+
+	// MachineClass backing the machine object
+	MachineClass *v1alpha1.MachineClass
+
+	// Secret backing the machineClass object
+	Secret *corev1.Secret
+
+	// VolumeIDs is the list of volumeIDs, as returned by GetVolumeIDs, to be detached
+	VolumeIDs []string
+}
+
+// DetachVolumesResponse is the response object for detaching volumes from the VM backing the machine
+type DetachVolumesResponse struct{}
+
+// StopMachineRequest is the request object to stop the VM backing the machine
+type StopMachineRequest struct {
+	// Machine object whose VM is to be stopped
+	Machine *v1alpha1.Machine
+
+	// MachineClass backing the machine object
+	MachineClass *v1alpha1.MachineClass
+
+	// Secret backing the machineClass object
+	Secret *corev1.Secret
+}
+
+// StopMachineResponse is the response object for stopping the VM backing the machine
+type StopMachineResponse struct{}
+
+// StartMachineRequest is the request object to start the stopped VM backing the machine
+type StartMachineRequest struct {
+	// Machine object whose VM is to be started
+	Machine *v1alpha1.Machine
+
+	// MachineClass backing the machine object
+	MachineClass *v1alpha1.MachineClass
+
+	// Secret backing the machineClass object
+	Secret *corev1.Secret
+}
+
+// StartMachineResponse is the response object for starting the stopped VM backing the machine
+type StartMachineResponse struct{}
+
 // GenerateMachineClassForMigrationRequest is the request for generating the generic machineClass
 // for the provider specific machine class
 type GenerateMachineClassForMigrationRequest struct {
@@ -17,6 +17,7 @@ type VMs map[string]string
 // FakeDriver is a fake driver returned when none of the actual drivers match
 type FakeDriver struct {
 	VMExists       bool
+	VMStopped      bool
 	ProviderID     string
 	NodeName       string
 	LastKnownState string
@@ -99,6 +100,7 @@ func (d *FakeDriver) GetMachineStatus(_ context.Context, _ *GetMachineStatusRequ
 	return &GetMachineStatusResponse{
 		ProviderID: d.ProviderID,
 		NodeName:   d.NodeName,
+		Stopped:    d.VMStopped,
 	}, d.Err
 }
 
@@ -116,6 +118,27 @@ func (d *FakeDriver) GetVolumeIDs(_ context.Context, _ *GetVolumeIDsRequest) (*G
 	}, d.Err
 }
 
+// DetachVolumes detaches the volumes with the supplied volumeIDs from the VM
+func (d *FakeDriver) DetachVolumes(_ context.Context, _ *DetachVolumesRequest) (*DetachVolumesResponse, error) {
+	return &DetachVolumesResponse{}, d.Err
+}
+
+// StopMachine stops the VM backing the machine
+func (d *FakeDriver) StopMachine(_ context.Context, _ *StopMachineRequest) (*StopMachineResponse, error) {
+	if !d.VMExists {
+		return nil, status.Error(codes.NotFound, "Fake plugin is returning no VM instances backing this machine object")
+	}
+	return &StopMachineResponse{}, d.Err
+}
+
+// StartMachine starts the stopped VM backing the machine
+func (d *FakeDriver) StartMachine(_ context.Context, _ *StartMachineRequest) (*StartMachineResponse, error) {
+	if !d.VMExists {
+		return nil, status.Error(codes.NotFound, "Fake plugin is returning no VM instances backing this machine object")
+	}
+	return &StartMachineResponse{}, d.Err
+}
+
 // GenerateMachineClassForMigration converts providerMachineClass to (generic)MachineClass
 func (d *FakeDriver) GenerateMachineClassForMigration(_ context.Context, req *GenerateMachineClassForMigrationRequest) (*GenerateMachineClassForMigrationResponse, error) {
 	req.MachineClass.Provider = "FakeProvider"
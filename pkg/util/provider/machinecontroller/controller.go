@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/gardener/machine-controller-manager/pkg/handlers"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	"github.com/gardener/machine-controller-manager/pkg/util/k8sutils"
 	"github.com/gardener/machine-controller-manager/pkg/util/permits"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/drain"
@@ -88,21 +89,23 @@ func NewController(
 		controlCoreClient:             controlCoreClient,
 		targetCoreClient:              targetCoreClient,
 		recorder:                      recorder,
-		secretQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "secret"),
-		nodeQueue:                     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "node"),
-		machineClassQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineclass"),
-		machineQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machine"),
-		machineSafetyOrphanVMsQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyorphanvms"),
-		machineSafetyAPIServerQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyapiserver"),
+		secretQueue:                   debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "secret"),
+		nodeQueue:                     debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "node"),
+		machineClassQueue:             debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineclass"),
+		machineQueue:                  debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machine"),
+		machineSafetyOrphanVMsQueue:   debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyorphanvms"),
+		machineSafetyAPIServerQueue:   debug.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machinesafetyapiserver"),
 		safetyOptions:                 safetyOptions,
 		nodeConditions:                nodeConditions,
-		driver:                        driver,
 		bootstrapTokenAuthExtraGroups: bootstrapTokenAuthExtraGroups,
 		volumeAttachmentHandler:       nil,
 		permitGiver:                   permits.NewPermitGiver(permitGiverStaleEntryTimeout, janitorFreq),
 		targetKubernetesVersion:       targetKubernetesVersion,
 	}
 
+	controller.driverCalls = newDriverCallRecorder(driver)
+	controller.driver = controller.driverCalls
+
 	controller.internalExternalScheme = runtime.NewScheme()
 
 	if err := machineinternal.AddToScheme(controller.internalExternalScheme); err != nil {
@@ -232,11 +235,18 @@ type controller struct {
 	internalExternalScheme  *runtime.Scheme
 	driver                  driver.Driver
 	volumeAttachmentHandler *drain.VolumeAttachmentHandler
+	// driverCalls wraps the driver and records the last successful call per machine class
+	driverCalls *driverCallRecorder
 	// permitGiver store two things:
 	// - mutex per machinedeployment
 	// - lastAcquire time
 	// it is used to limit removal of `health timed out` machines
 	permitGiver permits.PermitGiver
+	// permitHolders maps the keys of the acquired permits to the names of the machines holding them
+	permitHolders sync.Map
+	// safetyStateLock guards MachineControllerFrozen and APIserverInactiveStartTime of the safetyOptions, which are
+	// written by the safety controller and read by the machine workers, the metrics and the debug endpoint
+	safetyStateLock sync.RWMutex
 
 	// listers
 	pvcLister               corelisters.PersistentVolumeClaimLister
@@ -282,6 +292,10 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	defer c.machineSafetyOrphanVMsQueue.ShutDown()
 	defer c.machineSafetyAPIServerQueue.ShutDown()
 
+	// The state is registered before the caches are synced, so that a controller waiting for them can be inspected.
+	debug.Register(debugName, c.debugState)
+	defer debug.Unregister(debugName)
+
 	if k8sutils.ConstraintK8sGreaterEqual121.Check(c.targetKubernetesVersion) {
 		if !cache.WaitForCacheSync(stopCh, c.secretSynced, c.pvcSynced, c.pvSynced, c.pdbSynced, c.volumeAttachementSynced, c.nodeSynced, c.machineClassSynced, c.machineSynced) {
 			runtimeutil.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
@@ -578,10 +578,12 @@ func createController(
 		MaxEvictRetries:                          drain.DefaultMaxEvictRetries,
 	}
 
+	driverCalls := newDriverCallRecorder(fakedriver)
 	controller := &controller{
 		namespace:                   namespace,
 		nodeConditions:              "KernelDeadlock,ReadonlyFilesystem,DiskPressure,NetworkUnavailable",
-		driver:                      fakedriver,
+		driver:                      driverCalls,
+		driverCalls:                 driverCalls,
 		safetyOptions:               safetyOptions,
 		machineClassLister:          machineClass.Lister(),
 		machineClassSynced:          machineClass.Informer().HasSynced,
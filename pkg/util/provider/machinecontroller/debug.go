@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// debugName is the name under which the controller shows its state on the debug endpoint.
+const debugName = "machine-controller"
+
+// debugState is the state of the controller shown on the debug endpoint.
+type debugState struct {
+	// Queues are the states of the work queues.
+	Queues []debug.QueueState `json:"queues"`
+	// InformersSynced tells for each informer whether its cache is synced.
+	InformersSynced map[string]bool `json:"informersSynced"`
+	// Permits are the permits of the machine deployments, which limit the machines marked as failed at a time.
+	Permits []permitState `json:"permits"`
+	// Frozen is true if the safety controller froze the machine controller as the API server was unreachable.
+	Frozen bool `json:"frozen"`
+	// APIServerInactiveSince is the time since which the API server is unreachable, if it is.
+	APIServerInactiveSince *metav1.Time `json:"apiServerInactiveSince,omitempty"`
+}
+
+// permitState is the state of the permits of a machine deployment.
+type permitState struct {
+	// Key is the name of the machine deployment.
+	Key string `json:"key"`
+	// Permits is the number of permits.
+	Permits int `json:"permits"`
+	// Acquired is the number of acquired permits.
+	Acquired int `json:"acquired"`
+	// Holder is the name of the machine holding the permit, if it is acquired.
+	Holder string `json:"holder,omitempty"`
+}
+
+func (c *controller) debugState() interface{} {
+	state := debugState{
+		Queues: []debug.QueueState{
+			debug.QueueStateOf(c.secretQueue, "secret"),
+			debug.QueueStateOf(c.nodeQueue, "node"),
+			debug.QueueStateOf(c.machineClassQueue, "machineclass"),
+			debug.QueueStateOf(c.machineQueue, "machine"),
+			debug.QueueStateOf(c.machineSafetyOrphanVMsQueue, "machinesafetyorphanvms"),
+			debug.QueueStateOf(c.machineSafetyAPIServerQueue, "machinesafetyapiserver"),
+		},
+		InformersSynced: map[string]bool{
+			"secrets":                c.secretSynced(),
+			"persistentVolumeClaims": c.pvcSynced(),
+			"persistentVolumes":      c.pvSynced(),
+			"podDisruptionBudgets":   c.pdbSynced(),
+			"volumeAttachments":      c.volumeAttachementSynced(),
+			"nodes":                  c.nodeSynced(),
+			"machineClasses":         c.machineClassSynced(),
+			"machines":               c.machineSynced(),
+			"pods":                   c.podSynced(),
+		},
+		Permits: []permitState{},
+	}
+	var inactiveSince time.Time
+	state.Frozen, inactiveSince = c.machineControllerFrozen()
+	if !inactiveSince.IsZero() {
+		state.APIServerInactiveSince = &metav1.Time{Time: inactiveSince}
+	}
+	for _, p := range c.permitGiver.Permits() {
+		permit := permitState{Key: p.Key, Permits: p.Permits, Acquired: p.Acquired}
+		if holder, ok := c.permitHolders.Load(p.Key); ok {
+			permit.Holder = holder.(string)
+		}
+		state.Permits = append(state.Permits, permit)
+	}
+	return state
+}
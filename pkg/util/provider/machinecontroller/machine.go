@@ -47,7 +47,9 @@ func (c *controller) updateMachine(oldObj, newObj interface{}) {
 		return
 	}
 
-	if oldMachine.Generation == newMachine.Generation {
+	if oldMachine.Generation == newMachine.Generation &&
+		oldMachine.Annotations[machineutils.MachineMaintenance] == newMachine.Annotations[machineutils.MachineMaintenance] &&
+		oldMachine.Annotations[machineutils.MachineStandby] == newMachine.Annotations[machineutils.MachineStandby] {
 		klog.V(3).Infof("Skipping non-spec updates for machine %s", oldMachine.Name)
 		return
 	}
@@ -116,7 +118,7 @@ func (c *controller) reconcileClusterMachine(ctx context.Context, machine *v1alp
 	klog.V(2).Infof("reconcileClusterMachine: Start for %q with phase:%q, description:%q", machine.Name, machine.Status.CurrentStatus.Phase, machine.Status.LastOperation.Description)
 	defer klog.V(2).Infof("reconcileClusterMachine: Stop for %q", machine.Name)
 
-	if c.safetyOptions.MachineControllerFrozen && machine.DeletionTimestamp == nil {
+	if frozen, _ := c.machineControllerFrozen(); frozen && machine.DeletionTimestamp == nil {
 		// If Machine controller is frozen and
 		// machine is not set for termination don't process it
 		err := fmt.Errorf("Machine controller has frozen. Retrying reconcile after resync period")
@@ -164,7 +166,25 @@ func (c *controller) reconcileClusterMachine(ctx context.Context, machine *v1alp
 
 	if machine.Labels[v1alpha1.NodeLabelKey] != "" && machine.Status.CurrentStatus.Phase != "" {
 		// If reference to node object exists execute the below
-		retry, err := c.reconcileMachineHealth(ctx, machine)
+		var (
+			retry machineutils.RetryPeriod
+			err   error
+		)
+		if isMachineStandbyRequested(machine) {
+			retry, err = c.taintNodeForStandby(ctx, machine)
+			if err != nil {
+				return retry, err
+			}
+		}
+		if shouldReconcileMachineMaintenance(machine) {
+			// Health timeouts are suspended while the machine is under maintenance
+			retry, err = c.reconcileMachineMaintenance(ctx, machine)
+		} else if c.shouldReconcileMachineStandby(machine) {
+			// Health timeouts are suspended while the VM of the machine is stopped
+			retry, err = c.reconcileMachineStandby(ctx, machine, machineClass, &corev1.Secret{Data: secretData})
+		} else {
+			retry, err = c.reconcileMachineHealth(ctx, machine)
+		}
 		if err != nil {
 			return retry, err
 		}
@@ -556,7 +576,7 @@ func (c *controller) triggerCreationFlow(ctx context.Context, createMachineReque
 			TimeoutActive:  true,
 			LastUpdateTime: metav1.Now(),
 		}
-		_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+		_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 		if err != nil {
 			klog.Warningf("Machine/status UPDATE failed for %q. Retrying, error: %s", machine.Name, err)
 		} else {
@@ -571,9 +591,9 @@ func (c *controller) triggerCreationFlow(ctx context.Context, createMachineReque
 
 func (c *controller) updateLabels(ctx context.Context, machine *v1alpha1.Machine, nodeName, providerID string) (clone *v1alpha1.Machine, err error) {
 	machineNodeLabelPresent := metav1.HasLabel(machine.ObjectMeta, v1alpha1.NodeLabelKey)
-	machinePriorityAnnotationPresent := metav1.HasAnnotation(machine.ObjectMeta, machineutils.MachinePriority)
+	machinePrioritySet := machine.Spec.DeletionPriority != nil || metav1.HasAnnotation(machine.ObjectMeta, machineutils.MachinePriority)
 	clone = machine.DeepCopy()
-	if !machineNodeLabelPresent || !machinePriorityAnnotationPresent || machine.Spec.ProviderID == "" {
+	if !machineNodeLabelPresent || !machinePrioritySet || machine.Spec.ProviderID == "" {
 		if clone.Labels == nil {
 			clone.Labels = make(map[string]string)
 		}
@@ -581,7 +601,7 @@ func (c *controller) updateLabels(ctx context.Context, machine *v1alpha1.Machine
 		if clone.Annotations == nil {
 			clone.Annotations = make(map[string]string)
 		}
-		if clone.Annotations[machineutils.MachinePriority] == "" {
+		if clone.Spec.DeletionPriority == nil && clone.Annotations[machineutils.MachinePriority] == "" {
 			clone.Annotations[machineutils.MachinePriority] = "3"
 		}
 		clone.Spec.ProviderID = providerID
@@ -7,6 +7,7 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,6 +48,23 @@ func (c *controller) reconcileClusterMachineSafetyOrphanVMs(_ string) error {
 	return nil
 }
 
+// machineControllerFrozen returns whether the machine controller is frozen due to unreachable APIServers,
+// and since when the APIServers are unreachable
+func (c *controller) machineControllerFrozen() (bool, time.Time) {
+	c.safetyStateLock.RLock()
+	defer c.safetyStateLock.RUnlock()
+	return c.safetyOptions.MachineControllerFrozen, c.safetyOptions.APIserverInactiveStartTime
+}
+
+// setMachineControllerFrozen sets whether the machine controller is frozen due to unreachable APIServers,
+// and since when the APIServers are unreachable
+func (c *controller) setMachineControllerFrozen(frozen bool, inactiveSince time.Time) {
+	c.safetyStateLock.Lock()
+	defer c.safetyStateLock.Unlock()
+	c.safetyOptions.MachineControllerFrozen = frozen
+	c.safetyOptions.APIserverInactiveStartTime = inactiveSince
+}
+
 // reconcileClusterMachineSafetyAPIServer checks control and target clusters
 // and checks if their APIServer's are reachable
 // If they are not reachable, they set a machineControllerFreeze flag
@@ -58,7 +76,8 @@ func (c *controller) reconcileClusterMachineSafetyAPIServer(_ string) error {
 	klog.V(4).Infof("reconcileClusterMachineSafetyAPIServer: Start")
 	defer klog.V(4).Infof("reconcileClusterMachineSafetyAPIServer: Stop")
 
-	if c.safetyOptions.MachineControllerFrozen {
+	frozen, inactiveSince := c.machineControllerFrozen()
+	if frozen {
 		// MachineController is frozen
 		if c.isAPIServerUp(ctx) {
 			// APIServer is up now, hence we need reset all machine health checks (to avoid unwanted freezes) and unfreeze
@@ -86,7 +105,7 @@ func (c *controller) reconcileClusterMachineSafetyAPIServer(_ string) error {
 						State:          v1alpha1.MachineStateSuccessful,
 						Type:           v1alpha1.MachineOperationHealthCheck,
 					}
-					_, err = c.controlMachineClient.Machines(c.namespace).UpdateStatus(ctx, machine, metav1.UpdateOptions{})
+					_, err = c.controlMachineClient.Machines(c.namespace).UpdateStatus(ctx, machine, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 					if err != nil {
 						klog.Error("SafetyController: Unable to UPDATE machine/status. Error:", err)
 						return err
@@ -100,23 +119,23 @@ func (c *controller) reconcileClusterMachineSafetyAPIServer(_ string) error {
 				c.enqueueMachineAfter(machine, 30*time.Second, "kube-api-servers are up again, so reconcile of machine phase is needed")
 			}
 
-			c.safetyOptions.MachineControllerFrozen = false
-			c.safetyOptions.APIserverInactiveStartTime = time.Time{}
+			c.setMachineControllerFrozen(false, time.Time{})
 			klog.V(2).Infof("SafetyController: UnFreezing Machine Controller")
 		}
 	} else {
 		// MachineController is not frozen
 		if !c.isAPIServerUp(ctx) {
 			// If APIServer is not up
-			if c.safetyOptions.APIserverInactiveStartTime.Equal(time.Time{}) {
+			if inactiveSince.Equal(time.Time{}) {
 				// If timeout has not started
-				c.safetyOptions.APIserverInactiveStartTime = time.Now()
+				inactiveSince = time.Now()
 			}
-			if time.Since(c.safetyOptions.APIserverInactiveStartTime) > statusCheckTimeout {
+			if time.Since(inactiveSince) > statusCheckTimeout {
 				// If APIServer has been down for more than statusCheckTimeout
-				c.safetyOptions.MachineControllerFrozen = true
+				frozen = true
 				klog.V(2).Infof("SafetyController: Freezing Machine Controller")
 			}
+			c.setMachineControllerFrozen(frozen, inactiveSince)
 
 			// Re-enqueue the safety check more often if APIServer is not active and is not frozen yet
 			defer c.machineSafetyAPIServerQueue.AddAfter("", statusCheckTimeout/5)
@@ -254,6 +273,7 @@ func (c *controller) checkMachineClass(ctx context.Context, machineClass *v1alph
 		}
 	}
 
+	var orphanVMs []v1alpha1.OrphanVM
 	for machineID, machineName := range listMachineResponse.MachineList {
 		machine, err := c.machineLister.Machines(c.namespace).Get(machineName)
 
@@ -269,6 +289,8 @@ func (c *controller) checkMachineClass(ctx context.Context, machineClass *v1alph
 				}
 			}
 
+			orphanVMs = append(orphanVMs, v1alpha1.OrphanVM{ProviderID: machineID, MachineName: machineName})
+
 			// Creating a dummy machine object to create deleteMachineRequest
 			machine = &v1alpha1.Machine{
 				ObjectMeta: metav1.ObjectMeta{
@@ -296,6 +318,18 @@ func (c *controller) checkMachineClass(ctx context.Context, machineClass *v1alph
 		}
 	}
 
+	// Record the orphan VMs found in this sweep in the status of the machineClass
+	sort.Slice(orphanVMs, func(i, j int) bool {
+		return orphanVMs[i].ProviderID < orphanVMs[j].ProviderID
+	})
+	err = c.updateMachineClassStatus(ctx, machineClass, func(status *v1alpha1.MachineClassStatus) {
+		status.OrphanVMs = orphanVMs
+		c.setLastSuccessfulDriverCallTime(machineClass.Name, status)
+	})
+	if err != nil {
+		klog.Errorf("SafetyController: Failed to record orphan VMs of MachineClass %q: %s", machineClass.Name, err)
+	}
+
 	return machineutils.LongRetry, nil
 }
 
@@ -253,6 +253,7 @@ var _ = Describe("safety_logic", func() {
 			//machineIds of machines which are expected to be deleted
 			toBeDeletedMachines []string
 			toBePresentMachines map[string]string
+			orphanVMs           []v1alpha1.OrphanVM
 		}
 		type data struct {
 			setup  setup
@@ -300,7 +301,7 @@ var _ = Describe("safety_logic", func() {
 			controlCoreObjects := []runtime.Object{}
 			controlCoreObjects = append(controlCoreObjects, testSecret)
 
-			controlMachineObjects := []runtime.Object{}
+			controlMachineObjects := []runtime.Object{testMachineClass}
 			for _, obj := range data.setup.machineObjects {
 				controlMachineObjects = append(controlMachineObjects, obj)
 			}
@@ -338,6 +339,11 @@ var _ = Describe("safety_logic", func() {
 			for machineID, machineName := range data.expect.toBePresentMachines {
 				Expect(listMachinesResponse.MachineList[machineID]).To(Equal(machineName))
 			}
+
+			machineClass, err := c.controlMachineClient.MachineClasses(testNamespace).Get(context.TODO(), testMachineClass.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(machineClass.Status.OrphanVMs).To(Equal(data.expect.orphanVMs))
+			Expect(machineClass.Status.LastSuccessfulDriverCallTime).ToNot(BeNil())
 		},
 			Entry("machine object not found", &data{
 				setup: setup{
@@ -348,6 +354,7 @@ var _ = Describe("safety_logic", func() {
 				},
 				expect: expect{
 					toBeDeletedMachines: []string{"testmachine-ip1"},
+					orphanVMs:           []v1alpha1.OrphanVM{{ProviderID: "testmachine-ip1", MachineName: "testmachine_1"}},
 				},
 			}),
 			Entry("machine object in CrashLoopBackOff state,so machine should NOT be deleted", &data{
@@ -398,6 +405,7 @@ var _ = Describe("safety_logic", func() {
 				expect: expect{
 					toBeDeletedMachines: []string{"testmachine-ip1"},
 					toBePresentMachines: nil,
+					orphanVMs:           []v1alpha1.OrphanVM{{ProviderID: "testmachine-ip1", MachineName: "testmachine_1"}},
 				},
 			}),
 		)
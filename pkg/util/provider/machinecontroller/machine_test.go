@@ -299,6 +299,13 @@ var _ = Describe("machine", func() {
 				} else {
 					Expect(err).To(HaveOccurred())
 				}
+
+				// The result of the validation is recorded in the status of an existing machine class
+				class, getErr := controller.controlMachineClient.MachineClasses(objMeta.Namespace).Get(context.TODO(), data.action.Name, metav1.GetOptions{})
+				if getErr == nil {
+					Expect(class.Status.LastValidation).ToNot(BeNil())
+					Expect(class.Status.LastValidation.Valid).To(Equal(!data.expect.err))
+				}
 			},
 			Entry("non-existing machine class", &data{
 				setup: setup{
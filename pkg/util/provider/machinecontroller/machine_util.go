@@ -30,20 +30,24 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	machineapi "github.com/gardener/machine-controller-manager/pkg/apis/machine"
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/validation"
 	"github.com/gardener/machine-controller-manager/pkg/util/nodeops"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/drain"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/metrics"
 	utilstrings "github.com/gardener/machine-controller-manager/pkg/util/strings"
 	utiltime "github.com/gardener/machine-controller-manager/pkg/util/time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -52,6 +56,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	storageclient "k8s.io/client-go/kubernetes/typed/storage/v1"
 	storagelisters "k8s.io/client-go/listers/storage/v1"
@@ -72,31 +77,38 @@ const (
 	cacheUpdateTimeout = 1 * time.Second
 )
 
-// ValidateMachineClass validates the machine class.
-func (c *controller) ValidateMachineClass(_ context.Context, classSpec *v1alpha1.ClassSpec) (*v1alpha1.MachineClass, map[string][]byte, machineutils.RetryPeriod, error) {
-	var (
-		machineClass *v1alpha1.MachineClass
-		err          error
-		retry        = machineutils.LongRetry
-	)
-
-	machineClass, err = c.machineClassLister.MachineClasses(c.namespace).Get(classSpec.Name)
+// ValidateMachineClass validates the machine class and records the result in its status.
+func (c *controller) ValidateMachineClass(ctx context.Context, classSpec *v1alpha1.ClassSpec) (*v1alpha1.MachineClass, map[string][]byte, machineutils.RetryPeriod, error) {
+	machineClass, err := c.machineClassLister.MachineClasses(c.namespace).Get(classSpec.Name)
 	if err != nil {
 		klog.Errorf("MachineClass %s/%s not found. Skipping. %v", c.namespace, classSpec.Name, err)
+		return nil, nil, machineutils.LongRetry, err
+	}
+
+	secretData, retry, err := c.validateMachineClass(machineClass)
+	c.recordMachineClassValidation(ctx, machineClass, err)
+	if err != nil {
 		return nil, nil, retry, err
 	}
 
+	return machineClass, secretData, retry, nil
+}
+
+// validateMachineClass validates the machine class and returns its secret data.
+func (c *controller) validateMachineClass(machineClass *v1alpha1.MachineClass) (map[string][]byte, machineutils.RetryPeriod, error) {
+	retry := machineutils.LongRetry
+
 	internalMachineClass := &machineapi.MachineClass{}
-	err = c.internalExternalScheme.Convert(machineClass, internalMachineClass, nil)
+	err := c.internalExternalScheme.Convert(machineClass, internalMachineClass, nil)
 	if err != nil {
 		klog.Warning("Error in scheme conversion")
-		return nil, nil, retry, err
+		return nil, retry, err
 	}
 
 	secretData, err := c.getSecretData(machineClass.Name, machineClass.SecretRef, machineClass.CredentialsSecretRef)
 	if err != nil {
 		klog.V(2).Infof("Could not compute secret data: %+v", err)
-		return nil, nil, retry, err
+		return nil, retry, err
 	}
 
 	if finalizers := sets.NewString(machineClass.Finalizers...); !finalizers.Has(MCMFinalizerName) {
@@ -105,16 +117,16 @@ func (c *controller) ValidateMachineClass(_ context.Context, classSpec *v1alpha1
 		errMessage := fmt.Sprintf("The machine class %s has no finalizers set. So not reconciling the machine.", machineClass.Name)
 		err := errors.New(errMessage)
 
-		return nil, nil, machineutils.ShortRetry, err
+		return nil, machineutils.ShortRetry, err
 	}
 
 	err = c.validateNodeTemplate(machineClass.NodeTemplate)
 	if err != nil {
 		klog.Warning(err)
-		return nil, nil, machineutils.ShortRetry, err
+		return nil, machineutils.ShortRetry, err
 	}
 
-	return machineClass, secretData, retry, nil
+	return secretData, retry, nil
 }
 
 func (c *controller) getSecretData(machineClassName string, secretRefs ...*v1.SecretReference) (map[string][]byte, error) {
@@ -139,32 +151,18 @@ func (c *controller) getSecretData(machineClassName string, secretRefs ...*v1.Se
 	return secretData, nil
 }
 
-// validateNodeTemplate validates the optional nodeTemplate field is configured in the MachineClass
+// validateNodeTemplate validates the optional nodeTemplate field is configured in the MachineClass, with the same
+// validation as the admission webhook
 func (c *controller) validateNodeTemplate(nodeTemplate *v1alpha1.NodeTemplate) error {
-	var allErr []error
-	capacityAttributes := []v1.ResourceName{"cpu", "gpu", "memory"}
-
 	if nodeTemplate == nil {
 		return nil
 	}
 
-	for _, attribute := range capacityAttributes {
-		if _, ok := nodeTemplate.Capacity[attribute]; !ok {
-			err := errors.New("MachineClass NodeTemplate Capacity should mandatorily have CPU, GPU and Memory configured")
-			allErr = append(allErr, err)
-		}
-	}
-
-	if nodeTemplate.InstanceType == "" || nodeTemplate.Region == "" || nodeTemplate.Zone == "" {
-		err := errors.New("MachineClass NodeTemplate Instance Type, region and zone cannot be empty")
-		allErr = append(allErr, err)
-	}
-
-	if allErr != nil {
-		return fmt.Errorf("%s", allErr)
+	internalNodeTemplate := &machineapi.NodeTemplate{}
+	if err := c.internalExternalScheme.Convert(nodeTemplate, internalNodeTemplate, nil); err != nil {
+		return err
 	}
-
-	return nil
+	return validation.ValidateNodeTemplate(internalNodeTemplate, field.NewPath("nodeTemplate")).ToAggregate()
 }
 
 // getSecret retrieves the kubernetes secret if found
@@ -557,7 +555,7 @@ func (c *controller) machineStatusUpdate(
 		return machineutils.ShortRetry, nil
 	}
 
-	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 	if err != nil {
 		// Keep retrying until update goes through
 		klog.Warningf("Machine/status UPDATE failed for machine %q. Retrying, error: %s", machine.Name, err)
@@ -784,7 +782,7 @@ func (c *controller) reconcileMachineHealth(ctx context.Context, machine *v1alph
 	}
 
 	if cloneDirty {
-		_, err = c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+		_, err = c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 		if err != nil {
 			// Keep retrying across reconciles until update goes through
 			klog.Errorf("Update of Phase/Conditions failed for machine %q. Retrying, error: %q", machine.Name, err)
@@ -928,7 +926,7 @@ func (c *controller) setMachineTerminationStatus(ctx context.Context, deleteMach
 		LastUpdateTime: metav1.Now(),
 	}
 
-	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 	if err != nil {
 		// Keep retrying until update goes through
 		klog.Errorf("Machine/status UPDATE failed for machine %q. Retrying, error: %s", deleteMachineRequest.Machine.Name, err)
@@ -1045,6 +1043,35 @@ func printLogInitError(s string, err *error, description *string, machine *v1alp
 	*description = fmt.Sprintf(s+" %s", machineutils.InitiateVMDeletion)
 }
 
+// newDrainOptions returns the options to drain the given node, evicting pods with respect to their
+// PodDisruptionBudgets and waiting for the detachment of their volumes
+func (c *controller) newDrainOptions(nodeName string, timeOutDuration time.Duration, maxEvictRetries int32, forceDeletePods bool, buf, errBuf *bytes.Buffer) *drain.Options {
+	return drain.NewDrainOptions(
+		c.targetCoreClient,
+		c.targetKubernetesVersion,
+		timeOutDuration,
+		maxEvictRetries,
+		c.safetyOptions.PvDetachTimeout.Duration,
+		c.safetyOptions.PvReattachTimeout.Duration,
+		nodeName,
+		-1,
+		forceDeletePods,
+		true,
+		true,
+		true,
+		buf,
+		errBuf,
+		c.driver,
+		c.pvcLister,
+		c.pvLister,
+		c.pdbLister,
+		c.nodeLister,
+		c.podLister,
+		c.volumeAttachmentHandler,
+		c.podSynced,
+	)
+}
+
 // drainNode attempts to drain the node backed by the machine object
 func (c *controller) drainNode(ctx context.Context, deleteMachineRequest *driver.DeleteMachineRequest) (machineutils.RetryPeriod, error) {
 	var (
@@ -1061,10 +1088,8 @@ func (c *controller) drainNode(ctx context.Context, deleteMachineRequest *driver
 		// Initialization
 		machine                                      = deleteMachineRequest.Machine
 		maxEvictRetries                              = int32(math.Min(float64(*c.getEffectiveMaxEvictRetries(machine)), c.getEffectiveDrainTimeout(machine).Seconds()/drain.PodEvictionRetryInterval.Seconds()))
-		pvDetachTimeOut                              = c.safetyOptions.PvDetachTimeout.Duration
-		pvReattachTimeOut                            = c.safetyOptions.PvReattachTimeout.Duration
 		timeOutDuration                              = c.getEffectiveDrainTimeout(deleteMachineRequest.Machine).Duration
-		forceDeleteLabelPresent                      = machine.Labels["force-deletion"] == "True"
+		forceDeleteLabelPresent                      = machine.Labels[machineutils.ForceDeletion] == "True"
 		nodeName                                     = machine.Labels[v1alpha1.NodeLabelKey]
 		nodeNotReadyDuration                         = 5 * time.Minute
 		ReadonlyFilesystem      v1.NodeConditionType = "ReadonlyFilesystem"
@@ -1090,7 +1115,21 @@ func (c *controller) drainNode(ctx context.Context, deleteMachineRequest *driver
 			klog.Warningf("(drainNode) Node %q for machine %q doesn't exist, so drain will finish instantly", nodeName, machine.Name)
 		}
 
-		if !isConditionEmpty(nodeReadyCondition) && (nodeReadyCondition.Status != v1.ConditionTrue) && (time.Since(nodeReadyCondition.LastTransitionTime.Time) > nodeNotReadyDuration) {
+		if c.safetyOptions.EnableOutOfServiceTaint && !isConditionEmpty(nodeReadyCondition) && nodeReadyCondition.Status != v1.ConditionTrue {
+			tainted, taintErr := c.taintNodeOutOfServiceIfVMDown(ctx, deleteMachineRequest, nodeName)
+			if taintErr != nil {
+				klog.Warningf("(drainNode) Could not apply out-of-service taint on node %q for machine %q, continuing with drain: %s", nodeName, machine.Name, taintErr)
+			} else if tainted {
+				// kube-controller-manager force deletes the pods and detaches the volumes of out-of-service nodes
+				description = fmt.Sprintf("Node %q tainted with %q as VM is missing or stopped, skipping drain. %s", nodeName, v1.TaintNodeOutOfService, machineutils.DelVolumesAttachments)
+				err = fmt.Errorf("%s", description)
+				skipDrain = true
+			}
+		}
+
+		if skipDrain {
+			klog.V(3).Infof("(drainNode) Skipping drain of out-of-service node %q for machine %q", nodeName, machine.Name)
+		} else if !isConditionEmpty(nodeReadyCondition) && (nodeReadyCondition.Status != v1.ConditionTrue) && (time.Since(nodeReadyCondition.LastTransitionTime.Time) > nodeNotReadyDuration) {
 			message := "Setting forceDeletePods & forceDeleteMachine to true for drain as machine is NotReady for over 5min"
 			forceDeleteMachine = true
 			forceDeletePods = true
@@ -1155,30 +1194,9 @@ func (c *controller) drainNode(ctx context.Context, deleteMachineRequest *driver
 			buf := bytes.NewBuffer([]byte{})
 			errBuf := bytes.NewBuffer([]byte{})
 
-			drainOptions := drain.NewDrainOptions(
-				c.targetCoreClient,
-				c.targetKubernetesVersion,
-				timeOutDuration,
-				maxEvictRetries,
-				pvDetachTimeOut,
-				pvReattachTimeOut,
-				nodeName,
-				-1,
-				forceDeletePods,
-				true,
-				true,
-				true,
-				buf,
-				errBuf,
-				c.driver,
-				c.pvcLister,
-				c.pvLister,
-				c.pdbLister,
-				c.nodeLister,
-				c.podLister,
-				c.volumeAttachmentHandler,
-				c.podSynced,
-			)
+			drainOptions := c.newDrainOptions(nodeName, timeOutDuration, maxEvictRetries, forceDeletePods, buf, errBuf)
+			// DaemonSet pods opted in for eviction get a chance to terminate gracefully, unless draining forcefully
+			drainOptions.EvictDaemonSetPods = !forceDeletePods
 			klog.V(3).Infof("(drainNode) Invoking RunDrain, forceDeleteMachine: %t, forceDeletePods: %t, timeOutDuration: %s", forceDeletePods, forceDeleteMachine, timeOutDuration)
 			err = drainOptions.RunDrain(ctx)
 			if err == nil {
@@ -1232,6 +1250,442 @@ func (c *controller) drainNode(ctx context.Context, deleteMachineRequest *driver
 	return machineutils.ShortRetry, err
 }
 
+// taintNodeOutOfServiceIfVMDown puts the out-of-service taint on the node if the driver reports
+// the VM backing the machine as missing or stopped. It returns true if the node carries the taint.
+// The taint goes away along with the node object at the end of the termination flow.
+func (c *controller) taintNodeOutOfServiceIfVMDown(ctx context.Context, deleteMachineRequest *driver.DeleteMachineRequest, nodeName string) (bool, error) {
+	machine := deleteMachineRequest.Machine
+
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		return false, err
+	}
+	if getOutOfServiceTaint(node) != nil {
+		return true, nil
+	}
+
+	resp, err := c.driver.GetMachineStatus(ctx, &driver.GetMachineStatusRequest{
+		Machine:      machine,
+		MachineClass: deleteMachineRequest.MachineClass,
+		Secret:       deleteMachineRequest.Secret,
+	})
+	if err == nil {
+		if resp == nil || !resp.Stopped {
+			return false, nil
+		}
+		klog.V(2).Infof("VM backing machine %q is stopped, tainting node %q with %q", machine.Name, nodeName, v1.TaintNodeOutOfService)
+	} else if machineErr, ok := status.FromError(err); !ok || machineErr.Code() != codes.NotFound {
+		return false, err
+	} else {
+		klog.V(2).Infof("VM backing machine %q is missing, tainting node %q with %q", machine.Name, nodeName, v1.TaintNodeOutOfService)
+	}
+
+	// The time the taint was added bounds the wait for kube-controller-manager to detach the volumes
+	now := metav1.Now()
+	outOfServiceTaint := &v1.Taint{
+		Key:       v1.TaintNodeOutOfService,
+		Value:     machineutils.OutOfServiceTaintValue,
+		Effect:    v1.TaintEffectNoExecute,
+		TimeAdded: &now,
+	}
+	if err := nodeops.AddOrUpdateTaintOnNode(ctx, c.targetCoreClient, nodeName, outOfServiceTaint); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getOutOfServiceTaint returns the out-of-service taint of the node, if any.
+func getOutOfServiceTaint(node *v1.Node) *v1.Taint {
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].Key == v1.TaintNodeOutOfService {
+			return &node.Spec.Taints[i]
+		}
+	}
+	return nil
+}
+
+// isMachineMaintenanceRequested checks if the machine is annotated for maintenance
+func isMachineMaintenanceRequested(machine *v1alpha1.Machine) bool {
+	return machine.Annotations[machineutils.MachineMaintenance] == "true"
+}
+
+// shouldReconcileMachineMaintenance checks if the machine is to be handled by the maintenance flow
+// instead of the health checks. This is the case for machines already in Maintenance phase and
+// for Running or Unknown machines that are annotated for maintenance.
+func shouldReconcileMachineMaintenance(machine *v1alpha1.Machine) bool {
+	switch machine.Status.CurrentStatus.Phase {
+	case v1alpha1.MachineMaintenance:
+		return true
+	case v1alpha1.MachineRunning, v1alpha1.MachineUnknown:
+		return isMachineMaintenanceRequested(machine)
+	default:
+		return false
+	}
+}
+
+// reconcileMachineMaintenance cordons and drains the node backing the machine while it is annotated
+// for maintenance, holding the machine in Maintenance phase. Once the annotation is removed, the node
+// is uncordoned, unless it was already unschedulable before, and the machine is moved back to Running phase.
+func (c *controller) reconcileMachineMaintenance(ctx context.Context, machine *v1alpha1.Machine) (machineutils.RetryPeriod, error) {
+	var (
+		err         error
+		description string
+		state       v1alpha1.MachineState
+		phase       = v1alpha1.MachineMaintenance
+		nodeName    = machine.Labels[v1alpha1.NodeLabelKey]
+	)
+
+	switch {
+	case !isMachineMaintenanceRequested(machine):
+		// Maintenance has been cleared, node is made schedulable again
+		if err = c.restoreNodeSchedulability(ctx, nodeName); err != nil {
+			klog.Warningf("Uncordon failed for machine %q, backing node %q: %v", machine.Name, nodeName, err)
+			description = fmt.Sprintf("Uncordon of node failed due to - %s. Will retry in next sync.", err.Error())
+			state = v1alpha1.MachineStateFailed
+		} else {
+			klog.V(2).Infof("Maintenance ended for machine %q, backing node %q uncordoned", machine.Name, nodeName)
+			description = "Machine maintenance ended. Node uncordoned."
+			state = v1alpha1.MachineStateSuccessful
+			phase = v1alpha1.MachineRunning
+		}
+
+	case machine.Status.CurrentStatus.Phase != v1alpha1.MachineMaintenance:
+		klog.V(2).Infof("Maintenance requested for machine %q, backing node %q", machine.Name, nodeName)
+		if err = c.recordNodeSchedulability(ctx, nodeName); err != nil {
+			klog.Warningf("Recording the schedulability of node %q failed for machine %q: %v", nodeName, machine.Name, err)
+			description = fmt.Sprintf("Recording the schedulability of node failed due to - %s. Will retry in next sync.", err.Error())
+			state = v1alpha1.MachineStateFailed
+			phase = machine.Status.CurrentStatus.Phase
+		} else {
+			description = fmt.Sprintf("Machine maintenance requested. %s", machineutils.InitiateMaintenanceDrain)
+			state = v1alpha1.MachineStateProcessing
+			err = fmt.Errorf("%s", description)
+		}
+
+	case machine.Status.LastOperation.Type == v1alpha1.MachineOperationMaintenance &&
+		machine.Status.LastOperation.State == v1alpha1.MachineStateSuccessful:
+		// Node has already been drained, nothing to do until maintenance is cleared
+		return machineutils.LongRetry, nil
+
+	default:
+		var (
+			maxEvictRetries = int32(math.Min(float64(*c.getEffectiveMaxEvictRetries(machine)), c.getEffectiveDrainTimeout(machine).Seconds()/drain.PodEvictionRetryInterval.Seconds()))
+			timeOutDuration = c.getEffectiveDrainTimeout(machine).Duration
+			buf             = bytes.NewBuffer([]byte{})
+			errBuf          = bytes.NewBuffer([]byte{})
+		)
+
+		// The node stays in the cluster, hence DaemonSet pods are not evicted
+		drainOptions := c.newDrainOptions(nodeName, timeOutDuration, maxEvictRetries, false, buf, errBuf)
+		klog.V(3).Infof("(reconcileMachineMaintenance) Invoking RunDrain for machine %q, timeOutDuration: %s, maxEvictRetries: %d", machine.Name, timeOutDuration, maxEvictRetries)
+		if err = drainOptions.RunDrain(ctx); err != nil {
+			klog.Warningf("Maintenance drain failed for machine %q, backing node %q. \nBuf:%v \nErrBuf:%v \nErr-Message:%v", machine.Name, nodeName, buf, errBuf, err)
+			description = fmt.Sprintf("Maintenance drain failed due to - %s. Will retry in next sync. %s", err.Error(), machineutils.InitiateMaintenanceDrain)
+			state = v1alpha1.MachineStateFailed
+		} else {
+			klog.V(2).Infof("Maintenance drain successful for machine %q, backing node %q. \nBuf:%v \nErrBuf:%v", machine.Name, nodeName, buf, errBuf)
+			description = "Maintenance drain successful. Node is cordoned until maintenance is cleared."
+			state = v1alpha1.MachineStateSuccessful
+		}
+	}
+
+	lastOperation := machine.Status.LastOperation
+	if machine.Status.CurrentStatus.Phase == phase &&
+		lastOperation.Type == v1alpha1.MachineOperationMaintenance &&
+		lastOperation.State == state &&
+		lastOperation.Description == description {
+		// Status is unchanged, e.g. when a failed drain keeps failing for the same reason
+		if err != nil {
+			return machineutils.ShortRetry, err
+		}
+		return machineutils.LongRetry, nil
+	}
+
+	currentStatus := machine.Status.CurrentStatus
+	if currentStatus.Phase != phase {
+		currentStatus = v1alpha1.CurrentStatus{
+			Phase:          phase,
+			LastUpdateTime: metav1.Now(),
+		}
+	}
+
+	updateRetryPeriod, updateErr := c.machineStatusUpdate(
+		ctx,
+		machine,
+		v1alpha1.LastOperation{
+			Description:    description,
+			State:          state,
+			Type:           v1alpha1.MachineOperationMaintenance,
+			LastUpdateTime: metav1.Now(),
+		},
+		currentStatus,
+		machine.Status.LastKnownState,
+	)
+	if updateErr != nil {
+		return updateRetryPeriod, updateErr
+	}
+
+	if err != nil {
+		return machineutils.ShortRetry, err
+	}
+	return machineutils.LongRetry, nil
+}
+
+// recordNodeSchedulability annotates the node with whether it is unschedulable before it is cordoned for maintenance.
+// An existing record is kept, as the node might already have been cordoned by a previous attempt.
+func (c *controller) recordNodeSchedulability(ctx context.Context, nodeName string) error {
+	node, err := c.nodeLister.Get(nodeName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if _, ok := node.Annotations[machineutils.UnschedulableBeforeMaintenance]; ok {
+		return nil
+	}
+	return c.updateNodeWithAnnotations(ctx, node.DeepCopy(), map[string]string{
+		machineutils.UnschedulableBeforeMaintenance: strconv.FormatBool(node.Spec.Unschedulable),
+	})
+}
+
+// restoreNodeSchedulability uncordons the node after maintenance, unless it was recorded to be unschedulable
+// before, and removes the record.
+func (c *controller) restoreNodeSchedulability(ctx context.Context, nodeName string) error {
+	node, err := c.nodeLister.Get(nodeName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	wasUnschedulable, recorded := node.Annotations[machineutils.UnschedulableBeforeMaintenance]
+	if !recorded && !node.Spec.Unschedulable {
+		return nil
+	}
+
+	clone := node.DeepCopy()
+	if wasUnschedulable != "true" {
+		clone.Spec.Unschedulable = false
+	}
+	delete(clone.Annotations, machineutils.UnschedulableBeforeMaintenance)
+	_, err = c.targetCoreClient.CoreV1().Nodes().Update(ctx, clone, metav1.UpdateOptions{})
+	return err
+}
+
+// isMachineStandbyRequested checks if the machine is annotated to be part of a warm pool
+func isMachineStandbyRequested(machine *v1alpha1.Machine) bool {
+	return machine.Annotations[machineutils.MachineStandby] == "true"
+}
+
+// isMachineStopUnsupported checks if the driver refused to stop the VM of the machine before
+func isMachineStopUnsupported(machine *v1alpha1.Machine) bool {
+	lastOperation := machine.Status.LastOperation
+	return lastOperation.Type == v1alpha1.MachineOperationStop &&
+		lastOperation.State == v1alpha1.MachineStateFailed &&
+		lastOperation.ErrorCode == codes.Unimplemented.String()
+}
+
+// shouldReconcileMachineStandby checks if the machine is to be handled by the standby flow instead of
+// the health checks. This is the case for machines already in Standby phase and for Running machines
+// that are annotated for standby, unless the driver does not support stopping VMs. It is also the case
+// for Running machines which are no longer annotated for standby, but whose node is still tainted for it.
+func (c *controller) shouldReconcileMachineStandby(machine *v1alpha1.Machine) bool {
+	switch machine.Status.CurrentStatus.Phase {
+	case v1alpha1.MachineStandby:
+		return true
+	case v1alpha1.MachineRunning:
+		if isMachineStandbyRequested(machine) {
+			return !isMachineStopUnsupported(machine)
+		}
+		return c.isNodeTaintedForStandby(machine)
+	default:
+		return false
+	}
+}
+
+// isNodeTaintedForStandby checks if the node backing the machine has the standby taint
+func (c *controller) isNodeTaintedForStandby(machine *v1alpha1.Machine) bool {
+	node, err := c.nodeLister.Get(machine.Labels[v1alpha1.NodeLabelKey])
+	if err != nil {
+		return false
+	}
+	return hasStandbyTaint(node)
+}
+
+// hasStandbyTaint checks if the node has the standby taint
+func hasStandbyTaint(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == machineutils.MachineStandby {
+			return true
+		}
+	}
+	return false
+}
+
+// newStandbyTaint returns the taint keeping pods off the nodes of the warm pool
+func newStandbyTaint() *v1.Taint {
+	return &v1.Taint{
+		Key:    machineutils.MachineStandby,
+		Value:  "true",
+		Effect: v1.TaintEffectNoSchedule,
+	}
+}
+
+// taintNodeForStandby taints the node backing a machine annotated for standby as soon as the node has registered.
+// As kubelet registers the node with the not-ready taint, no pods are scheduled to the node before its VM is stopped.
+func (c *controller) taintNodeForStandby(ctx context.Context, machine *v1alpha1.Machine) (machineutils.RetryPeriod, error) {
+	nodeName := getNodeName(machine)
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// node has not registered yet
+			return machineutils.LongRetry, nil
+		}
+		return machineutils.ShortRetry, err
+	}
+	if hasStandbyTaint(node) {
+		return machineutils.LongRetry, nil
+	}
+	if err := nodeops.AddOrUpdateTaintOnNode(ctx, c.targetCoreClient, nodeName, newStandbyTaint()); err != nil {
+		klog.Warningf("Tainting node %q for standby of machine %q failed: %v", nodeName, machine.Name, err)
+		return machineutils.ShortRetry, err
+	}
+	klog.V(2).Infof("Tainted node %q of machine %q for standby", nodeName, machine.Name)
+	return machineutils.LongRetry, nil
+}
+
+// reconcileMachineStandby ensures the node backing the machine is tainted and stops its VM while it is annotated
+// for standby, holding the machine in Standby phase. Once the annotation is removed, the taint is removed and
+// the VM is started again. The machine is moved to Pending phase, so the creation timeout applies until
+// the node is ready again.
+func (c *controller) reconcileMachineStandby(ctx context.Context, machine *v1alpha1.Machine, machineClass *v1alpha1.MachineClass, secret *v1.Secret) (machineutils.RetryPeriod, error) {
+	var (
+		err           error
+		description   string
+		errorCode     string
+		state         v1alpha1.MachineState
+		operationType v1alpha1.MachineOperationType
+		phase         = machine.Status.CurrentStatus.Phase
+		nodeName      = machine.Labels[v1alpha1.NodeLabelKey]
+		standbyTaint  = newStandbyTaint()
+	)
+
+	switch {
+	case isMachineStandbyRequested(machine) && phase == v1alpha1.MachineStandby:
+		// VM has already been stopped, nothing to do until standby is cleared
+		return machineutils.LongRetry, nil
+
+	case isMachineStandbyRequested(machine):
+		operationType = v1alpha1.MachineOperationStop
+		if err = nodeops.AddOrUpdateTaintOnNode(ctx, c.targetCoreClient, nodeName, standbyTaint); err != nil {
+			klog.Warningf("Tainting node %q for standby of machine %q failed: %v", nodeName, machine.Name, err)
+			description = fmt.Sprintf("Tainting of node for standby failed due to - %s. Will retry in next sync. %s", err.Error(), machineutils.InitiateVMStop)
+			state = v1alpha1.MachineStateFailed
+			break
+		}
+		_, err = driver.StopMachine(ctx, c.driver, &driver.StopMachineRequest{
+			Machine:      machine,
+			MachineClass: machineClass,
+			Secret:       secret,
+		})
+		if err == nil {
+			klog.V(2).Infof("VM of machine %q stopped, backing node %q is on standby", machine.Name, nodeName)
+			description = "VM stopped. Machine is on standby."
+			state = v1alpha1.MachineStateSuccessful
+			phase = v1alpha1.MachineStandby
+			break
+		}
+		state = v1alpha1.MachineStateFailed
+		machineErr, _ := status.FromError(err)
+		errorCode = machineErr.Code().String()
+		switch machineErr.Code() {
+		case codes.Unimplemented:
+			klog.V(2).Infof("Driver does not support stopping VMs, machine %q is kept running on standby", machine.Name)
+			description = "Stopping VMs is not supported by driver. Machine is kept running with its node tainted for standby."
+			err = nil
+		case codes.NotFound:
+			klog.Warningf("VM of machine %q to be stopped was not found: %v", machine.Name, err)
+			description = fmt.Sprintf("VM stop failed due to - %s. Machine is unhealthy - changing MachinePhase to Unknown.", err.Error())
+			phase = v1alpha1.MachineUnknown
+		default:
+			klog.Warningf("VM stop failed for machine %q: %v", machine.Name, err)
+			description = fmt.Sprintf("VM stop failed due to - %s. Will retry in next sync. %s", err.Error(), machineutils.InitiateVMStop)
+		}
+
+	default:
+		// Standby has been cleared, the VM is started and the node is made schedulable again
+		operationType = v1alpha1.MachineOperationStart
+		if err = nodeops.RemoveTaintOffNode(ctx, c.targetCoreClient, nodeName, nil, standbyTaint); err != nil && !apierrors.IsNotFound(err) {
+			klog.Warningf("Removing standby taint off node %q of machine %q failed: %v", nodeName, machine.Name, err)
+			description = fmt.Sprintf("Removing standby taint off node failed due to - %s. Will retry in next sync.", err.Error())
+			state = v1alpha1.MachineStateFailed
+			break
+		}
+		if phase != v1alpha1.MachineStandby {
+			// The VM was kept running, as the driver does not support stopping it
+			klog.V(2).Infof("Standby of running machine %q cleared, removed taint off backing node %q", machine.Name, nodeName)
+			description = "Standby cleared. Node is schedulable again."
+			state = v1alpha1.MachineStateSuccessful
+			break
+		}
+		_, err = driver.StartMachine(ctx, c.driver, &driver.StartMachineRequest{
+			Machine:      machine,
+			MachineClass: machineClass,
+			Secret:       secret,
+		})
+		if err == nil {
+			klog.V(2).Infof("VM of machine %q started, waiting for backing node %q to be ready", machine.Name, nodeName)
+			description = "VM started. Waiting for node to be ready."
+			state = v1alpha1.MachineStateProcessing
+			phase = v1alpha1.MachinePending
+			break
+		}
+		state = v1alpha1.MachineStateFailed
+		machineErr, _ := status.FromError(err)
+		errorCode = machineErr.Code().String()
+		if machineErr.Code() == codes.NotFound {
+			klog.Warningf("VM of machine %q to be started was not found: %v", machine.Name, err)
+			description = fmt.Sprintf("VM start failed due to - %s. Machine is unhealthy - changing MachinePhase to Unknown.", err.Error())
+			phase = v1alpha1.MachineUnknown
+		} else {
+			klog.Warningf("VM start failed for machine %q: %v", machine.Name, err)
+			description = fmt.Sprintf("VM start failed due to - %s. Will retry in next sync.", err.Error())
+		}
+	}
+
+	currentStatus := machine.Status.CurrentStatus
+	if currentStatus.Phase != phase {
+		currentStatus = v1alpha1.CurrentStatus{
+			Phase:          phase,
+			TimeoutActive:  phase == v1alpha1.MachinePending,
+			LastUpdateTime: metav1.Now(),
+		}
+	}
+
+	updateRetryPeriod, updateErr := c.machineStatusUpdate(
+		ctx,
+		machine,
+		v1alpha1.LastOperation{
+			Description:    description,
+			ErrorCode:      errorCode,
+			State:          state,
+			Type:           operationType,
+			LastUpdateTime: metav1.Now(),
+		},
+		currentStatus,
+		machine.Status.LastKnownState,
+	)
+	if updateErr != nil {
+		return updateRetryPeriod, updateErr
+	}
+
+	if err != nil {
+		return machineutils.ShortRetry, err
+	}
+	return machineutils.LongRetry, nil
+}
+
 // deleteNodeVolAttachments deletes VolumeAttachment(s) for a node before moving to VM deletion stage.
 func (c *controller) deleteNodeVolAttachments(ctx context.Context, deleteMachineRequest *driver.DeleteMachineRequest) (machineutils.RetryPeriod, error) {
 	var (
@@ -1255,6 +1709,14 @@ func (c *controller) deleteNodeVolAttachments(ctx context.Context, deleteMachine
 		description = fmt.Sprintf("Node Volumes for node: %s are already detached. Moving to VM Deletion. %s", nodeName, machineutils.InitiateVMDeletion)
 		state = v1alpha1.MachineStateProcessing
 		retryPeriod = 0
+	} else if taint := getOutOfServiceTaint(node); taint != nil {
+		// kube-controller-manager force detaches the volumes of out-of-service nodes
+		if taint.TimeAdded == nil || time.Since(taint.TimeAdded.Time) < c.safetyOptions.PvDetachTimeout.Duration {
+			klog.V(3).Infof("(deleteNodeVolAttachments) Waiting for kube-controller-manager to detach #%d volume(s) of out-of-service node %q, machine %q", len(node.Status.VolumesAttached), nodeName, machine.Name)
+			return retryPeriod, nil
+		}
+		description = fmt.Sprintf("Volumes of out-of-service node %s not detached within %s. Moving to VM Deletion. %s", nodeName, c.safetyOptions.PvDetachTimeout.Duration, machineutils.InitiateVMDeletion)
+		state = v1alpha1.MachineStateProcessing
 	} else {
 		// case: where node.Status.VolumesAttached > 0
 		liveNodeVolAttachments, err := getLiveVolumeAttachmentsForNode(c.volumeAttachementLister, nodeName, machine.Name)
@@ -1271,7 +1733,22 @@ func (c *controller) deleteNodeVolAttachments(ctx context.Context, deleteMachine
 			}
 			return retryPeriod, nil
 		}
-		description = fmt.Sprintf("No Live VolumeAttachments for node: %s. Moving to VM Deletion. %s", nodeName, machineutils.InitiateVMDeletion)
+		detachingNodeVolAttachments, err := getDetachingVolumeAttachmentsForNode(c.volumeAttachementLister, nodeName, machine.Name)
+		if err != nil {
+			klog.Errorf("(deleteNodeVolAttachments) Error obtaining VolumeAttachment(s) for node %q, machine %q: %s", nodeName, machine.Name, err)
+			return retryPeriod, err
+		}
+		if len(detachingNodeVolAttachments) != 0 {
+			// give the volumes the PV detach timeout since their VolumeAttachments were deleted
+			// to be detached before the driver force detaches them
+			if detachStartedOn := getEarliestDeletionTimestamp(detachingNodeVolAttachments); time.Since(detachStartedOn) < c.safetyOptions.PvDetachTimeout.Duration {
+				klog.V(3).Infof("(deleteNodeVolAttachments) Waiting for #%d VolumeAttachment(s) of node %q, machine %q to be detached", len(detachingNodeVolAttachments), nodeName, machine.Name)
+				return retryPeriod, nil
+			}
+			description = c.detachStuckVolumes(ctx, deleteMachineRequest, detachingNodeVolAttachments)
+		} else {
+			description = fmt.Sprintf("No Live VolumeAttachments for node: %s. Moving to VM Deletion. %s", nodeName, machineutils.InitiateVMDeletion)
+		}
 		state = v1alpha1.MachineStateProcessing
 	}
 	now := metav1.Now()
@@ -1296,6 +1773,64 @@ func (c *controller) deleteNodeVolAttachments(ctx context.Context, deleteMachine
 	return retryPeriod, err
 }
 
+// detachStuckVolumes asks the driver to detach the volumes of the VolumeAttachments which are still attached
+// to the node after the PV detach timeout. The outcome is recorded in metrics and the returned description.
+// Deletion of the VM proceeds irrespective of the outcome.
+func (c *controller) detachStuckVolumes(ctx context.Context, deleteMachineRequest *driver.DeleteMachineRequest, volAttachments []*storagev1.VolumeAttachment) string {
+	var (
+		machine   = deleteMachineRequest.Machine
+		volumeIDs []string
+		err       error
+	)
+
+	pvSpecs := make([]*v1.PersistentVolumeSpec, 0, len(volAttachments))
+	for _, va := range volAttachments {
+		if va.Spec.Source.PersistentVolumeName == nil {
+			continue
+		}
+		pv, pvErr := c.pvLister.Get(*va.Spec.Source.PersistentVolumeName)
+		if pvErr != nil {
+			klog.Warningf("(detachStuckVolumes) Error fetching PV %q for machine %q: %s", *va.Spec.Source.PersistentVolumeName, machine.Name, pvErr)
+			continue
+		}
+		pvSpecs = append(pvSpecs, &pv.Spec)
+	}
+
+	if len(pvSpecs) != 0 {
+		var getVolumeIDsResponse *driver.GetVolumeIDsResponse
+		getVolumeIDsResponse, err = c.driver.GetVolumeIDs(ctx, &driver.GetVolumeIDsRequest{PVSpecs: pvSpecs})
+		if err == nil {
+			volumeIDs = getVolumeIDsResponse.VolumeIDs
+		}
+	}
+
+	if err == nil && len(volumeIDs) != 0 {
+		_, err = driver.DetachVolumes(ctx, c.driver, &driver.DetachVolumesRequest{
+			Machine:      machine,
+			MachineClass: deleteMachineRequest.MachineClass,
+			Secret:       deleteMachineRequest.Secret,
+			VolumeIDs:    volumeIDs,
+		})
+	}
+
+	if err == nil && len(volumeIDs) == 0 {
+		klog.Warningf("(detachStuckVolumes) No volumeIDs found for #%d VolumeAttachment(s) stuck in detachment for machine %q", len(volAttachments), machine.Name)
+		return fmt.Sprintf("No volumeIDs found for VolumeAttachments stuck in detachment. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion)
+	} else if err == nil {
+		klog.V(2).Infof("(detachStuckVolumes) Driver detached volumes %v stuck in detachment for machine %q", volumeIDs, machine.Name)
+		metrics.MachineVolumeDetachCount.With(prometheus.Labels{"result": "succeeded"}).Inc()
+		return fmt.Sprintf("Volumes %v still attached after PV detach timeout were detached by driver. Moving to VM Deletion. %s", volumeIDs, machineutils.InitiateVMDeletion)
+	} else if machineErr, ok := status.FromError(err); ok && machineErr.Code() == codes.Unimplemented {
+		klog.V(3).Infof("(detachStuckVolumes) Driver does not support detaching volumes for machine %q", machine.Name)
+		metrics.MachineVolumeDetachCount.With(prometheus.Labels{"result": "unimplemented"}).Inc()
+		return fmt.Sprintf("Volumes still attached after PV detach timeout, detaching volumes is not supported by driver. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion)
+	}
+
+	klog.Warningf("(detachStuckVolumes) Driver failed to detach volumes %v stuck in detachment for machine %q: %s", volumeIDs, machine.Name, err)
+	metrics.MachineVolumeDetachCount.With(prometheus.Labels{"result": "failed"}).Inc()
+	return fmt.Sprintf("Detaching volumes still attached after PV detach timeout failed due to - %s. Moving to VM Deletion. %s", err.Error(), machineutils.InitiateVMDeletion)
+}
+
 // deleteVM attempts to delete the VM backed by the machine object
 func (c *controller) deleteVM(ctx context.Context, deleteMachineRequest *driver.DeleteMachineRequest) (machineutils.RetryPeriod, error) {
 	var (
@@ -1536,7 +2071,7 @@ func (c *controller) updateMachineToFailedState(ctx context.Context, description
 		LastUpdateTime: metav1.Now(),
 	}
 
-	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{})
+	_, err := c.controlMachineClient.Machines(clone.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
 	updated := false
 	if err != nil {
 		// Keep retrying until update goes through
@@ -1637,7 +2172,9 @@ func setTerminationReasonByPhase(phase v1alpha1.MachinePhase, terminationConditi
 
 func (c *controller) tryMarkingMachineFailed(ctx context.Context, machine, clone *v1alpha1.Machine, machineDeployName, description string, lockAcquireTimeout time.Duration) (machineutils.RetryPeriod, error) {
 	if c.permitGiver.TryPermit(machineDeployName, lockAcquireTimeout) {
+		c.permitHolders.Store(machineDeployName, machine.Name)
 		defer c.permitGiver.ReleasePermit(machineDeployName)
+		defer c.permitHolders.Delete(machineDeployName)
 		markable, err := c.canMarkMachineFailed(machineDeployName, machine.Name, machine.Namespace, maxReplacements)
 		if err != nil {
 			klog.Errorf("Couldn't check if machine can be marked as Failed. Error: %q", err)
@@ -1680,6 +2217,30 @@ func getLiveVolumeAttachmentsForNode(volAttachLister storagelisters.VolumeAttach
 	return nodeVolAttachments, nil
 }
 
+func getDetachingVolumeAttachmentsForNode(volAttachLister storagelisters.VolumeAttachmentLister, nodeName string, machineName string) ([]*storagev1.VolumeAttachment, error) {
+	volAttachments, err := volAttachLister.List(labels.NewSelector())
+	if err != nil {
+		return nil, fmt.Errorf("cant list volume attachments for node %q, machine %q: %w", nodeName, machineName, err)
+	}
+	nodeVolAttachments := make([]*storagev1.VolumeAttachment, 0, len(volAttachments))
+	for _, va := range volAttachments {
+		if va.Spec.NodeName == nodeName && va.ObjectMeta.DeletionTimestamp != nil && va.Status.Attached {
+			nodeVolAttachments = append(nodeVolAttachments, va)
+		}
+	}
+	return nodeVolAttachments, nil
+}
+
+func getEarliestDeletionTimestamp(volAttachments []*storagev1.VolumeAttachment) time.Time {
+	earliest := volAttachments[0].DeletionTimestamp.Time
+	for _, va := range volAttachments[1:] {
+		if va.DeletionTimestamp.Time.Before(earliest) {
+			earliest = va.DeletionTimestamp.Time
+		}
+	}
+	return earliest
+}
+
 func deleteVolumeAttachmentsForNode(ctx context.Context, attachIf storageclient.VolumeAttachmentInterface, nodeName string, volAttachments []*storagev1.VolumeAttachment) error {
 	klog.V(3).Infof("(deleteVolumeAttachmentsForNode) Deleting #%d VolumeAttachment(s) for node %q", len(volAttachments), nodeName)
 	var errs []error
@@ -14,14 +14,22 @@ import (
 	machinev1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/gardener/machine-controller-manager/pkg/fakeclient"
 	"github.com/gardener/machine-controller-manager/pkg/util/permits"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/codes"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machinecodes/status"
 	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	coreinformers "k8s.io/client-go/informers"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -2011,7 +2019,7 @@ var _ = Describe("machine_util", func() {
 					Expect(err).To(BeNil())
 				} else {
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(Equal(data.expect.err))
+					Expect(err).To(MatchError(data.expect.err.Error()))
 				}
 			},
 			Entry("MachineClass with proper nodetemplate field", &data{
@@ -2056,7 +2064,7 @@ var _ = Describe("machine_util", func() {
 					},
 				},
 				expect: expect{
-					err: errors.New("[MachineClass NodeTemplate Capacity should mandatorily have CPU, GPU and Memory configured]"),
+					err: errors.New("nodeTemplate.capacity[cpu]: Required value: Capacity of cpu is required"),
 				},
 			}),
 			Entry("MachineClass with missing region in nodetemplate attribute", &data{
@@ -2078,12 +2086,136 @@ var _ = Describe("machine_util", func() {
 					},
 				},
 				expect: expect{
-					err: errors.New("[MachineClass NodeTemplate Instance Type, region and zone cannot be empty]"),
+					err: errors.New("nodeTemplate.region: Required value: Region is required"),
+				},
+			}),
+		)
+	})
+
+	Describe("#taintNodeOutOfServiceIfVMDown", func() {
+		type setup struct {
+			nodeTaints []corev1.Taint
+			vmExists   bool
+			vmStopped  bool
+			driverErr  error
+		}
+		type expect struct {
+			tainted bool
+			err     bool
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table",
+			func(data *data) {
+				stop := make(chan struct{})
+				defer close(stop)
+
+				machine := newMachine(
+					&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+					&machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineTerminating}},
+					nil, nil, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+				node := newNode(1, nil, nil, &corev1.NodeSpec{Taints: data.setup.nodeTaints}, &corev1.NodeStatus{})
+
+				fakeDriver := driver.NewFakeDriver(data.setup.vmExists, "fakeID-0", "node-0", "", data.setup.driverErr, nil)
+				fakeDriver.(*driver.FakeDriver).VMStopped = data.setup.vmStopped
+				c, trackers := createController(stop, testNamespace, []runtime.Object{machine}, nil, []runtime.Object{node}, fakeDriver)
+				defer trackers.Stop()
+				waitForCacheSync(stop, c)
+
+				tainted, err := c.taintNodeOutOfServiceIfVMDown(context.TODO(), &driver.DeleteMachineRequest{Machine: machine}, "node-0")
+				if data.expect.err {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(tainted).To(Equal(data.expect.tainted))
+
+				updatedNode, err := c.targetCoreClient.CoreV1().Nodes().Get(context.TODO(), "node-0", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				hasTaint := false
+				for _, taint := range updatedNode.Spec.Taints {
+					if taint.Key == corev1.TaintNodeOutOfService && taint.Effect == corev1.TaintEffectNoExecute {
+						hasTaint = true
+					}
+				}
+				Expect(hasTaint).To(Equal(data.expect.tainted))
+			},
+			Entry("should taint the node if the VM is missing", &data{
+				setup:  setup{vmExists: false},
+				expect: expect{tainted: true},
+			}),
+			Entry("should taint the node if the VM is stopped", &data{
+				setup:  setup{vmExists: true, vmStopped: true},
+				expect: expect{tainted: true},
+			}),
+			Entry("should not taint the node if the VM exists", &data{
+				setup:  setup{vmExists: true},
+				expect: expect{tainted: false},
+			}),
+			Entry("should not taint the node if the VM status cannot be determined", &data{
+				setup:  setup{vmExists: true, driverErr: status.Error(codes.Unavailable, "provider unavailable")},
+				expect: expect{tainted: false, err: true},
+			}),
+			Entry("should report an already tainted node", &data{
+				setup: setup{
+					vmExists: true,
+					nodeTaints: []corev1.Taint{
+						{Key: corev1.TaintNodeOutOfService, Value: machineutils.OutOfServiceTaintValue, Effect: corev1.TaintEffectNoExecute},
+					},
 				},
+				expect: expect{tainted: true},
 			}),
 		)
 	})
 
+	Describe("#drainNode of an out-of-service node", func() {
+		It("should taint a node which is not ready since a short time, if its VM is missing, and skip the drain", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machine := newMachine(
+				&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+				&machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineTerminating, LastUpdateTime: metav1.Now()},
+					LastOperation: machinev1.LastOperation{
+						Description:    machineutils.InitiateDrain,
+						State:          machinev1.MachineStateProcessing,
+						Type:           machinev1.MachineOperationDelete,
+						LastUpdateTime: metav1.Now(),
+					},
+					Conditions: []corev1.NodeCondition{{
+						Type:               corev1.NodeReady,
+						Status:             corev1.ConditionUnknown,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+					}},
+				},
+				nil, nil, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+			node := newNode(1, nil, nil, &corev1.NodeSpec{}, &corev1.NodeStatus{})
+
+			fakeDriver := driver.NewFakeDriver(false, "fakeID-0", "node-0", "", nil, nil)
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machine}, nil, []runtime.Object{node}, fakeDriver)
+			defer trackers.Stop()
+			c.safetyOptions.EnableOutOfServiceTaint = true
+			waitForCacheSync(stop, c)
+
+			_, err := c.drainNode(context.TODO(), &driver.DeleteMachineRequest{Machine: machine})
+			Expect(err).To(HaveOccurred())
+
+			updatedNode, err := c.targetCoreClient.CoreV1().Nodes().Get(context.TODO(), "node-0", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getOutOfServiceTaint(updatedNode)).ToNot(BeNil())
+			Expect(getOutOfServiceTaint(updatedNode).TimeAdded).ToNot(BeNil())
+
+			updatedMachine, err := c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMachine.Status.LastOperation.Description).To(ContainSubstring("skipping drain"))
+			Expect(updatedMachine.Status.LastOperation.Description).To(ContainSubstring(machineutils.DelVolumesAttachments))
+		})
+	})
+
 	Describe("#reconcileMachineHealth", func() {
 		var c *controller
 		var trackers *fakeclient.FakeObjectTrackers
@@ -2711,4 +2843,602 @@ var _ = Describe("machine_util", func() {
 			}),
 		)
 	})
+
+	Describe("#reconcileMachineMaintenance", func() {
+		type setup struct {
+			machine          *machinev1.Machine
+			node             *corev1.Node
+			pods             []*corev1.Pod
+			podDeletionFails bool
+		}
+		type expect struct {
+			retryPeriod                    machineutils.RetryPeriod
+			err                            bool
+			expectedPhase                  machinev1.MachinePhase
+			expectedState                  machinev1.MachineState
+			statusUnchanged                bool
+			nodeUnschedulable              bool
+			unschedulableBeforeMaintenance *string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		maintenanceAnnotation := map[string]string{machineutils.MachineMaintenance: "true"}
+		podOnNode := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod-0",
+				Namespace:       testNamespace,
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs-0", UID: "rs-0", Controller: ptr.To(true)}},
+			},
+			Spec: corev1.PodSpec{NodeName: "node-0"},
+		}
+		newMaintenanceMachine := func(status *machinev1.MachineStatus, annotations map[string]string) *machinev1.Machine {
+			machine := newMachine(
+				&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+				status, nil, annotations, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+			machine.Spec.MachineConfiguration = &machinev1.MachineConfiguration{MachineDrainTimeout: &metav1.Duration{Duration: time.Second}}
+			return machine
+		}
+		drainFailedDescription := fmt.Sprintf("Maintenance drain failed due to - error when evicting pod %q: pod deletion failed scheduled on node node-0. Will retry in next sync. %s", podOnNode.Name, machineutils.InitiateMaintenanceDrain)
+
+		DescribeTable("##table", func(data *data) {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			controlMachineObjects := []runtime.Object{data.setup.machine}
+			targetCoreObjects := []runtime.Object{data.setup.node}
+			for _, pod := range data.setup.pods {
+				targetCoreObjects = append(targetCoreObjects, pod)
+			}
+
+			c, trackers := createController(stop, testNamespace, controlMachineObjects, nil, targetCoreObjects, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			if data.setup.podDeletionFails {
+				c.targetCoreClient.(*fakeclient.Clientset).PrependReactor("delete", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("pod deletion failed")
+				})
+			}
+
+			Expect(shouldReconcileMachineMaintenance(data.setup.machine)).To(BeTrue())
+
+			retryPeriod, err := c.reconcileMachineMaintenance(context.TODO(), data.setup.machine)
+			Expect(retryPeriod).To(Equal(data.expect.retryPeriod))
+			if data.expect.err {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			updatedMachine, err := c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), data.setup.machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMachine.Status.CurrentStatus.Phase).To(Equal(data.expect.expectedPhase))
+			Expect(updatedMachine.Status.LastOperation.Type).To(Equal(machinev1.MachineOperationMaintenance))
+			Expect(updatedMachine.Status.LastOperation.State).To(Equal(data.expect.expectedState))
+			if data.expect.statusUnchanged {
+				Expect(updatedMachine.Status).To(Equal(data.setup.machine.Status))
+			}
+
+			updatedNode, err := c.targetCoreClient.CoreV1().Nodes().Get(context.TODO(), data.setup.node.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedNode.Spec.Unschedulable).To(Equal(data.expect.nodeUnschedulable))
+			if data.expect.unschedulableBeforeMaintenance != nil {
+				Expect(updatedNode.Annotations).To(HaveKeyWithValue(machineutils.UnschedulableBeforeMaintenance, *data.expect.unschedulableBeforeMaintenance))
+			} else {
+				Expect(updatedNode.Annotations).ToNot(HaveKey(machineutils.UnschedulableBeforeMaintenance))
+			}
+		},
+			Entry("should move Running machine annotated for maintenance to Maintenance phase and record that its node was schedulable", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineRunning, LastUpdateTime: metav1.Now()}}, maintenanceAnnotation),
+					node:    newNode(1, nil, nil, &corev1.NodeSpec{}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+				},
+				expect: expect{
+					retryPeriod:                    machineutils.ShortRetry,
+					err:                            true,
+					expectedPhase:                  machinev1.MachineMaintenance,
+					expectedState:                  machinev1.MachineStateProcessing,
+					nodeUnschedulable:              false,
+					unschedulableBeforeMaintenance: ptr.To("false"),
+				},
+			}),
+			Entry("should record that the node of a machine annotated for maintenance was already unschedulable", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineRunning, LastUpdateTime: metav1.Now()}}, maintenanceAnnotation),
+					node:    newNode(1, nil, nil, &corev1.NodeSpec{Unschedulable: true}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+				},
+				expect: expect{
+					retryPeriod:                    machineutils.ShortRetry,
+					err:                            true,
+					expectedPhase:                  machinev1.MachineMaintenance,
+					expectedState:                  machinev1.MachineStateProcessing,
+					nodeUnschedulable:              true,
+					unschedulableBeforeMaintenance: ptr.To("true"),
+				},
+			}),
+			Entry("should cordon and drain node of machine in Maintenance phase", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{
+						CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineMaintenance, LastUpdateTime: metav1.Now()},
+						LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationMaintenance, State: machinev1.MachineStateProcessing, LastUpdateTime: metav1.Now()},
+					}, maintenanceAnnotation),
+					node: newNode(1, nil, map[string]string{machineutils.UnschedulableBeforeMaintenance: "false"}, &corev1.NodeSpec{}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+				},
+				expect: expect{
+					retryPeriod:                    machineutils.LongRetry,
+					err:                            false,
+					expectedPhase:                  machinev1.MachineMaintenance,
+					expectedState:                  machinev1.MachineStateSuccessful,
+					nodeUnschedulable:              true,
+					unschedulableBeforeMaintenance: ptr.To("false"),
+				},
+			}),
+			Entry("should fail the drain if a pod cannot be drained", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{
+						CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineMaintenance, LastUpdateTime: metav1.Now()},
+						LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationMaintenance, State: machinev1.MachineStateProcessing, LastUpdateTime: metav1.Now()},
+					}, maintenanceAnnotation),
+					node:             newNode(1, nil, map[string]string{machineutils.UnschedulableBeforeMaintenance: "false"}, &corev1.NodeSpec{}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+					pods:             []*corev1.Pod{podOnNode},
+					podDeletionFails: true,
+				},
+				expect: expect{
+					retryPeriod:                    machineutils.ShortRetry,
+					err:                            true,
+					expectedPhase:                  machinev1.MachineMaintenance,
+					expectedState:                  machinev1.MachineStateFailed,
+					nodeUnschedulable:              true,
+					unschedulableBeforeMaintenance: ptr.To("false"),
+				},
+			}),
+			Entry("should not update the status if the drain keeps failing for the same reason", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{
+						CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineMaintenance, LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+						LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationMaintenance, State: machinev1.MachineStateFailed, Description: drainFailedDescription, LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+					}, maintenanceAnnotation),
+					node:             newNode(1, nil, map[string]string{machineutils.UnschedulableBeforeMaintenance: "false"}, &corev1.NodeSpec{}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+					pods:             []*corev1.Pod{podOnNode},
+					podDeletionFails: true,
+				},
+				expect: expect{
+					retryPeriod:                    machineutils.ShortRetry,
+					err:                            true,
+					expectedPhase:                  machinev1.MachineMaintenance,
+					expectedState:                  machinev1.MachineStateFailed,
+					statusUnchanged:                true,
+					nodeUnschedulable:              true,
+					unschedulableBeforeMaintenance: ptr.To("false"),
+				},
+			}),
+			Entry("should uncordon node and move machine back to Running phase once maintenance is cleared", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{
+						CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineMaintenance, LastUpdateTime: metav1.Now()},
+						LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationMaintenance, State: machinev1.MachineStateSuccessful, LastUpdateTime: metav1.Now()},
+					}, nil),
+					node: newNode(1, nil, map[string]string{machineutils.UnschedulableBeforeMaintenance: "false"}, &corev1.NodeSpec{Unschedulable: true}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+				},
+				expect: expect{
+					retryPeriod:       machineutils.LongRetry,
+					err:               false,
+					expectedPhase:     machinev1.MachineRunning,
+					expectedState:     machinev1.MachineStateSuccessful,
+					nodeUnschedulable: false,
+				},
+			}),
+			Entry("should keep node unschedulable once maintenance is cleared if it was unschedulable before", &data{
+				setup: setup{
+					machine: newMaintenanceMachine(&machinev1.MachineStatus{
+						CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineMaintenance, LastUpdateTime: metav1.Now()},
+						LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationMaintenance, State: machinev1.MachineStateSuccessful, LastUpdateTime: metav1.Now()},
+					}, nil),
+					node: newNode(1, nil, map[string]string{machineutils.UnschedulableBeforeMaintenance: "true"}, &corev1.NodeSpec{Unschedulable: true}, &corev1.NodeStatus{Phase: corev1.NodeRunning}),
+				},
+				expect: expect{
+					retryPeriod:       machineutils.LongRetry,
+					err:               false,
+					expectedPhase:     machinev1.MachineRunning,
+					expectedState:     machinev1.MachineStateSuccessful,
+					nodeUnschedulable: true,
+				},
+			}),
+		)
+
+		DescribeTable("##shouldReconcileMachineMaintenance", func(phase machinev1.MachinePhase, expected bool) {
+			machine := newMachine(
+				&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+				&machinev1.MachineStatus{CurrentStatus: machinev1.CurrentStatus{Phase: phase, LastUpdateTime: metav1.Now()}},
+				nil, maintenanceAnnotation, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+			Expect(shouldReconcileMachineMaintenance(machine)).To(Equal(expected))
+		},
+			Entry("should start maintenance of Running machine", machinev1.MachineRunning, true),
+			Entry("should start maintenance of Unknown machine", machinev1.MachineUnknown, true),
+			Entry("should not start maintenance of Pending machine", machinev1.MachinePending, false),
+			Entry("should not start maintenance of Failed machine", machinev1.MachineFailed, false),
+			Entry("should not start maintenance of Terminating machine", machinev1.MachineTerminating, false),
+		)
+	})
+
+	Describe("#reconcileMachineStandby", func() {
+		type setup struct {
+			phase         machinev1.MachinePhase
+			standby       bool
+			lastOperation machinev1.LastOperation
+			nodeTaints    []corev1.Taint
+			driverErr     error
+		}
+		type expect struct {
+			err           bool
+			expectedPhase machinev1.MachinePhase
+			expectedType  machinev1.MachineOperationType
+			expectedState machinev1.MachineState
+			nodeTainted   bool
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		standbyTaint := corev1.Taint{Key: machineutils.MachineStandby, Value: "true", Effect: corev1.TaintEffectNoSchedule}
+
+		DescribeTable("##table", func(data *data) {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			var annotations map[string]string
+			if data.setup.standby {
+				annotations = map[string]string{machineutils.MachineStandby: "true"}
+			}
+			machine := newMachine(
+				&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+				&machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{Phase: data.setup.phase, LastUpdateTime: metav1.Now()},
+					LastOperation: data.setup.lastOperation,
+				},
+				nil, annotations, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+			node := newNode(1, nil, nil, &corev1.NodeSpec{Taints: data.setup.nodeTaints}, &corev1.NodeStatus{Phase: corev1.NodeRunning})
+
+			fakeDriver := driver.NewFakeDriver(true, "fakeID", "node-0", "", data.setup.driverErr, nil)
+			c, trackers := createController(stop, testNamespace, []runtime.Object{machine}, nil, []runtime.Object{node}, fakeDriver)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			Expect(c.shouldReconcileMachineStandby(machine)).To(BeTrue())
+
+			_, err := c.reconcileMachineStandby(context.TODO(), machine, &machinev1.MachineClass{}, &corev1.Secret{})
+			if data.expect.err {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			updatedMachine, err := c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMachine.Status.CurrentStatus.Phase).To(Equal(data.expect.expectedPhase))
+			Expect(updatedMachine.Status.LastOperation.Type).To(Equal(data.expect.expectedType))
+			Expect(updatedMachine.Status.LastOperation.State).To(Equal(data.expect.expectedState))
+
+			updatedNode, err := c.targetCoreClient.CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			if data.expect.nodeTainted {
+				Expect(updatedNode.Spec.Taints).To(ContainElement(standbyTaint))
+			} else {
+				Expect(updatedNode.Spec.Taints).ToNot(ContainElement(standbyTaint))
+			}
+		},
+			Entry("should taint node and stop VM of Running machine annotated for standby", &data{
+				setup: setup{phase: machinev1.MachineRunning, standby: true},
+				expect: expect{
+					expectedPhase: machinev1.MachineStandby,
+					expectedType:  machinev1.MachineOperationStop,
+					expectedState: machinev1.MachineStateSuccessful,
+					nodeTainted:   true,
+				},
+			}),
+			Entry("should keep machine running if driver does not support stopping VMs", &data{
+				setup: setup{phase: machinev1.MachineRunning, standby: true, driverErr: status.Error(codes.Unimplemented, "not implemented")},
+				expect: expect{
+					expectedPhase: machinev1.MachineRunning,
+					expectedType:  machinev1.MachineOperationStop,
+					expectedState: machinev1.MachineStateFailed,
+					nodeTainted:   true,
+				},
+			}),
+			Entry("should retry if stopping VM fails", &data{
+				setup: setup{phase: machinev1.MachineRunning, standby: true, driverErr: status.Error(codes.Internal, "provider error")},
+				expect: expect{
+					err:           true,
+					expectedPhase: machinev1.MachineRunning,
+					expectedType:  machinev1.MachineOperationStop,
+					expectedState: machinev1.MachineStateFailed,
+					nodeTainted:   true,
+				},
+			}),
+			Entry("should start VM and remove taint once standby is cleared", &data{
+				setup: setup{phase: machinev1.MachineStandby, nodeTaints: []corev1.Taint{standbyTaint}},
+				expect: expect{
+					expectedPhase: machinev1.MachinePending,
+					expectedType:  machinev1.MachineOperationStart,
+					expectedState: machinev1.MachineStateProcessing,
+					nodeTainted:   false,
+				},
+			}),
+			Entry("should only remove taint once standby is cleared if driver does not support stopping VMs", &data{
+				setup: setup{
+					phase:         machinev1.MachineRunning,
+					lastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationStop, State: machinev1.MachineStateFailed, ErrorCode: codes.Unimplemented.String()},
+					nodeTaints:    []corev1.Taint{standbyTaint},
+					driverErr:     status.Error(codes.Unimplemented, "not implemented"),
+				},
+				expect: expect{
+					expectedPhase: machinev1.MachineRunning,
+					expectedType:  machinev1.MachineOperationStart,
+					expectedState: machinev1.MachineStateSuccessful,
+					nodeTainted:   false,
+				},
+			}),
+		)
+
+		It("should skip the standby flow if driver does not support stopping VMs", func() {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			node := newNode(1, nil, nil, &corev1.NodeSpec{Taints: []corev1.Taint{standbyTaint}}, &corev1.NodeStatus{Phase: corev1.NodeRunning})
+			c, trackers := createController(stop, testNamespace, nil, nil, []runtime.Object{node}, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			machine := &machinev1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{machineutils.MachineStandby: "true"},
+					Labels:      map[string]string{machinev1.NodeLabelKey: node.Name},
+				},
+				Status: machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineRunning},
+					LastOperation: machinev1.LastOperation{Type: machinev1.MachineOperationStop, State: machinev1.MachineStateFailed, ErrorCode: codes.Unimplemented.String()},
+				},
+			}
+			Expect(c.shouldReconcileMachineStandby(machine)).To(BeFalse())
+
+			// Once standby is cleared, the taint has to be removed
+			delete(machine.Annotations, machineutils.MachineStandby)
+			Expect(c.shouldReconcileMachineStandby(machine)).To(BeTrue())
+
+			machine.Labels[machinev1.NodeLabelKey] = "other-node"
+			Expect(c.shouldReconcileMachineStandby(machine)).To(BeFalse())
+		})
+
+		It("should fail with Unimplemented if driver does not implement stopping VMs", func() {
+			_, err := driver.StopMachine(context.TODO(), struct{ driver.Driver }{driver.NewFakeDriver(true, "fakeID", "node-0", "", nil, nil)}, &driver.StopMachineRequest{})
+			Expect(err).To(HaveOccurred())
+			machineErr, _ := status.FromError(err)
+			Expect(machineErr.Code()).To(Equal(codes.Unimplemented))
+		})
+	})
+
+	Describe("#taintNodeForStandby", func() {
+		standbyTaint := corev1.Taint{Key: machineutils.MachineStandby, Value: "true", Effect: corev1.TaintEffectNoSchedule}
+
+		DescribeTable("##table", func(nodeName string, nodeTaints []corev1.Taint) {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			notReadyTaint := corev1.Taint{Key: corev1.TaintNodeNotReady, Effect: corev1.TaintEffectNoSchedule}
+			node := newNode(1, nil, nil, &corev1.NodeSpec{Taints: append([]corev1.Taint{notReadyTaint}, nodeTaints...)}, &corev1.NodeStatus{})
+			c, trackers := createController(stop, testNamespace, nil, nil, []runtime.Object{node}, nil)
+			defer trackers.Stop()
+			waitForCacheSync(stop, c)
+
+			machine := &machinev1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machine-0",
+					Annotations: map[string]string{machineutils.MachineStandby: "true"},
+					Labels:      map[string]string{machinev1.NodeLabelKey: nodeName},
+				},
+				Status: machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachinePending},
+				},
+			}
+			retry, err := c.taintNodeForStandby(context.TODO(), machine)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(machineutils.LongRetry))
+
+			updatedNode, err := c.targetCoreClient.CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			if nodeName == node.Name {
+				Expect(updatedNode.Spec.Taints).To(ConsistOf(notReadyTaint, standbyTaint))
+			} else {
+				Expect(updatedNode.Spec.Taints).To(ConsistOf(notReadyTaint))
+			}
+		},
+			Entry("should taint the node of a Pending machine as soon as it has registered", "node-0", nil),
+			Entry("should keep the standby taint of the node", "node-0", []corev1.Taint{standbyTaint}),
+			Entry("should wait for the node to register", "node-1", nil),
+		)
+	})
+
+	Describe("#deleteNodeVolAttachments", func() {
+		const pvName = "pv-0"
+
+		type setup struct {
+			detachStartedOn   time.Time
+			driverErr         error
+			outOfServiceSince *time.Time
+		}
+		type expect struct {
+			retryPeriod       machineutils.RetryPeriod
+			description       string
+			detachedVolumeIDs []string
+		}
+		type data struct {
+			setup  setup
+			expect expect
+		}
+
+		DescribeTable("##table", func(data *data) {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			machine := newMachine(
+				&machinev1.MachineTemplateSpec{ObjectMeta: *newObjectMeta(&metav1.ObjectMeta{GenerateName: machineSet1Deploy1}, 0)},
+				&machinev1.MachineStatus{
+					CurrentStatus: machinev1.CurrentStatus{Phase: machinev1.MachineTerminating, LastUpdateTime: metav1.Now()},
+					LastOperation: machinev1.LastOperation{
+						Description:    fmt.Sprintf("Force Drain successful. %s", machineutils.DelVolumesAttachments),
+						State:          machinev1.MachineStateProcessing,
+						Type:           machinev1.MachineOperationDelete,
+						LastUpdateTime: metav1.Now(),
+					},
+				},
+				nil, nil, map[string]string{machinev1.NodeLabelKey: "node-0"}, true, metav1.Now())
+			node := newNode(1, nil, nil, &corev1.NodeSpec{}, &corev1.NodeStatus{
+				VolumesAttached: []corev1.AttachedVolume{{Name: "kubernetes.io/csi/test^vol-0"}},
+			})
+			if data.setup.outOfServiceSince != nil {
+				node.Spec.Taints = []corev1.Taint{{
+					Key:       corev1.TaintNodeOutOfService,
+					Value:     machineutils.OutOfServiceTaintValue,
+					Effect:    corev1.TaintEffectNoExecute,
+					TimeAdded: &metav1.Time{Time: *data.setup.outOfServiceSince},
+				}}
+			}
+			pv := &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: pvName},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{CSI: &corev1.CSIPersistentVolumeSource{Driver: "test", VolumeHandle: "vol-0"}},
+				},
+			}
+			deletionTimestamp := metav1.NewTime(data.setup.detachStartedOn)
+			volumeAttachment := &storagev1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{Name: "va-0", DeletionTimestamp: &deletionTimestamp, Finalizers: []string{"external-attacher/test"}},
+				Spec: storagev1.VolumeAttachmentSpec{
+					Attacher: "test",
+					NodeName: "node-0",
+					Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: ptr.To(pvName)},
+				},
+				Status: storagev1.VolumeAttachmentStatus{Attached: true},
+			}
+
+			fakeDriver := &volumeDetachDriver{FakeDriver: &driver.FakeDriver{Err: data.setup.driverErr}}
+			controlMachineObjects := []runtime.Object{machine}
+			targetCoreObjects := []runtime.Object{node, pv, volumeAttachment}
+			c, trackers := createController(stop, testNamespace, controlMachineObjects, nil, targetCoreObjects, fakeDriver)
+			defer trackers.Stop()
+
+			targetInformerFactory := coreinformers.NewSharedInformerFactory(c.targetCoreClient, 100*time.Millisecond)
+			volumeAttachments := targetInformerFactory.Storage().V1().VolumeAttachments()
+			pvs := targetInformerFactory.Core().V1().PersistentVolumes()
+			c.volumeAttachementLister = volumeAttachments.Lister()
+			c.pvLister = pvs.Lister()
+			c.safetyOptions.PvDetachTimeout = metav1.Duration{Duration: 2 * time.Minute}
+			targetInformerFactory.Start(stop)
+
+			waitForCacheSync(stop, c)
+			Expect(cache.WaitForCacheSync(stop, volumeAttachments.Informer().HasSynced, pvs.Informer().HasSynced)).To(BeTrue())
+
+			retryPeriod, err := c.deleteNodeVolAttachments(context.TODO(), &driver.DeleteMachineRequest{Machine: machine})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retryPeriod).To(Equal(data.expect.retryPeriod))
+			Expect(fakeDriver.detachedVolumeIDs).To(Equal(data.expect.detachedVolumeIDs))
+
+			updatedMachine, err := c.controlMachineClient.Machines(testNamespace).Get(context.TODO(), machine.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedMachine.Status.LastOperation.Description).To(Equal(data.expect.description))
+		},
+			Entry("should wait for volumes in detachment until the PV detach timeout has passed", &data{
+				setup: setup{
+					detachStartedOn: time.Now().Add(-5 * time.Second),
+				},
+				expect: expect{
+					retryPeriod: machineutils.ShortRetry,
+					description: fmt.Sprintf("Force Drain successful. %s", machineutils.DelVolumesAttachments),
+				},
+			}),
+			Entry("should detach volumes in detachment via driver after the PV detach timeout", &data{
+				setup: setup{
+					detachStartedOn: time.Now().Add(-3 * time.Minute),
+				},
+				expect: expect{
+					retryPeriod:       machineutils.ShortRetry,
+					description:       fmt.Sprintf("Volumes [vol-0] still attached after PV detach timeout were detached by driver. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion),
+					detachedVolumeIDs: []string{"vol-0"},
+				},
+			}),
+			Entry("should move to VM deletion if driver does not support detaching volumes", &data{
+				setup: setup{
+					detachStartedOn: time.Now().Add(-3 * time.Minute),
+					driverErr:       status.Error(codes.Unimplemented, "not implemented"),
+				},
+				expect: expect{
+					retryPeriod: machineutils.ShortRetry,
+					description: fmt.Sprintf("Volumes still attached after PV detach timeout, detaching volumes is not supported by driver. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion),
+				},
+			}),
+			Entry("should move to VM deletion if driver fails to detach volumes", &data{
+				setup: setup{
+					detachStartedOn: time.Now().Add(-3 * time.Minute),
+					driverErr:       status.Error(codes.Internal, "provider error"),
+				},
+				expect: expect{
+					retryPeriod: machineutils.ShortRetry,
+					description: fmt.Sprintf("Detaching volumes still attached after PV detach timeout failed due to - machine codes error: code = [Internal] message = [provider error]. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion),
+				},
+			}),
+			Entry("should leave detaching the volumes of an out-of-service node to kube-controller-manager", &data{
+				setup: setup{
+					detachStartedOn:   time.Now().Add(-3 * time.Minute),
+					outOfServiceSince: ptr.To(time.Now().Add(-1 * time.Minute)),
+				},
+				expect: expect{
+					retryPeriod: machineutils.ShortRetry,
+					description: fmt.Sprintf("Force Drain successful. %s", machineutils.DelVolumesAttachments),
+				},
+			}),
+			Entry("should move to VM deletion if the volumes of an out-of-service node are not detached in time", &data{
+				setup: setup{
+					detachStartedOn:   time.Now().Add(-3 * time.Minute),
+					outOfServiceSince: ptr.To(time.Now().Add(-3 * time.Minute)),
+				},
+				expect: expect{
+					retryPeriod: machineutils.ShortRetry,
+					description: fmt.Sprintf("Volumes of out-of-service node node-0 not detached within 2m0s. Moving to VM Deletion. %s", machineutils.InitiateVMDeletion),
+				},
+			}),
+		)
+
+		It("should fail with Unimplemented if driver does not implement detaching volumes", func() {
+			_, err := driver.DetachVolumes(context.TODO(), struct{ driver.Driver }{driver.NewFakeDriver(true, "fakeID", "node-0", "", nil, nil)}, &driver.DetachVolumesRequest{})
+			Expect(err).To(HaveOccurred())
+			machineErr, _ := status.FromError(err)
+			Expect(machineErr.Code()).To(Equal(codes.Unimplemented))
+		})
+	})
 })
+
+type volumeDetachDriver struct {
+	*driver.FakeDriver
+	detachedVolumeIDs []string
+}
+
+func (d *volumeDetachDriver) GetVolumeIDs(_ context.Context, req *driver.GetVolumeIDsRequest) (*driver.GetVolumeIDsResponse, error) {
+	volumeIDs := make([]string, 0, len(req.PVSpecs))
+	for _, spec := range req.PVSpecs {
+		if spec.CSI != nil {
+			volumeIDs = append(volumeIDs, spec.CSI.VolumeHandle)
+		}
+	}
+	return &driver.GetVolumeIDsResponse{VolumeIDs: volumeIDs}, nil
+}
+
+func (d *volumeDetachDriver) DetachVolumes(_ context.Context, req *driver.DetachVolumesRequest) (*driver.DetachVolumesResponse, error) {
+	if d.Err != nil {
+		return nil, d.Err
+	}
+	d.detachedVolumeIDs = append(d.detachedVolumeIDs, req.VolumeIDs...)
+	return &driver.DetachVolumesResponse{}, nil
+}
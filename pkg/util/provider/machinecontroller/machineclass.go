@@ -55,6 +55,10 @@ func (c *controller) machineClassUpdate(oldObj, newObj interface{}) {
 	if new == nil || !ok {
 		return
 	}
+	// Updates of the status and the metadata do not change the generation of the machineClass
+	if old.Generation == new.Generation && old.DeletionTimestamp.Equal(new.DeletionTimestamp) {
+		return
+	}
 
 	c.machineClassAdd(newObj)
 }
@@ -112,6 +116,14 @@ func (c *controller) reconcileClusterMachineClass(ctx context.Context, class *v1
 		return err
 	}
 
+	err = c.updateMachineClassStatus(ctx, class, func(status *v1alpha1.MachineClassStatus) {
+		status.MachineCount = int32(len(machines)) // #nosec G115 (CWE-190) -- number of machines cannot exceed MaxInt32
+		c.setLastSuccessfulDriverCallTime(class.Name, status)
+	})
+	if err != nil {
+		return err
+	}
+
 	if class.DeletionTimestamp == nil && len(machines) > 0 {
 		// If deletionTimestamp is not set and at least one machine is referring this machineClass
 
@@ -19,6 +19,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
 )
 
 const (
@@ -155,6 +156,7 @@ var _ = Describe("machineclass", func() {
 							ProviderSpec: runtime.RawExtension{},
 							SecretRef:    &v1.SecretReference{},
 							Provider:     "",
+							Status:       v1alpha1.MachineClassStatus{MachineCount: 1},
 						},
 						err: nil,
 					},
@@ -251,6 +253,7 @@ var _ = Describe("machineclass", func() {
 							ProviderSpec: runtime.RawExtension{},
 							SecretRef:    &v1.SecretReference{},
 							Provider:     "",
+							Status:       v1alpha1.MachineClassStatus{MachineCount: 1},
 						},
 						err: nil,
 					},
@@ -315,6 +318,7 @@ var _ = Describe("machineclass", func() {
 							ProviderSpec: runtime.RawExtension{},
 							SecretRef:    &v1.SecretReference{},
 							Provider:     "",
+							Status:       v1alpha1.MachineClassStatus{MachineCount: 1},
 						},
 						err: fmt.Errorf("Retry as machine objects are still referring the machineclass"),
 					},
@@ -450,3 +454,42 @@ var _ = Describe("machineclass", func() {
 	})
 
 })
+
+var _ = Describe("machineclass status", func() {
+	Describe("#machineClassUpdate", func() {
+		DescribeTable("##table",
+			func(mutate func(*v1alpha1.MachineClass), expectedQueueLen int) {
+				c := &controller{machineClassQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "machineclass")}
+				defer c.machineClassQueue.ShutDown()
+
+				old := &v1alpha1.MachineClass{ObjectMeta: metav1.ObjectMeta{Name: TestMachineClassName, Namespace: TestNamespace, Generation: 1}}
+				new := old.DeepCopy()
+				mutate(new)
+
+				c.machineClassUpdate(old, new)
+				Expect(c.machineClassQueue.Len()).To(Equal(expectedQueueLen))
+			},
+			Entry("should ignore status updates", func(class *v1alpha1.MachineClass) { class.Status.MachineCount = 1 }, 0),
+			Entry("should enqueue changes of the class", func(class *v1alpha1.MachineClass) { class.Generation = 2 }, 1),
+			Entry("should enqueue the deletion of the class", func(class *v1alpha1.MachineClass) { class.DeletionTimestamp = &metav1.Time{Time: time.Now()} }, 1),
+		)
+	})
+
+	Describe("#setLastSuccessfulDriverCallTime", func() {
+		lastCallTime := metav1.NewTime(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+
+		DescribeTable("##table",
+			func(recorded *metav1.Time, expected *metav1.Time) {
+				c := &controller{driverCalls: newDriverCallRecorder(nil)}
+				c.driverCalls.lastCallTimes.Store(TestMachineClassName, lastCallTime)
+				status := &v1alpha1.MachineClassStatus{LastSuccessfulDriverCallTime: recorded}
+
+				c.setLastSuccessfulDriverCallTime(TestMachineClassName, status)
+				Expect(status.LastSuccessfulDriverCallTime).To(Equal(expected))
+			},
+			Entry("should set the time of the first call", nil, &lastCallTime),
+			Entry("should not update a recent time", &metav1.Time{Time: lastCallTime.Add(-time.Minute)}, &metav1.Time{Time: lastCallTime.Add(-time.Minute)}),
+			Entry("should update an outdated time", &metav1.Time{Time: lastCallTime.Add(-lastSuccessfulDriverCallTimeInterval)}, &lastCallTime),
+		)
+	})
+})
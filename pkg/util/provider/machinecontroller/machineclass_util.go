@@ -6,8 +6,17 @@
 package controller
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/driver"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
 )
 
 func (c *controller) findMachinesForClass(kind, name string) ([]*v1alpha1.Machine, error) {
@@ -23,3 +32,151 @@ func (c *controller) findMachinesForClass(kind, name string) ([]*v1alpha1.Machin
 	}
 	return filtered, nil
 }
+
+// updateMachineClassStatus applies the given change to the status of the machine class and updates the
+// status if it changed.
+func (c *controller) updateMachineClassStatus(ctx context.Context, class *v1alpha1.MachineClass, mutate func(*v1alpha1.MachineClassStatus)) error {
+	apply := func(class *v1alpha1.MachineClass) *v1alpha1.MachineClass {
+		clone := class.DeepCopy()
+		mutate(&clone.Status)
+		return clone
+	}
+	if apiequality.Semantic.DeepEqual(class.Status, apply(class).Status) {
+		return nil
+	}
+
+	// Get the latest version of the class so that we can avoid conflicts
+	class, err := c.controlMachineClient.MachineClasses(class.Namespace).Get(ctx, class.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	clone := apply(class)
+	if apiequality.Semantic.DeepEqual(class.Status, clone.Status) {
+		return nil
+	}
+	_, err = c.controlMachineClient.MachineClasses(class.Namespace).UpdateStatus(ctx, clone, metav1.UpdateOptions{FieldManager: machineutils.MachineControllerFieldManager})
+	if err != nil {
+		klog.Warningf("Updating status of machineClass %q failed: %v", class.Name, err)
+		return err
+	}
+	klog.V(4).Infof("Updated status of machineClass %q", class.Name)
+	return nil
+}
+
+// recordMachineClassValidation records the result of a validation of the machine class in its status if
+// it differs from the last one.
+func (c *controller) recordMachineClassValidation(ctx context.Context, class *v1alpha1.MachineClass, validationErr error) {
+	validation := &v1alpha1.MachineClassValidation{
+		Valid:          validationErr == nil,
+		LastUpdateTime: metav1.Now(),
+	}
+	if validationErr != nil {
+		validation.Message = validationErr.Error()
+	}
+
+	err := c.updateMachineClassStatus(ctx, class, func(status *v1alpha1.MachineClassStatus) {
+		if last := status.LastValidation; last != nil && last.Valid == validation.Valid && last.Message == validation.Message {
+			return
+		}
+		status.LastValidation = validation
+	})
+	if err != nil {
+		klog.Warningf("Failed to record the validation of machineClass %q: %v", class.Name, err)
+	}
+}
+
+// lastSuccessfulDriverCallTimeInterval is the minimum interval between two updates of the time of the last
+// successful driver call in the status of a machine class, so that the status is not written on every reconcile.
+const lastSuccessfulDriverCallTimeInterval = 5 * time.Minute
+
+// setLastSuccessfulDriverCallTime sets the time of the last successful driver call with the machine class, if it is
+// at least lastSuccessfulDriverCallTimeInterval after the recorded time.
+func (c *controller) setLastSuccessfulDriverCallTime(className string, status *v1alpha1.MachineClassStatus) {
+	lastCallTime, ok := c.driverCalls.lastCallTime(className)
+	if !ok {
+		return
+	}
+	if recorded := status.LastSuccessfulDriverCallTime; recorded != nil && lastCallTime.Sub(recorded.Time) < lastSuccessfulDriverCallTimeInterval {
+		return
+	}
+	status.LastSuccessfulDriverCallTime = &lastCallTime
+}
+
+// driverCallRecorder wraps a driver and records the time of the last successful call for each machine class.
+type driverCallRecorder struct {
+	driver.Driver
+
+	lastCallTimes sync.Map
+}
+
+func newDriverCallRecorder(d driver.Driver) *driverCallRecorder {
+	return &driverCallRecorder{Driver: d}
+}
+
+// lastCallTime returns the time of the last successful call to the driver with the given machine class.
+func (r *driverCallRecorder) lastCallTime(className string) (metav1.Time, bool) {
+	if r == nil {
+		return metav1.Time{}, false
+	}
+	lastCallTime, ok := r.lastCallTimes.Load(className)
+	if !ok {
+		return metav1.Time{}, false
+	}
+	return lastCallTime.(metav1.Time), true
+}
+
+func (r *driverCallRecorder) record(class *v1alpha1.MachineClass, err error) {
+	if err != nil || class == nil {
+		return
+	}
+	// The status only holds the time in seconds, which avoids needless updates of it
+	r.lastCallTimes.Store(class.Name, metav1.Now().Rfc3339Copy())
+}
+
+func (r *driverCallRecorder) CreateMachine(ctx context.Context, req *driver.CreateMachineRequest) (*driver.CreateMachineResponse, error) {
+	resp, err := r.Driver.CreateMachine(ctx, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) InitializeMachine(ctx context.Context, req *driver.InitializeMachineRequest) (*driver.InitializeMachineResponse, error) {
+	resp, err := r.Driver.InitializeMachine(ctx, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) DeleteMachine(ctx context.Context, req *driver.DeleteMachineRequest) (*driver.DeleteMachineResponse, error) {
+	resp, err := r.Driver.DeleteMachine(ctx, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) GetMachineStatus(ctx context.Context, req *driver.GetMachineStatusRequest) (*driver.GetMachineStatusResponse, error) {
+	resp, err := r.Driver.GetMachineStatus(ctx, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) ListMachines(ctx context.Context, req *driver.ListMachinesRequest) (*driver.ListMachinesResponse, error) {
+	resp, err := r.Driver.ListMachines(ctx, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) DetachVolumes(ctx context.Context, req *driver.DetachVolumesRequest) (*driver.DetachVolumesResponse, error) {
+	resp, err := driver.DetachVolumes(ctx, r.Driver, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) StopMachine(ctx context.Context, req *driver.StopMachineRequest) (*driver.StopMachineResponse, error) {
+	resp, err := driver.StopMachine(ctx, r.Driver, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
+
+func (r *driverCallRecorder) StartMachine(ctx context.Context, req *driver.StartMachineRequest) (*driver.StartMachineResponse, error) {
+	resp, err := driver.StartMachine(ctx, r.Driver, req)
+	r.record(req.MachineClass, err)
+	return resp, err
+}
@@ -50,7 +50,7 @@ func (c *controller) CollectMachineMetrics(ch chan<- prometheus.Metric) {
 // CollectMachineControllerFrozenStatusMetrics is method to collect Machine controller state related metrics.
 func (c *controller) CollectMachineControllerFrozenStatusMetrics(ch chan<- prometheus.Metric) {
 	var frozenStatus float64
-	if c.safetyOptions.MachineControllerFrozen {
+	if frozen, _ := c.machineControllerFrozen(); frozen {
 		frozenStatus = 1
 	}
 	metric, err := prometheus.NewConstMetric(metrics.MachineControllerFrozenDesc, prometheus.GaugeValue, frozenStatus)
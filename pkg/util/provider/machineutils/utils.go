@@ -33,6 +33,16 @@ const (
 	// InitiateFinalizerRemoval specifies next step as machine finalizer removal
 	InitiateFinalizerRemoval = "Initiate machine object finalizer removal"
 
+	// InitiateMaintenanceDrain specifies next step as cordon and drain of the node for maintenance
+	InitiateMaintenanceDrain = "Initiate node drain for maintenance"
+
+	// InitiateVMStop specifies next step as stopping the VM of a warm pool machine
+	InitiateVMStop = "Initiate VM stop"
+
+	// ForceDeletion label on the machine object, if set to "True", forcefully drains the backing node by deleting
+	// its pods instead of evicting them when the machine is deleted
+	ForceDeletion = "force-deletion"
+
 	// LastAppliedALTAnnotation contains the last configuration of annotations, labels & taints applied on the node object
 	LastAppliedALTAnnotation = "node.machine.sapcloud.io/last-applied-anno-labels-taints"
 
@@ -42,9 +52,36 @@ const (
 	// Default priority for a machine is set to 3
 	MachinePriority = "machinepriority.machine.sapcloud.io"
 
+	// UnfreezeAnnotation indicates the controllers to unfreeze this object
+	UnfreezeAnnotation = "safety.machine.sapcloud.io/unfreeze"
+
+	// FreezeLabel is the legacy label freezing a machineSet/machineDeployment if set to FreezeLabelValue.
+	// It is still accepted, but spec.frozen is set instead.
+	FreezeLabel = "freeze"
+
+	// FreezeLabelValue is the value of the legacy freeze label of a frozen machineSet/machineDeployment
+	FreezeLabelValue = "True"
+
+	// MachineControllerFieldManager is the field manager of the status writes of the machine controller
+	MachineControllerFieldManager = "machine-controller"
+
 	// MachineClassKind is used to identify the machineClassKind for generic machineClasses
 	MachineClassKind = "MachineClass"
 
+	// MachineMaintenance annotation on the machine object, if set to "true", cordons and drains the
+	// backing node without deleting the machine. Removing it uncordons the node again.
+	MachineMaintenance = "machine.sapcloud.io/maintenance"
+
+	// UnschedulableBeforeMaintenance annotation on the node records whether it was already unschedulable when the
+	// maintenance of its machine started, so that the node is only uncordoned at the end of the maintenance if
+	// it has been cordoned for it.
+	UnschedulableBeforeMaintenance = "node.machine.sapcloud.io/unschedulable-before-maintenance"
+
+	// MachineStandby annotation on the machine object, if set to "true", marks the machine as part of a warm pool.
+	// The backing node is tainted and the VM is stopped. Removing it starts the VM again and removes the taint.
+	// The same key is used for the NoSchedule taint on the backing node.
+	MachineStandby = "machine.sapcloud.io/standby"
+
 	// NotManagedByMCM annotation helps in identifying the nodes which are not handled by MCM
 	NotManagedByMCM = "node.machine.sapcloud.io/not-managed-by-mcm"
 
@@ -66,6 +103,10 @@ const (
 
 	// MachineLabelKey defines the labels which contains the name of the machine of a node
 	MachineLabelKey = "node.gardener.cloud/machine-name"
+
+	// OutOfServiceTaintValue is the value of the out-of-service taint put on nodes whose VM is gone,
+	// as recommended for the non-graceful node shutdown feature of kubernetes
+	OutOfServiceTaintValue = "nodeshutdown"
 )
 
 // RetryPeriod is an alias for specifying the retry period
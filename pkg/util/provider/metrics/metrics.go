@@ -47,6 +47,14 @@ var (
 		Name:      "status_condition",
 		Help:      "Information of the mcm managed Machines' status conditions.",
 	}, []string{"name", "namespace", "condition"})
+
+	// MachineVolumeDetachCount Number of driver calls to detach volumes still attached after the PV detach timeout, partitioned by result.
+	MachineVolumeDetachCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: machineSubsystem,
+		Name:      "volume_detach_total",
+		Help:      "Number of driver calls to detach volumes still attached after the PV detach timeout, partitioned by result.",
+	}, []string{"result"})
 )
 
 // variables for subsystem: cloud_api
@@ -113,6 +121,7 @@ func registerMachineSubsystemMetrics() {
 	prometheus.MustRegister(MachineInfo)
 	prometheus.MustRegister(MachineStatusCondition)
 	prometheus.MustRegister(MachineCSPhase)
+	prometheus.MustRegister(MachineVolumeDetachCount)
 }
 
 func registerCloudAPISubsystemMetrics() {
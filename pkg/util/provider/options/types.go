@@ -9,6 +9,8 @@ import (
 	"time"
 
 	mcmoptions "github.com/gardener/machine-controller-manager/pkg/options"
+	"github.com/gardener/machine-controller-manager/pkg/util/chaos"
+	"github.com/gardener/machine-controller-manager/pkg/util/debug"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -51,6 +53,8 @@ type MachineControllerConfiguration struct {
 	EnableProfiling bool
 	// enableContentionProfiling enables lock contention profiling, if enableProfiling is true.
 	EnableContentionProfiling bool
+	// DebugServer is the configuration of the TLS server of the /debug/mcm endpoint.
+	DebugServer debug.ServerOptions
 	// contentType is contentType of requests sent to apiserver.
 	ContentType string
 	// kubeAPIQPS is the QPS to use while talking with kubernetes apiserver.
@@ -73,6 +77,10 @@ type MachineControllerConfiguration struct {
 
 	//BootstrapTokenAuthExtraGroups is a comma-separated string of groups to set bootstrap token's "auth-extra-groups" field to.
 	BootstrapTokenAuthExtraGroups string
+
+	// Chaos are the faults injected into the requests of the controllers to the API servers and into the calls to the
+	// driver, for debugging only.
+	Chaos chaos.Faults
 }
 
 // SafetyOptions are used to configure the upper-limit and lower-limit
@@ -94,6 +102,9 @@ type SafetyOptions struct {
 	PvDetachTimeout metav1.Duration
 	// Timeout (in duration) used while waiting for PV to reattach on new node
 	PvReattachTimeout metav1.Duration
+	// EnableOutOfServiceTaint enables tainting of unready nodes, whose VM is reported
+	// missing or stopped by the driver, with the out-of-service taint during machine termination
+	EnableOutOfServiceTaint bool
 
 	// Timeout (in duration) for which the APIServer can be down before
 	// declare the machine controller frozen by safety controller
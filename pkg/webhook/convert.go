@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/install"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// scheme knows the internal and all versions of the machine.sapcloud.io resources.
+var scheme = runtime.NewScheme()
+
+func init() {
+	install.Install(scheme)
+}
+
+// serveConversion converts machine.sapcloud.io resources in conversion reviews sent by the API server for
+// custom resource definitions with the webhook conversion strategy.
+func (h *Handler) serveConversion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+		return
+	}
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("failed to decode conversion review: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := &apiextensionsv1.ConversionResponse{UID: review.Request.UID}
+	convertedObjects, err := ConvertObjects(review.Request.Objects, review.Request.DesiredAPIVersion)
+	if err != nil {
+		response.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+	} else {
+		response.ConvertedObjects = convertedObjects
+		response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	}
+	review.Response = response
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		klog.Errorf("Failed to write conversion review response: %v", err)
+	}
+}
+
+// ConvertObjects converts the given machine.sapcloud.io objects to the desired API version through the
+// internal version.
+func ConvertObjects(objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, error) {
+	desiredGroupVersion, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	if desiredGroupVersion.Group != machine.GroupName {
+		return nil, fmt.Errorf("cannot convert to API group %q", desiredGroupVersion.Group)
+	}
+
+	convertedObjects := make([]runtime.RawExtension, 0, len(objects))
+	for _, object := range objects {
+		typeMeta := &metav1.TypeMeta{}
+		if err := json.Unmarshal(object.Raw, typeMeta); err != nil {
+			return nil, fmt.Errorf("failed to decode object: %w", err)
+		}
+		obj, err := scheme.New(typeMeta.GroupVersionKind())
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(object.Raw, obj); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", typeMeta.Kind, err)
+		}
+
+		internal, err := scheme.ConvertToVersion(obj, machine.SchemeGroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		converted, err := scheme.ConvertToVersion(internal, desiredGroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(converted)
+		if err != nil {
+			return nil, err
+		}
+		convertedObjects = append(convertedObjects, runtime.RawExtension{Raw: raw})
+	}
+	return convertedObjects, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1beta1"
+	"github.com/gardener/machine-controller-manager/pkg/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("conversion", func() {
+	v1alpha1TypeMeta := func(kind string) metav1.TypeMeta {
+		return metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind}
+	}
+	v1beta1TypeMeta := func(kind string) metav1.TypeMeta {
+		return metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: kind}
+	}
+
+	convert := func(obj runtime.Object, desiredAPIVersion string, into runtime.Object) {
+		raw, err := json.Marshal(obj)
+		Expect(err).ToNot(HaveOccurred())
+		converted, err := ConvertObjects([]runtime.RawExtension{{Raw: raw}}, desiredAPIVersion)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(converted).To(HaveLen(1))
+		Expect(json.Unmarshal(converted[0].Raw, into)).To(Succeed())
+	}
+
+	Describe("#ConvertObjects", func() {
+		DescribeTable("##round trips",
+			func(obj runtime.Object, into, back runtime.Object, expected runtime.Object) {
+				convert(obj, v1beta1.SchemeGroupVersion.String(), into)
+				Expect(into).To(Equal(expected))
+				convert(into, v1alpha1.SchemeGroupVersion.String(), back)
+				Expect(back).To(Equal(obj))
+			},
+			Entry("should keep the deletion priority and the priority annotation of a machine", &v1alpha1.Machine{
+				TypeMeta: v1alpha1TypeMeta("Machine"),
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machine",
+					Annotations: map[string]string{"machinepriority.machine.sapcloud.io": "1", "foo": "bar"},
+				},
+				Spec:   v1alpha1.MachineSpec{ProviderID: "id", DeletionPriority: ptr.To[int32](2)},
+				Status: v1alpha1.MachineStatus{CurrentStatus: v1alpha1.CurrentStatus{Phase: v1alpha1.MachineRunning}},
+			}, &v1beta1.Machine{}, &v1alpha1.Machine{}, &v1beta1.Machine{
+				TypeMeta: v1beta1TypeMeta("Machine"),
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machine",
+					Annotations: map[string]string{"machinepriority.machine.sapcloud.io": "1", "foo": "bar"},
+				},
+				Spec:   v1beta1.MachineSpec{ProviderID: "id", DeletionPriority: ptr.To[int32](2)},
+				Status: v1beta1.MachineStatus{CurrentStatus: v1beta1.CurrentStatus{Phase: v1beta1.MachineRunning}},
+			}),
+			Entry("should move the provider of a machine class into one field", &v1alpha1.MachineClass{
+				TypeMeta:     v1alpha1TypeMeta("MachineClass"),
+				ObjectMeta:   metav1.ObjectMeta{Name: "class"},
+				Provider:     "AWS",
+				ProviderSpec: runtime.RawExtension{Raw: []byte(`{"ami":"ami-1"}`)},
+			}, &v1beta1.MachineClass{}, &v1alpha1.MachineClass{}, &v1beta1.MachineClass{
+				TypeMeta:   v1beta1TypeMeta("MachineClass"),
+				ObjectMeta: metav1.ObjectMeta{Name: "class"},
+				Provider:   v1beta1.MachineClassProvider{Name: "AWS", Spec: runtime.RawExtension{Raw: []byte(`{"ami":"ami-1"}`)}},
+			}),
+			Entry("should keep the freeze of a machine set and the deletion priority of its template", &v1alpha1.MachineSet{
+				TypeMeta:   v1alpha1TypeMeta("MachineSet"),
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Labels: map[string]string{"freeze": "True"}},
+				Spec: v1alpha1.MachineSetSpec{
+					Replicas: 1,
+					Frozen:   true,
+					Template: v1alpha1.MachineTemplateSpec{Spec: v1alpha1.MachineSpec{DeletionPriority: ptr.To[int32](3)}},
+				},
+			}, &v1beta1.MachineSet{}, &v1alpha1.MachineSet{}, &v1beta1.MachineSet{
+				TypeMeta:   v1beta1TypeMeta("MachineSet"),
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Labels: map[string]string{"freeze": "True"}},
+				Spec: v1beta1.MachineSetSpec{
+					Replicas: 1,
+					Frozen:   true,
+					Template: v1beta1.MachineTemplateSpec{Spec: v1beta1.MachineSpec{DeletionPriority: ptr.To[int32](3)}},
+				},
+			}),
+			Entry("should keep a freeze label of a machine deployment with another value", &v1alpha1.MachineDeployment{
+				TypeMeta:   v1alpha1TypeMeta("MachineDeployment"),
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Labels: map[string]string{"freeze": "true"}},
+				Spec:       v1alpha1.MachineDeploymentSpec{Replicas: 2},
+			}, &v1beta1.MachineDeployment{}, &v1alpha1.MachineDeployment{}, &v1beta1.MachineDeployment{
+				TypeMeta:   v1beta1TypeMeta("MachineDeployment"),
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Labels: map[string]string{"freeze": "true"}},
+				Spec:       v1beta1.MachineDeploymentSpec{Replicas: 2},
+			}),
+		)
+
+		It("should drop the timeoutActive field of a machine", func() {
+			machine := &v1beta1.Machine{}
+			convert(&v1alpha1.Machine{
+				TypeMeta: v1alpha1TypeMeta("Machine"),
+				Status:   v1alpha1.MachineStatus{CurrentStatus: v1alpha1.CurrentStatus{Phase: v1alpha1.MachinePending, TimeoutActive: true}},
+			}, v1beta1.SchemeGroupVersion.String(), machine)
+			Expect(machine.Status.CurrentStatus).To(Equal(v1beta1.CurrentStatus{Phase: v1beta1.MachinePending}))
+		})
+
+		It("should not convert to another API group", func() {
+			_, err := ConvertObjects(nil, "apps/v1")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#serveConversion", func() {
+		It("should respond with the converted objects", func() {
+			raw, err := json.Marshal(&v1beta1.MachineDeployment{
+				TypeMeta:   v1beta1TypeMeta("MachineDeployment"),
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       v1beta1.MachineDeploymentSpec{Frozen: true},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			body, err := json.Marshal(&apiextensionsv1.ConversionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: apiextensionsv1.SchemeGroupVersion.String(), Kind: "ConversionReview"},
+				Request: &apiextensionsv1.ConversionRequest{
+					UID:               types.UID("uid"),
+					DesiredAPIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Objects:           []runtime.RawExtension{{Raw: raw}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			handler := NewHandler(fake.NewSimpleClientset(), options.WebhookServerOptions{})
+			recorder := httptest.NewRecorder()
+			handler.ServeMux().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ConvertPath, bytes.NewReader(body)))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			review := &apiextensionsv1.ConversionReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), review)).To(Succeed())
+			Expect(review.Response).ToNot(BeNil())
+			Expect(review.Response.UID).To(Equal(types.UID("uid")))
+			Expect(review.Response.Result.Status).To(Equal(metav1.StatusSuccess))
+			Expect(review.Response.ConvertedObjects).To(HaveLen(1))
+
+			deployment := &v1alpha1.MachineDeployment{}
+			Expect(json.Unmarshal(review.Response.ConvertedObjects[0].Raw, deployment)).To(Succeed())
+			Expect(deployment.APIVersion).To(Equal(v1alpha1.SchemeGroupVersion.String()))
+			Expect(deployment.Spec.Frozen).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/options"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// patchOperation is an operation of a JSON patch.
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// mutate sets the defaults of machines and machine deployments. The spec of a defaulted object is replaced
+// as a whole. The machine templates of machine sets and the machines owned by a controller are not
+// defaulted, as they are copied from the template of their owner, which is defaulted itself. Defaulting
+// them would make them differ from the template of their owner.
+func (h *Handler) mutate(_ context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return allowed()
+	}
+
+	var (
+		spec    interface{}
+		changed bool
+	)
+	switch request.Kind.Kind {
+	case "Machine":
+		obj := &v1alpha1.Machine{}
+		if err := json.Unmarshal(request.Object.Raw, obj); err != nil {
+			return deniedBadRequest(err)
+		}
+		if metav1.GetControllerOf(obj) != nil {
+			return allowed()
+		}
+		original := obj.Spec.DeepCopy()
+		SetDefaultsMachineSpec(&obj.Spec, h.options)
+		spec, changed = obj.Spec, !apiequality.Semantic.DeepEqual(original, &obj.Spec)
+	case "MachineDeployment":
+		obj := &v1alpha1.MachineDeployment{}
+		if err := json.Unmarshal(request.Object.Raw, obj); err != nil {
+			return deniedBadRequest(err)
+		}
+		original := obj.Spec.DeepCopy()
+		SetDefaultsMachineDeploymentSpec(&obj.Spec)
+		SetDefaultsMachineSpec(&obj.Spec.Template.Spec, h.options)
+		spec, changed = obj.Spec, !apiequality.Semantic.DeepEqual(original, &obj.Spec)
+	default:
+		return allowed()
+	}
+	if !changed {
+		return allowed()
+	}
+
+	patch, err := json.Marshal([]patchOperation{{Op: "add", Path: "/spec", Value: spec}})
+	if err != nil {
+		return denied(err)
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	return &admissionv1.AdmissionResponse{Allowed: true, Patch: patch, PatchType: &patchType}
+}
+
+// SetDefaultsMachineDeploymentSpec defaults the update strategy of a machine deployment to a rolling update
+// surging by one machine.
+func SetDefaultsMachineDeploymentSpec(spec *v1alpha1.MachineDeploymentSpec) {
+	if spec.Strategy.Type == "" {
+		spec.Strategy.Type = v1alpha1.RollingUpdateMachineDeploymentStrategyType
+	}
+	if spec.Strategy.Type == v1alpha1.RollingUpdateMachineDeploymentStrategyType {
+		if spec.Strategy.RollingUpdate == nil {
+			spec.Strategy.RollingUpdate = &v1alpha1.RollingUpdateMachineDeployment{}
+		}
+		if spec.Strategy.RollingUpdate.MaxSurge == nil {
+			maxSurge := intstr.FromInt32(1)
+			spec.Strategy.RollingUpdate.MaxSurge = &maxSurge
+		}
+		if spec.Strategy.RollingUpdate.MaxUnavailable == nil {
+			maxUnavailable := intstr.FromInt32(0)
+			spec.Strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+	}
+}
+
+// SetDefaultsMachineSpec defaults the creation, health and drain timeouts of a machine which are not set
+// to the non-zero defaults of the options.
+func SetDefaultsMachineSpec(spec *v1alpha1.MachineSpec, options options.WebhookServerOptions) {
+	if spec.MachineConfiguration == nil {
+		spec.MachineConfiguration = &v1alpha1.MachineConfiguration{}
+	}
+	config := spec.MachineConfiguration
+	if config.MachineCreationTimeout == nil && options.DefaultMachineCreationTimeout.Duration > 0 {
+		config.MachineCreationTimeout = &metav1.Duration{Duration: options.DefaultMachineCreationTimeout.Duration}
+	}
+	if config.MachineHealthTimeout == nil && options.DefaultMachineHealthTimeout.Duration > 0 {
+		config.MachineHealthTimeout = &metav1.Duration{Duration: options.DefaultMachineHealthTimeout.Duration}
+	}
+	if config.MachineDrainTimeout == nil && options.DefaultMachineDrainTimeout.Duration > 0 {
+		config.MachineDrainTimeout = &metav1.Duration{Duration: options.DefaultMachineDrainTimeout.Duration}
+	}
+	if apiequality.Semantic.DeepEqual(config, &v1alpha1.MachineConfiguration{}) {
+		spec.MachineConfiguration = nil
+	}
+}
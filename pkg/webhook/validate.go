@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/validation"
+	"github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validate rejects machines, machine sets, machine deployments and machine classes which fail validation.
+// Objects which are being deleted are admitted, so that their finalizers can always be removed. On updates,
+// only errors which the old object did not have yet are rejected, so that objects admitted before a validation
+// was added can still be updated. Legacy priority annotations and freeze labels, whose values are ignored by the
+// controllers, are warned about.
+func (h *Handler) validate(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return allowed()
+	}
+
+	result, err := validateObject(request.Kind.Kind, request.Object.Raw)
+	if err != nil {
+		return deniedBadRequest(err)
+	}
+	if result == nil {
+		return allowed()
+	}
+	var oldResult *validationResult
+	if request.Operation == admissionv1.Update && len(request.OldObject.Raw) > 0 {
+		if oldResult, err = validateObject(request.Kind.Kind, request.OldObject.Raw); err != nil {
+			return deniedBadRequest(err)
+		}
+	}
+
+	allErrs := result.errs
+	if oldResult != nil {
+		allErrs = ratchetErrors(allErrs, oldResult.errs)
+	}
+	if machineClass, ok := result.object.(*v1alpha1.MachineClass); ok && machineClass.DeletionTimestamp == nil {
+		var oldMachineClass *v1alpha1.MachineClass
+		if oldResult != nil {
+			oldMachineClass = oldResult.object.(*v1alpha1.MachineClass)
+		}
+		secretErrs, secretErr := h.validateSecretReferences(ctx, machineClass, oldMachineClass)
+		if secretErr != nil {
+			return denied(secretErr)
+		}
+		allErrs = append(allErrs, secretErrs...)
+	}
+
+	response := allowed()
+	if result.objectMeta.DeletionTimestamp == nil && len(allErrs) > 0 {
+		groupKind := schema.GroupKind{Group: request.Kind.Group, Kind: request.Kind.Kind}
+		response = denied(apierrors.NewInvalid(groupKind, result.objectMeta.Name, allErrs))
+	}
+	response.Warnings = result.warnings
+	return response
+}
+
+// validationResult is the outcome of the validation of a decoded object.
+type validationResult struct {
+	object     runtime.Object
+	objectMeta *metav1.ObjectMeta
+	errs       field.ErrorList
+	warnings   []string
+}
+
+// validateObject decodes the given raw object of the given kind and validates it. It returns nil for kinds
+// which are not validated.
+func validateObject(kind string, raw []byte) (*validationResult, error) {
+	var (
+		result = &validationResult{}
+		err    error
+	)
+	switch kind {
+	case "Machine":
+		obj := &v1alpha1.Machine{}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, err
+		}
+		internal := &machine.Machine{}
+		err = v1alpha1.Convert_v1alpha1_Machine_To_machine_Machine(obj, internal, nil)
+		result.object, result.objectMeta, result.errs = obj, &obj.ObjectMeta, validation.ValidateMachine(internal)
+		result.warnings = priorityAnnotationWarnings(&obj.ObjectMeta, field.NewPath("metadata"))
+	case "MachineSet":
+		obj := &v1alpha1.MachineSet{}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, err
+		}
+		internal := &machine.MachineSet{}
+		err = v1alpha1.Convert_v1alpha1_MachineSet_To_machine_MachineSet(obj, internal, nil)
+		result.object, result.objectMeta, result.errs = obj, &obj.ObjectMeta, validation.ValidateMachineSet(internal)
+		result.warnings = append(freezeLabelWarnings(&obj.ObjectMeta), priorityAnnotationWarnings(&obj.Spec.Template.ObjectMeta, field.NewPath("spec", "template", "metadata"))...)
+	case "MachineDeployment":
+		obj := &v1alpha1.MachineDeployment{}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, err
+		}
+		internal := &machine.MachineDeployment{}
+		err = v1alpha1.Convert_v1alpha1_MachineDeployment_To_machine_MachineDeployment(obj, internal, nil)
+		result.object, result.objectMeta, result.errs = obj, &obj.ObjectMeta, append(validation.ValidateMachineDeployment(internal), rolloutPriorityErrors(&obj.ObjectMeta)...)
+		result.warnings = append(freezeLabelWarnings(&obj.ObjectMeta), priorityAnnotationWarnings(&obj.Spec.Template.ObjectMeta, field.NewPath("spec", "template", "metadata"))...)
+	case "MachineClass":
+		obj := &v1alpha1.MachineClass{}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, err
+		}
+		internal := &machine.MachineClass{}
+		err = v1alpha1.Convert_v1alpha1_MachineClass_To_machine_MachineClass(obj, internal, nil)
+		result.object, result.objectMeta, result.errs = obj, &obj.ObjectMeta, validation.ValidateMachineClass(internal)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ratchetErrors returns the errors which are not already present with the same value in the old errors.
+func ratchetErrors(errs, oldErrs field.ErrorList) field.ErrorList {
+	var newErrs field.ErrorList
+	for _, err := range errs {
+		if !slices.ContainsFunc(oldErrs, func(oldErr *field.Error) bool {
+			return oldErr.Type == err.Type && oldErr.Field == err.Field && reflect.DeepEqual(oldErr.BadValue, err.BadValue)
+		}) {
+			newErrs = append(newErrs, err)
+		}
+	}
+	return newErrs
+}
+
+// priorityAnnotationWarnings returns a warning if the legacy priority annotation is not an integer, as it is
+// ignored by the controllers then.
+func priorityAnnotationWarnings(objectMeta *metav1.ObjectMeta, fldPath *field.Path) []string {
+	value, ok := objectMeta.Annotations[machineutils.MachinePriority]
+	if !ok {
+		return nil
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		return []string{fmt.Sprintf("%s: %q is not an integer and is ignored, use spec.deletionPriority instead", fldPath.Child("annotations").Key(machineutils.MachinePriority), value)}
+	}
+	return nil
+}
+
+// rolloutPriorityErrors returns an error if the rollout priority annotation is not an integer, as it would be ignored
+// by the controllers.
+func rolloutPriorityErrors(objectMeta *metav1.ObjectMeta) field.ErrorList {
+	value, ok := objectMeta.Annotations[controller.RolloutPriorityAnnotation]
+	if !ok {
+		return nil
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("metadata", "annotations").Key(controller.RolloutPriorityAnnotation), value, "must be an integer")}
+	}
+	return nil
+}
+
+// freezeLabelWarnings returns a warning if the legacy freeze label has another value than the one freezing the
+// object, as it is ignored by the controllers then.
+func freezeLabelWarnings(objectMeta *metav1.ObjectMeta) []string {
+	value, ok := objectMeta.Labels[machineutils.FreezeLabel]
+	if !ok || value == machineutils.FreezeLabelValue {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %q does not freeze, use spec.frozen instead", field.NewPath("metadata", "labels").Key(machineutils.FreezeLabel), value)}
+}
+
+// validateSecretReferences returns an error for every secret referenced by the machine class which does
+// not exist. Secrets referenced by the old machine class as well are not looked up again.
+func (h *Handler) validateSecretReferences(ctx context.Context, machineClass, oldMachineClass *v1alpha1.MachineClass) (field.ErrorList, error) {
+	var oldSecretRef, oldCredentialsSecretRef *corev1.SecretReference
+	if oldMachineClass != nil {
+		oldSecretRef, oldCredentialsSecretRef = oldMachineClass.SecretRef, oldMachineClass.CredentialsSecretRef
+	}
+
+	allErrs := field.ErrorList{}
+	for _, ref := range []struct {
+		secretRef    *corev1.SecretReference
+		oldSecretRef *corev1.SecretReference
+		fldPath      *field.Path
+	}{
+		{machineClass.SecretRef, oldSecretRef, field.NewPath("secretRef")},
+		{machineClass.CredentialsSecretRef, oldCredentialsSecretRef, field.NewPath("credentialsSecretRef")},
+	} {
+		if ref.secretRef == nil || ref.secretRef.Name == "" {
+			continue
+		}
+		if oldMachineClass != nil && apiequality.Semantic.DeepEqual(ref.secretRef, ref.oldSecretRef) {
+			continue
+		}
+		namespace := ref.secretRef.Namespace
+		if namespace == "" {
+			namespace = machineClass.Namespace
+		}
+		_, err := h.controlCoreClient.CoreV1().Secrets(namespace).Get(ctx, ref.secretRef.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(ref.fldPath, namespace+"/"+ref.secretRef.Name))
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return allErrs, nil
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook is used to validate and default machine.sapcloud.io resources on admission and to convert
+// them between API versions
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/options"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ValidatePath is the path on which machine.sapcloud.io resources are validated.
+	ValidatePath = "/validate-machine-sapcloud-io"
+	// MutatePath is the path on which machine.sapcloud.io resources are defaulted.
+	MutatePath = "/mutate-machine-sapcloud-io"
+	// ConvertPath is the path on which machine.sapcloud.io resources are converted between API versions.
+	ConvertPath = "/convert-machine-sapcloud-io"
+
+	// maxRequestSize is the maximum size of an admission review which is read.
+	maxRequestSize = 3 * 1024 * 1024
+)
+
+// Handler validates and defaults machine.sapcloud.io resources in admission reviews.
+type Handler struct {
+	controlCoreClient kubernetes.Interface
+	options           options.WebhookServerOptions
+}
+
+// NewHandler returns a new Handler, which looks up the secrets referenced by machine classes with
+// the given control core client and sets the defaults of the given options.
+func NewHandler(controlCoreClient kubernetes.Interface, options options.WebhookServerOptions) *Handler {
+	return &Handler{
+		controlCoreClient: controlCoreClient,
+		options:           options,
+	}
+}
+
+// ServeMux returns a mux serving the validating, the mutating and the conversion webhook.
+func (h *Handler) ServeMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, h.serve(h.validate))
+	mux.HandleFunc(MutatePath, h.serve(h.mutate))
+	mux.HandleFunc(ConvertPath, h.serveConversion)
+	return mux
+}
+
+// ListenAndServeTLS serves the webhooks on the given address with the serving certificate in the cert dir
+// of the options.
+func (h *Handler) ListenAndServeTLS(address string) error {
+	server := &http.Server{
+		Addr:              net.JoinHostPort(address, strconv.Itoa(int(h.options.Port))),
+		Handler:           h.ServeMux(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServeTLS(filepath.Join(h.options.CertDir, "tls.crt"), filepath.Join(h.options.CertDir, "tls.key"))
+}
+
+// admitFunc admits the object of an admission request.
+type admitFunc func(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+// serve returns an http handler func decoding admission reviews and responding with the response of admit.
+func (h *Handler) serve(admit admitFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+			return
+		}
+		review := &admissionv1.AdmissionReview{}
+		if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+			http.Error(w, fmt.Sprintf("failed to decode admission review: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		response := admit(r.Context(), review.Request)
+		response.UID = review.Request.UID
+		review.Response = response
+		review.Request = nil
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(review); err != nil {
+			klog.Errorf("Failed to write admission review response: %v", err)
+		}
+	}
+}
+
+// allowed returns a response admitting the object.
+func allowed() *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// denied returns a response rejecting the object with the given error.
+func denied(err error) *admissionv1.AdmissionResponse {
+	status := apierrors.NewInternalError(err).ErrStatus
+	if apiStatus, ok := err.(apierrors.APIStatus); ok {
+		status = apiStatus.Status()
+	}
+	return &admissionv1.AdmissionResponse{Allowed: false, Result: &status}
+}
+
+// deniedBadRequest returns a response rejecting an object which cannot be decoded.
+func deniedBadRequest(err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusBadRequest,
+			Reason:  metav1.StatusReasonBadRequest,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/controller"
+	"github.com/gardener/machine-controller-manager/pkg/options"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("webhook", func() {
+	webhookOptions := options.WebhookServerOptions{
+		DefaultMachineCreationTimeout: metav1.Duration{Duration: 20 * time.Minute},
+		DefaultMachineHealthTimeout:   metav1.Duration{Duration: 10 * time.Minute},
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"}}
+	class := v1alpha1.ClassSpec{Kind: "MachineClass", Name: "class"}
+	one, zero := intstr.FromInt32(1), intstr.FromInt32(0)
+
+	newMachineDeployment := func(mutate func(*v1alpha1.MachineDeployment)) *v1alpha1.MachineDeployment {
+		d := &v1alpha1.MachineDeployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineDeployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "md", Namespace: "default"},
+			Spec: v1alpha1.MachineDeploymentSpec{
+				Replicas: 2,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "md"}},
+				Strategy: v1alpha1.MachineDeploymentStrategy{
+					Type:          v1alpha1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &v1alpha1.RollingUpdateMachineDeployment{MaxSurge: &one, MaxUnavailable: &zero},
+				},
+				Template: v1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"name": "md"}},
+					Spec:       v1alpha1.MachineSpec{Class: class},
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(d)
+		}
+		return d
+	}
+
+	reviewWithOldObject := func(path string, operation admissionv1.Operation, oldObj, obj runtime.Object) *admissionv1.AdmissionResponse {
+		raw, err := json.Marshal(obj)
+		Expect(err).ToNot(HaveOccurred())
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		request := &admissionv1.AdmissionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+			Request: &admissionv1.AdmissionRequest{
+				UID:       types.UID("uid"),
+				Kind:      metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+				Operation: operation,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		}
+		if oldObj != nil {
+			request.Request.OldObject.Raw, err = json.Marshal(oldObj)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		body, err := json.Marshal(request)
+		Expect(err).ToNot(HaveOccurred())
+
+		handler := NewHandler(fake.NewSimpleClientset(secret), webhookOptions)
+		recorder := httptest.NewRecorder()
+		handler.ServeMux().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		response := &admissionv1.AdmissionReview{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+		Expect(response.Response).ToNot(BeNil())
+		Expect(response.Response.UID).To(Equal(types.UID("uid")))
+		return response.Response
+	}
+	review := func(path string, operation admissionv1.Operation, obj runtime.Object) *admissionv1.AdmissionResponse {
+		return reviewWithOldObject(path, operation, nil, obj)
+	}
+
+	Describe("#validate", func() {
+		DescribeTable("##table",
+			func(operation admissionv1.Operation, obj runtime.Object, expectAllowed bool, expectMessage string) {
+				response := review(ValidatePath, operation, obj)
+				Expect(response.Allowed).To(Equal(expectAllowed))
+				if !expectAllowed {
+					Expect(response.Result).ToNot(BeNil())
+					Expect(response.Result.Message).To(ContainSubstring(expectMessage))
+				}
+			},
+			Entry("should admit a valid machine deployment", admissionv1.Create, newMachineDeployment(nil), true, ""),
+			Entry("should reject a machine deployment with a typo in maxSurge", admissionv1.Update, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				maxSurge := intstr.FromString("1O%")
+				d.Spec.Strategy.RollingUpdate.MaxSurge = &maxSurge
+			}), false, "spec.strategy.rollingUpdate.maxSurge"),
+			Entry("should reject a machine deployment with a rollout priority which is not an integer", admissionv1.Create, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Annotations = map[string]string{controller.RolloutPriorityAnnotation: "high"}
+			}), false, "metadata.annotations[deployment.machine.sapcloud.io/rollout-priority]"),
+			Entry("should admit an invalid machine deployment which is being deleted", admissionv1.Update, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				d.Spec.Strategy.Type = "Unknown"
+			}), true, ""),
+			Entry("should not validate deletions", admissionv1.Delete, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.Type = "Unknown"
+			}), true, ""),
+			Entry("should reject a machine set with negative replicas", admissionv1.Create, &v1alpha1.MachineSet{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineSet"},
+				Spec: v1alpha1.MachineSetSpec{
+					Replicas: -1,
+					Template: v1alpha1.MachineTemplateSpec{Spec: v1alpha1.MachineSpec{Class: class}},
+				},
+			}, false, "spec.replicas"),
+			Entry("should reject a machine with a deletion priority of zero", admissionv1.Create, &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+				Spec:     v1alpha1.MachineSpec{Class: class, DeletionPriority: ptr.To[int32](0)},
+			}, false, "spec.deletionPriority"),
+			Entry("should reject a machine without class", admissionv1.Create, &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+			}, false, "spec.class.name"),
+			Entry("should admit a machine class referencing an existing secret", admissionv1.Create, &v1alpha1.MachineClass{
+				TypeMeta:  metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				SecretRef: &corev1.SecretReference{Name: "secret", Namespace: "default"},
+			}, true, ""),
+			Entry("should reject a machine class referencing a missing secret", admissionv1.Create, &v1alpha1.MachineClass{
+				TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				SecretRef:            &corev1.SecretReference{Name: "secret", Namespace: "default"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "credentials", Namespace: "default"},
+			}, false, "credentialsSecretRef"),
+			Entry("should reject a machine class with an incomplete node template", admissionv1.Create, &v1alpha1.MachineClass{
+				TypeMeta:     metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				NodeTemplate: &v1alpha1.NodeTemplate{InstanceType: "m5.large", Region: "eu-west-1", Zone: "eu-west-1a"},
+			}, false, "nodeTemplate.capacity[cpu]"),
+		)
+
+		DescribeTable("should only reject errors introduced by an update",
+			func(oldObj, obj runtime.Object, expectAllowed bool, expectMessage string) {
+				response := reviewWithOldObject(ValidatePath, admissionv1.Update, oldObj, obj)
+				Expect(response.Allowed).To(Equal(expectAllowed))
+				if !expectAllowed {
+					Expect(response.Result).ToNot(BeNil())
+					Expect(response.Result.Message).To(ContainSubstring(expectMessage))
+				}
+			},
+			Entry("should admit an update keeping an invalid value", newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.Type = "Unknown"
+			}), newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.Type = "Unknown"
+				d.Spec.Replicas = 3
+			}), true, ""),
+			Entry("should reject an update changing an invalid value", &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+				Spec:     v1alpha1.MachineSpec{Class: class, DeletionPriority: ptr.To[int32](0)},
+			}, &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+				Spec:     v1alpha1.MachineSpec{Class: class, DeletionPriority: ptr.To[int32](-1)},
+			}, false, "spec.deletionPriority"),
+			Entry("should reject an update introducing an invalid value", newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.Type = "Unknown"
+			}), newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.Type = "Unknown"
+				d.Spec.Replicas = -1
+			}), false, "spec.replicas"),
+			Entry("should not look up an unchanged secret of a machine class", &v1alpha1.MachineClass{
+				TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "credentials", Namespace: "default"},
+			}, &v1alpha1.MachineClass{
+				TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "credentials", Namespace: "default"},
+				ProviderSpec:         runtime.RawExtension{Raw: []byte(`{"changed":true}`)},
+			}, true, ""),
+			Entry("should reject a machine class changed to reference a missing secret", &v1alpha1.MachineClass{
+				TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "secret", Namespace: "default"},
+			}, &v1alpha1.MachineClass{
+				TypeMeta:             metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineClass"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "credentials", Namespace: "default"},
+			}, false, "credentialsSecretRef"),
+		)
+
+		It("should warn about legacy priority annotations and freeze labels which are ignored", func() {
+			response := review(ValidatePath, admissionv1.Update, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Labels = map[string]string{"freeze": "true"}
+				d.Spec.Template.Annotations = map[string]string{"machinepriority.machine.sapcloud.io": "high"}
+			}))
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Warnings).To(ConsistOf(
+				ContainSubstring("metadata.labels[freeze]"),
+				ContainSubstring("spec.template.metadata.annotations[machinepriority.machine.sapcloud.io]"),
+			))
+		})
+
+		It("should not warn about valid legacy priority annotations and freeze labels", func() {
+			response := review(ValidatePath, admissionv1.Update, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Labels = map[string]string{"freeze": "True"}
+				d.Spec.Template.Annotations = map[string]string{"machinepriority.machine.sapcloud.io": "1"}
+			}))
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Warnings).To(BeEmpty())
+		})
+	})
+
+	Describe("#mutate", func() {
+		decodeSpec := func(response *admissionv1.AdmissionResponse, spec interface{}) {
+			Expect(response.PatchType).ToNot(BeNil())
+			Expect(*response.PatchType).To(Equal(admissionv1.PatchTypeJSONPatch))
+			var patch []struct {
+				Op    string          `json:"op"`
+				Path  string          `json:"path"`
+				Value json.RawMessage `json:"value"`
+			}
+			Expect(json.Unmarshal(response.Patch, &patch)).To(Succeed())
+			Expect(patch).To(HaveLen(1))
+			Expect(patch[0].Op).To(Equal("add"))
+			Expect(patch[0].Path).To(Equal("/spec"))
+			Expect(json.Unmarshal(patch[0].Value, spec)).To(Succeed())
+		}
+
+		It("should default the strategy and the machine timeouts of a new machine deployment", func() {
+			response := review(MutatePath, admissionv1.Create, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy = v1alpha1.MachineDeploymentStrategy{}
+				d.Spec.Template.Spec.MachineConfiguration = &v1alpha1.MachineConfiguration{
+					MachineHealthTimeout: &metav1.Duration{Duration: time.Minute},
+				}
+			}))
+			Expect(response.Allowed).To(BeTrue())
+
+			spec := &v1alpha1.MachineDeploymentSpec{}
+			decodeSpec(response, spec)
+			Expect(spec.Strategy.Type).To(Equal(v1alpha1.RollingUpdateMachineDeploymentStrategyType))
+			Expect(spec.Strategy.RollingUpdate.MaxSurge).To(Equal(&one))
+			Expect(spec.Strategy.RollingUpdate.MaxUnavailable).To(Equal(&zero))
+			Expect(spec.Template.Spec.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 20 * time.Minute}))
+			Expect(spec.Template.Spec.MachineHealthTimeout).To(Equal(&metav1.Duration{Duration: time.Minute}))
+			Expect(spec.Template.Spec.MachineDrainTimeout).To(BeNil())
+			Expect(spec.Template.Spec.Class).To(Equal(class))
+		})
+
+		It("should default the machine timeouts of an existing machine deployment", func() {
+			response := review(MutatePath, admissionv1.Update, newMachineDeployment(func(d *v1alpha1.MachineDeployment) {
+				d.Spec.Strategy.RollingUpdate.MaxUnavailable = nil
+			}))
+			Expect(response.Allowed).To(BeTrue())
+
+			spec := &v1alpha1.MachineDeploymentSpec{}
+			decodeSpec(response, spec)
+			Expect(spec.Strategy.RollingUpdate.MaxUnavailable).To(Equal(&zero))
+			Expect(spec.Template.Spec.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 20 * time.Minute}))
+			Expect(spec.Template.Spec.MachineHealthTimeout).To(Equal(&metav1.Duration{Duration: 10 * time.Minute}))
+		})
+
+		It("should not create another machine set for an existing machine deployment", func() {
+			existing := newMachineDeployment(nil)
+			machineSet := &v1alpha1.MachineSet{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "MachineSet"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "md-1",
+					Namespace:       "default",
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(existing, v1alpha1.SchemeGroupVersion.WithKind("MachineDeployment"))},
+				},
+				Spec: v1alpha1.MachineSetSpec{Replicas: 2, Selector: existing.Spec.Selector, Template: existing.Spec.Template},
+			}
+			response := review(MutatePath, admissionv1.Create, machineSet)
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patch).To(BeEmpty())
+
+			response = review(MutatePath, admissionv1.Update, existing)
+			Expect(response.Allowed).To(BeTrue())
+			updated := existing.DeepCopy()
+			decodeSpec(response, &updated.Spec)
+			Expect(controller.FindNewMachineSet(updated, []*v1alpha1.MachineSet{machineSet})).To(Equal(machineSet))
+		})
+
+		It("should only default machines without an owner", func() {
+			machine := &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+				Spec:     v1alpha1.MachineSpec{Class: class},
+			}
+			response := review(MutatePath, admissionv1.Update, machine)
+			Expect(response.Allowed).To(BeTrue())
+			spec := &v1alpha1.MachineSpec{}
+			decodeSpec(response, spec)
+			Expect(spec.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 20 * time.Minute}))
+
+			machine.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+				Kind:       "MachineSet",
+				Name:       "md-1",
+				UID:        "uid",
+				Controller: ptr.To(true),
+			}}
+			response = review(MutatePath, admissionv1.Create, machine)
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patch).To(BeEmpty())
+		})
+
+		It("should not patch a machine which needs no defaults", func() {
+			response := review(MutatePath, admissionv1.Create, &v1alpha1.Machine{
+				TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Machine"},
+				Spec: v1alpha1.MachineSpec{
+					Class: class,
+					MachineConfiguration: &v1alpha1.MachineConfiguration{
+						MachineCreationTimeout: &metav1.Duration{Duration: time.Hour},
+						MachineHealthTimeout:   &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			})
+			Expect(response.Allowed).To(BeTrue())
+			Expect(response.Patch).To(BeEmpty())
+			Expect(response.PatchType).To(BeNil())
+		})
+	})
+})